@@ -0,0 +1,2 @@
+data "lidarr_auto_taggings" "example" {
+}
@@ -0,0 +1,7 @@
+data "lidarr_custom_format_export" "example" {
+  name = "Example"
+}
+
+output "custom_format_json" {
+  value = data.lidarr_custom_format_export.example.json
+}
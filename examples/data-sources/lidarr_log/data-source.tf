@@ -0,0 +1,4 @@
+data "lidarr_log" "example" {
+  level = "error"
+  limit = 10
+}
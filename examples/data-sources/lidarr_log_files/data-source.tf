@@ -0,0 +1,3 @@
+data "lidarr_log_files" "example" {
+  type = "update"
+}
@@ -0,0 +1,6 @@
+data "lidarr_queue" "example" {
+  page_size      = 50
+  sort_key       = "timeleft"
+  sort_direction = "ascending"
+  protocol       = "torrent"
+}
@@ -0,0 +1,3 @@
+data "lidarr_rename_preview" "example" {
+  artist_id = 1
+}
@@ -0,0 +1,2 @@
+data "lidarr_tasks" "example" {
+}
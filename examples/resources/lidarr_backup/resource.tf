@@ -0,0 +1,5 @@
+resource "lidarr_backup" "example" {
+  triggers = {
+    version = "2.0.0"
+  }
+}
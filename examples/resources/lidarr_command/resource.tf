@@ -0,0 +1,9 @@
+resource "lidarr_command" "example" {
+  name = "RefreshArtist"
+  body = {
+    artist_ids = jsonencode([1, 2])
+  }
+  triggers = {
+    artists = "1,2"
+  }
+}
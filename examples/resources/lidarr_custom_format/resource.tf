@@ -19,4 +19,9 @@ resource "lidarr_custom_format" "example" {
       max            = 100
     }
   ]
-}
\ No newline at end of file
+}
+# create from a custom format exported by Lidarr
+resource "lidarr_custom_format" "json" {
+  name = "Example JSON"
+  json = file("${path.module}/custom_format.json")
+}
@@ -1,2 +1,5 @@
 # import using the API/UI ID
-terraform import lidarr_indexer_filelist.example 1
\ No newline at end of file
+terraform import lidarr_indexer_filelist.example 1
+
+# import using the indexer name
+terraform import lidarr_indexer_filelist.example name:Example
@@ -0,0 +1,5 @@
+# import using the API/UI ID
+terraform import lidarr_indexer_orpheus.example 1
+
+# import using the indexer name
+terraform import lidarr_indexer_orpheus.example name:Example
@@ -0,0 +1,7 @@
+resource "lidarr_indexer_orpheus" "example" {
+  enable_automatic_search = true
+  name                    = "Example"
+  api_key                 = "Key"
+  use_freeleech_token     = false
+  minimum_seeders         = 1
+}
@@ -1,2 +1,5 @@
 # import using the API/UI ID
-terraform import lidarr_notification_plex.example 1
\ No newline at end of file
+terraform import lidarr_notification_plex.example 1
+
+# import using the notification name
+terraform import lidarr_notification_plex.example name:Example
\ No newline at end of file
@@ -1,4 +1,4 @@
-resource "lidarr_notification_join" "example" {
+resource "lidarr_notification_pushover" "example" {
   on_grab               = false
   on_import_failure     = false
   on_upgrade            = false
@@ -12,4 +12,6 @@ resource "lidarr_notification_join" "example" {
 
   api_key  = "Key"
   priority = 2
+  retry    = 60
+  expire   = 3600
 }
\ No newline at end of file
@@ -19,4 +19,20 @@ resource "lidarr_quality_profile" "example" {
       ]
     }
   ]
-}
\ No newline at end of file
+}
+# declare qualities by name
+resource "lidarr_quality_profile" "items" {
+  name            = "example-by-name"
+  upgrade_allowed = true
+  cutoff          = 1001
+
+  items = [
+    {
+      group     = "Lossy High"
+      qualities = ["MP3-320", "AAC-320"]
+    },
+    {
+      name = "FLAC"
+    }
+  ]
+}
@@ -0,0 +1,9 @@
+resource "lidarr_queue_cleaner" "example" {
+  statuses        = ["warning"]
+  message_pattern = "(?i)no files found"
+  min_age         = "24h"
+  blocklist       = true
+  triggers = {
+    run = timestamp()
+  }
+}
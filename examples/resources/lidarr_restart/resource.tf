@@ -0,0 +1,8 @@
+resource "lidarr_restart" "example" {
+  triggers = {
+    port     = lidarr_host.example.port
+    url_base = lidarr_host.example.url_base
+  }
+
+  depends_on = [lidarr_host.example]
+}
@@ -0,0 +1,48 @@
+package helpers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// define constants for the read after create retry.
+const (
+	readAfterCreateAttempts = 3
+	readAfterCreateDelay    = 500 * time.Millisecond
+)
+
+// ReadAfterCreate reads a resource right after its creation, retrying while it is not found yet.
+// Lidarr behind a load balancer or a cache may not return a new resource immediately.
+// If the read still fails, the created resource is returned with a warning, since it already exists in Lidarr.
+func ReadAfterCreate[T any](ctx context.Context, created T, name string, read func() (T, *http.Response, error), diags *diag.Diagnostics) T {
+	response, _, err := retryNotFound(ctx, readAfterCreateAttempts, readAfterCreateDelay, read)
+	if err != nil {
+		diags.AddWarning(ClientError, ParseClientError(Read, name, err)+"\nThe state is written from the create response and is refreshed on the next plan.")
+
+		return created
+	}
+
+	return response
+}
+
+// retryNotFound executes a read up to the given attempts while it returns not found, doubling the delay each time.
+// It stops waiting as soon as the context is done.
+func retryNotFound[T any](ctx context.Context, attempts int, delay time.Duration, read func() (T, *http.Response, error)) (T, *http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		response, httpResponse, err := read()
+		if attempt >= attempts || !IsNotFound(httpResponse) {
+			return response, httpResponse, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return response, httpResponse, err
+		case <-time.After(delay):
+		}
+
+		delay *= 2
+	}
+}
@@ -0,0 +1,149 @@
+package helpers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryNotFound(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		missing  int32
+		attempts int32
+		found    bool
+	}{
+		"found": {
+			missing:  0,
+			attempts: 1,
+			found:    true,
+		},
+		"found after retries": {
+			missing:  2,
+			attempts: 3,
+			found:    true,
+		},
+		"not found": {
+			missing:  5,
+			attempts: 3,
+			found:    false,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls int32
+
+			// fake Lidarr returning not found for the first requests
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if atomic.AddInt32(&calls, 1) <= test.missing {
+					http.NotFound(w, r)
+
+					return
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"id":1,"label":"test"}`))
+			}))
+			defer server.Close()
+
+			config := lidarr.NewConfiguration()
+			config.Servers[0].URL = server.URL
+			client := lidarr.NewAPIClient(config)
+
+			tag, resp, err := retryNotFound(context.Background(), 3, time.Millisecond, client.TagAPI.GetTagById(context.Background(), 1).Execute)
+			assert.Equal(t, test.attempts, atomic.LoadInt32(&calls))
+			assert.Equal(t, test.found, err == nil)
+			assert.Equal(t, !test.found, IsNotFound(resp))
+
+			if test.found {
+				assert.Equal(t, "test", tag.GetLabel())
+			}
+		})
+	}
+}
+
+func TestRetryNotFoundCanceled(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		http.NotFound(w, r)
+	}))
+	defer server.Close()
+
+	config := lidarr.NewConfiguration()
+	config.Servers[0].URL = server.URL
+	client := lidarr.NewAPIClient(config)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// a canceled operation does not wait for the following attempts
+	_, resp, _ := retryNotFound(ctx, 3, time.Hour, client.TagAPI.GetTagById(context.Background(), 1).Execute)
+	assert.True(t, IsNotFound(resp))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestReadAfterCreate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		status   int
+		expected string
+		warning  bool
+	}{
+		"read": {
+			status:   http.StatusOK,
+			expected: "read",
+		},
+		"read failure": {
+			status:   http.StatusInternalServerError,
+			expected: "created",
+			warning:  true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(test.status)
+				_, _ = w.Write([]byte(`{"id":1,"label":"read"}`))
+			}))
+			defer server.Close()
+
+			config := lidarr.NewConfiguration()
+			config.Servers[0].URL = server.URL
+			client := lidarr.NewAPIClient(config)
+
+			created := lidarr.NewTagResource()
+			created.SetId(1)
+			created.SetLabel("created")
+
+			var diags diag.Diagnostics
+
+			// the created resource is kept when it cannot be read back
+			tag := ReadAfterCreate(context.Background(), created, "tag", client.TagAPI.GetTagById(context.Background(), 1).Execute, &diags)
+			assert.Equal(t, test.expected, tag.GetLabel())
+			assert.False(t, diags.HasError())
+			assert.Equal(t, test.warning, diags.WarningsCount() == 1)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DeletionProtectionError is the summary of the error raised when deleting a protected resource.
+const DeletionProtectionError = "Deletion Protection Enabled"
+
+// DeletionProtectionAttribute returns the schema of the deletion_protection attribute.
+// The attribute is only stored in the Terraform state and never sent to Lidarr.
+func DeletionProtectionAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		MarkdownDescription: "Prevent the resource from being destroyed. It must be set to `false` and applied before the resource can be destroyed. Defaults to `false`.",
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+	}
+}
+
+// DeletionProtected checks if the deletion of a resource is prevented by its deletion_protection attribute, adding an error if so.
+func DeletionProtected(ctx context.Context, state tfsdk.State, name string, diags *diag.Diagnostics) bool {
+	var protection types.Bool
+
+	diags.Append(state.GetAttribute(ctx, path.Root("deletion_protection"), &protection)...)
+
+	if !protection.ValueBool() {
+		return false
+	}
+
+	diags.AddError(DeletionProtectionError, fmt.Sprintf("Unable to delete %s, deletion_protection is enabled. Set it to false and apply before destroying the resource.", name))
+
+	return true
+}
@@ -0,0 +1,59 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeletionProtected(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value    interface{}
+		expected bool
+	}{
+		"enabled": {
+			value:    true,
+			expected: true,
+		},
+		"disabled": {
+			value:    false,
+			expected: false,
+		},
+		"null": {
+			value:    nil,
+			expected: false,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			resourceSchema := schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"deletion_protection": DeletionProtectionAttribute(),
+				},
+			}
+			state := tfsdk.State{
+				Schema: resourceSchema,
+				Raw: tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), map[string]tftypes.Value{
+					"deletion_protection": tftypes.NewValue(tftypes.Bool, test.value),
+				}),
+			}
+
+			var diags diag.Diagnostics
+
+			assert.Equal(t, test.expected, DeletionProtected(ctx, state, "lidarr_test", &diags))
+			assert.Equal(t, test.expected, diags.HasError())
+		})
+	}
+}
@@ -1,7 +1,13 @@
 package helpers
 
 import (
+	"cmp"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/devopsarr/lidarr-go/lidarr"
 )
@@ -13,22 +19,127 @@ const (
 	Update                            = "update"
 	Delete                            = "delete"
 	List                              = "list"
+	Validate                          = "validate"
 	ClientError                       = "Client Error"
 	ResourceError                     = "Resource Error"
 	DataSourceError                   = "Data Source Error"
 	UnexpectedImportIdentifier        = "Unexpected Import Identifier"
 	UnexpectedResourceConfigureType   = "Unexpected Resource Configure Type"
 	UnexpectedDataSourceConfigureType = "Unexpected DataSource Configure Type"
+	ResourceNotFound                  = "Resource Not Found"
 )
 
-func ParseNotFoundError(kind, field, search string) string {
-	return fmt.Sprintf("Unable to find %s, got error: data source not found: no %s with %s '%s'", kind, kind, field, search)
+// maxAvailableValues caps the values listed by a not found error.
+const maxAvailableValues = 25
+
+// ParseNotFoundError describes a failed data source lookup, listing the available values to pick from.
+func ParseNotFoundError(kind, field, search string, available []string) string {
+	return fmt.Sprintf("Unable to find %s, got error: data source not found: no %s with %s '%s', %s", kind, kind, field, search, listAvailable(available))
 }
 
+// listAvailable sorts the values, numbers by value, and quotes the first ones.
+func listAvailable(available []string) string {
+	if len(available) == 0 {
+		return "none available"
+	}
+
+	values := slices.Clone(available)
+	slices.SortFunc(values, func(a, b string) int {
+		x, errA := strconv.Atoi(a)
+		y, errB := strconv.Atoi(b)
+
+		if errA == nil && errB == nil {
+			return cmp.Compare(x, y)
+		}
+
+		return strings.Compare(a, b)
+	})
+
+	quoted := make([]string, min(len(values), maxAvailableValues))
+	for i := range quoted {
+		quoted[i] = "'" + values[i] + "'"
+	}
+
+	list := "available: " + strings.Join(quoted, ", ")
+	if more := len(values) - len(quoted); more > 0 {
+		list += fmt.Sprintf(" (+%d more)", more)
+	}
+
+	return list
+}
+
+// Values maps the listed elements to the values shown by ParseNotFoundError.
+func Values[T, V any](elements []T, value func(*T) V) []string {
+	values := make([]string, len(elements))
+	for i := range elements {
+		values[i] = fmt.Sprint(value(&elements[i]))
+	}
+
+	return values
+}
+
+// ParseResourceNotFound describes a resource removed from state since it is missing in Lidarr.
+func ParseResourceNotFound(name string, id int64) string {
+	return fmt.Sprintf("%s with ID %d not found, removing it from state so that it can be recreated", name, id)
+}
+
+// IsNotFound checks if a Lidarr response is a 404.
+func IsNotFound(resp *http.Response) bool {
+	return resp != nil && resp.StatusCode == http.StatusNotFound
+}
+
+// databaseLockedHint suggests the provider settings to tune when the Lidarr database is still locked after the retries.
+const databaseLockedHint = "The Lidarr database is still locked after the retries, consider lowering max_concurrent_requests or raising max_retries."
+
 func ParseClientError(action, name string, err error) string {
 	if e, ok := err.(*lidarr.GenericOpenAPIError); ok {
+		if strings.Contains(string(e.Body()), databaseLockedError) {
+			return fmt.Sprintf("Unable to %s %s, got error: %s\nDetails:\n%s\n%s", action, name, err, string(e.Body()), databaseLockedHint)
+		}
+
 		return fmt.Sprintf("Unable to %s %s, got error: %s\nDetails:\n%s", action, name, err, string(e.Body()))
 	}
 
 	return fmt.Sprintf("Unable to %s %s, got error: %s", action, name, err)
 }
+
+// validationFailure describes a single Lidarr validation failure.
+type validationFailure struct {
+	PropertyName string `json:"propertyName"`
+	ErrorMessage string `json:"errorMessage"`
+}
+
+// ParseValidationError lists the failing fields of a Lidarr validation error, falling back to ParseClientError.
+func ParseValidationError(action, name string, err error) string {
+	if e, ok := err.(*lidarr.GenericOpenAPIError); ok {
+		if failures := parseValidationFailures(e.Body()); failures != "" {
+			return fmt.Sprintf("Unable to %s %s, got error: %s\nValidation failures:\n%s", action, name, err, failures)
+		}
+	}
+
+	return ParseClientError(action, name, err)
+}
+
+// parseValidationFailures formats the validation failures contained in a response body.
+func parseValidationFailures(body []byte) string {
+	var failures []validationFailure
+	if err := json.Unmarshal(body, &failures); err != nil {
+		return ""
+	}
+
+	lines := make([]string, 0, len(failures))
+
+	for _, f := range failures {
+		if f.ErrorMessage == "" {
+			continue
+		}
+
+		if f.PropertyName == "" {
+			lines = append(lines, "- "+f.ErrorMessage)
+		} else {
+			lines = append(lines, fmt.Sprintf("- %s: %s", f.PropertyName, f.ErrorMessage))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -1,7 +1,11 @@
 package helpers
 
 import (
+	"context"
 	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
 	"testing"
 
 	"github.com/devopsarr/lidarr-go/lidarr"
@@ -11,6 +15,16 @@ import (
 func TestParseClientError(t *testing.T) {
 	t.Parallel()
 
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte("database is locked"))
+	}))
+	defer server.Close()
+
+	config := lidarr.NewConfiguration()
+	config.Servers[0].URL = server.URL
+	_, _, locked := lidarr.NewAPIClient(config).TagAPI.CreateTag(context.Background()).TagResource(*lidarr.NewTagResource()).Execute()
+
 	tests := map[string]struct {
 		action   string
 		name     string
@@ -29,6 +43,12 @@ func TestParseClientError(t *testing.T) {
 			err:      errors.New("other error"),
 			expected: "Unable to create lidarr_tag, got error: other error",
 		},
+		"database locked": {
+			action:   "create",
+			name:     "lidarr_tag",
+			err:      locked,
+			expected: "Unable to create lidarr_tag, got error: 500 Internal Server Error\nDetails:\ndatabase is locked\n" + databaseLockedHint,
+		},
 	}
 	for name, test := range tests {
 		test := test
@@ -40,20 +60,124 @@ func TestParseClientError(t *testing.T) {
 	}
 }
 
+func TestIsNotFound(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	config := lidarr.NewConfiguration()
+	config.Servers[0].URL = server.URL
+	_, notFound, _ := lidarr.NewAPIClient(config).TagAPI.GetTagById(context.Background(), 1).Execute()
+
+	tests := map[string]struct {
+		resp     *http.Response
+		expected bool
+	}{
+		"not found": {
+			resp:     notFound,
+			expected: true,
+		},
+		"server error": {
+			resp:     &http.Response{StatusCode: http.StatusInternalServerError},
+			expected: false,
+		},
+		"no response": {
+			resp:     nil,
+			expected: false,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, IsNotFound(test.resp))
+		})
+	}
+}
+
 func TestParseNotFoundError(t *testing.T) {
 	t.Parallel()
 
+	many := make([]string, 30)
+	for i := range many {
+		many[i] = strconv.Itoa(30 - i)
+	}
+
 	tests := map[string]struct {
-		kind     string
-		field    string
-		search   string
-		expected string
+		kind      string
+		field     string
+		search    string
+		expected  string
+		available []string
 	}{
-		"generic": {
+		"none available": {
 			kind:     "lidarr_tag",
 			field:    "label",
 			search:   "test",
-			expected: "Unable to find lidarr_tag, got error: data source not found: no lidarr_tag with label 'test'",
+			expected: "Unable to find lidarr_tag, got error: data source not found: no lidarr_tag with label 'test', none available",
+		},
+		"sorted": {
+			kind:      "lidarr_tag",
+			field:     "label",
+			search:    "test",
+			available: []string{"music", "flac", "lossy"},
+			expected:  "Unable to find lidarr_tag, got error: data source not found: no lidarr_tag with label 'test', available: 'flac', 'lossy', 'music'",
+		},
+		"capped": {
+			kind:      "lidarr_delay_profile",
+			field:     "id",
+			search:    "31",
+			available: many,
+			expected: "Unable to find lidarr_delay_profile, got error: data source not found: no lidarr_delay_profile with id '31', available: " +
+				"'1', '2', '3', '4', '5', '6', '7', '8', '9', '10', '11', '12', '13', '14', '15', '16', '17', '18', '19', '20', '21', '22', '23', '24', '25' (+5 more)",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, ParseNotFoundError(test.kind, test.field, test.search, test.available))
+		})
+	}
+}
+
+func TestValues(t *testing.T) {
+	t.Parallel()
+
+	tags := []lidarr.TagResource{
+		*lidarr.NewTagResource(),
+		*lidarr.NewTagResource(),
+	}
+	tags[0].SetId(1)
+	tags[0].SetLabel("flac")
+	tags[1].SetId(2)
+	tags[1].SetLabel("lossy")
+
+	assert.Equal(t, []string{"flac", "lossy"}, Values(tags, (*lidarr.TagResource).GetLabel))
+	assert.Equal(t, []string{"1", "2"}, Values(tags, (*lidarr.TagResource).GetId))
+}
+
+func TestParseValidationFailures(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		body     string
+		expected string
+	}{
+		"failures": {
+			body:     `[{"propertyName":"BaseUrl","errorMessage":"Unable to connect to indexer"},{"propertyName":"","errorMessage":"Test was aborted"}]`,
+			expected: "- BaseUrl: Unable to connect to indexer\n- Test was aborted",
+		},
+		"not validation": {
+			body:     `{"message":"NotFound"}`,
+			expected: "",
+		},
+		"empty": {
+			body:     "",
+			expected: "",
 		},
 	}
 	for name, test := range tests {
@@ -61,7 +185,7 @@ func TestParseNotFoundError(t *testing.T) {
 
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
-			assert.Equal(t, test.expected, ParseNotFoundError(test.kind, test.field, test.search))
+			assert.Equal(t, test.expected, parseValidationFailures([]byte(test.body)))
 		})
 	}
 }
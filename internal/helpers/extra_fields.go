@@ -0,0 +1,82 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ validator.Map = extraFieldsValidator{}
+
+// ExtraFieldsAttribute returns the schema of the extra_fields attribute of the typed resources.
+func ExtraFieldsAttribute() schema.MapAttribute {
+	return schema.MapAttribute{
+		MarkdownDescription: "Raw field values for settings not covered by other attributes, keyed by API field name. Values are converted to the type of the Lidarr field, lists are written as JSON. Settings not managed by the provider are kept on update.",
+		Optional:            true,
+		ElementType:         types.StringType,
+		Validators: []validator.Map{
+			UnmanagedFields(),
+		},
+	}
+}
+
+// UnmanagedFields returns a validator which rejects the raw fields managed by another attribute of the resource.
+// Managed fields are written to their attribute only, so they could not be read back into the map.
+func UnmanagedFields() validator.Map {
+	return extraFieldsValidator{}
+}
+
+// extraFieldsValidator rejects the extra fields managed by a typed attribute of the same resource.
+type extraFieldsValidator struct{}
+
+func (v extraFieldsValidator) Description(_ context.Context) string {
+	return "keys must not be managed by other attributes"
+}
+
+func (v extraFieldsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v extraFieldsValidator) ValidateMap(_ context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	attributes := req.Config.Schema.GetAttributes()
+
+	for key := range req.ConfigValue.Elements() {
+		name := normalizeName(selectTFName(key))
+
+		for attribute := range attributes {
+			if normalizeName(attribute) == name && !req.Path.Equal(req.Path.ParentPath().AtName(attribute)) {
+				resp.Diagnostics.AddAttributeError(
+					req.Path.AtMapKey(key),
+					"Conflicting Extra Field",
+					fmt.Sprintf("Field %s is managed by the %s attribute, set it there instead.", key, attribute),
+				)
+			}
+		}
+	}
+}
+
+// PreserveFields appends the current fields not managed by the field lists and missing in the request.
+// This prevents the update of a resource from resetting the settings added by newer Lidarr versions.
+func PreserveFields(fields []lidarr.Field, current []lidarr.Field, fieldLists Fields) []lidarr.Field {
+	for _, c := range current {
+		name := c.GetName()
+
+		if fieldLists.contains(name) || c.GetValue() == nil || c.GetValue() == SensitiveValue ||
+			slices.ContainsFunc(fields, func(f lidarr.Field) bool { return f.GetName() == name }) {
+			continue
+		}
+
+		fields = append(fields, setField(name, c.GetValue()))
+	}
+
+	return fields
+}
@@ -0,0 +1,80 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtraFieldsValidator(t *testing.T) {
+	t.Parallel()
+
+	config := tfsdk.Config{
+		Schema: schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"extra_fields": ExtraFieldsAttribute(),
+				"app_token":    schema.StringAttribute{Optional: true},
+				"field_tags":   schema.SetAttribute{Optional: true, ElementType: types.StringType},
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		value types.Map
+		err   bool
+	}{
+		"null": {
+			value: types.MapNull(types.StringType),
+		},
+		"new field": {
+			value: types.MapValueMust(types.StringType, map[string]attr.Value{"newSetting": types.StringValue("1")}),
+		},
+		"typed field": {
+			value: types.MapValueMust(types.StringType, map[string]attr.Value{"appToken": types.StringValue("token")}),
+			err:   true,
+		},
+		"field exception": {
+			value: types.MapValueMust(types.StringType, map[string]attr.Value{"tags": types.StringValue(`["music"]`)}),
+			err:   true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := validator.MapResponse{}
+			extraFieldsValidator{}.ValidateMap(context.Background(), validator.MapRequest{
+				Path:        path.Root("extra_fields"),
+				ConfigValue: test.value,
+				Config:      config,
+			}, &resp)
+			assert.Equal(t, test.err, resp.Diagnostics.HasError())
+		})
+	}
+}
+
+func TestPreserveFields(t *testing.T) {
+	t.Parallel()
+
+	fields := []lidarr.Field{setField("appToken", "token")}
+	current := []lidarr.Field{
+		setField("appToken", "old"),
+		setField("priority", float64(5)),
+		setField("newSetting", true),
+		setField("newSecret", SensitiveValue),
+		*lidarr.NewField(),
+	}
+
+	expected := []lidarr.Field{setField("appToken", "token"), setField("newSetting", true)}
+	assert.Equal(t, expected, PreserveFields(fields, current, Fields{Strings: []string{"appToken"}, Ints: []string{"priority"}}))
+}
@@ -2,18 +2,45 @@ package helpers
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"slices"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 const SensitiveValue = "********"
 
+// define the Lidarr field types whose value shape depends on the type metadata.
+const (
+	fieldTypeSelect       = "select"
+	fieldTypeTagSelect    = "tagSelect"
+	fieldTypeTag          = "tag"
+	fieldTypeDevice       = "device"
+	fieldTypePlaylist     = "playlist"
+	fieldTypeNumber       = "number"
+	fieldTypeCheckbox     = "checkbox"
+	fieldTypeKeyValueList = "keyValueList"
+)
+
+// mapFieldKind describes the JSON type of a raw field value.
+type mapFieldKind int
+
+// define the JSON types of the raw field values.
+const (
+	mapFieldString mapFieldKind = iota
+	mapFieldNumber
+	mapFieldBool
+	mapFieldJSON
+)
+
 type fieldException struct {
 	apiName string
 	tfName  string
@@ -88,9 +115,78 @@ func setField(name string, value interface{}) lidarr.Field {
 	return field
 }
 
+// normalizeFieldValue converts a lidarr field value to the shape expected for its type.
+// Select options and tag selects hold numbers, select without options are string enums,
+// while tag, device and playlist pickers hold strings. Single values are wrapped into lists.
+func normalizeFieldValue(field *lidarr.Field) interface{} {
+	value := field.GetValue()
+
+	switch field.GetType() {
+	case fieldTypeSelect:
+		if len(field.GetSelectOptions()) == 0 {
+			return value
+		}
+
+		return fieldNumber(value)
+	case fieldTypeTagSelect:
+		return fieldSlice(value, fieldNumber)
+	case fieldTypeTag, fieldTypeDevice, fieldTypePlaylist:
+		return fieldSlice(value, fieldString)
+	default:
+		return value
+	}
+}
+
+// fieldSlice converts each element of a multi value field, wrapping single values.
+func fieldSlice(value interface{}, convert func(interface{}) interface{}) interface{} {
+	var elements []interface{}
+
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		elements = v
+	case string:
+		if v == "" {
+			return []interface{}{}
+		}
+
+		elements = []interface{}{v}
+	default:
+		elements = []interface{}{v}
+	}
+
+	output := make([]interface{}, len(elements))
+	for i, e := range elements {
+		output[i] = convert(e)
+	}
+
+	return output
+}
+
+// fieldNumber converts numeric strings into numbers, as returned by the JSON decoding.
+func fieldNumber(value interface{}) interface{} {
+	if stringValue, ok := value.(string); ok {
+		if number, err := strconv.ParseFloat(stringValue, 64); err == nil {
+			return number
+		}
+	}
+
+	return value
+}
+
+// fieldString converts numbers into strings without exponent.
+func fieldString(value interface{}) interface{} {
+	if number, ok := value.(float64); ok {
+		return strconv.FormatFloat(number, 'f', -1, 64)
+	}
+
+	return value
+}
+
 // writeStringField writes a lidarr string field into struct field.
 func writeStringField(fieldOutput *lidarr.Field, fieldCase interface{}) {
-	stringValue := fmt.Sprint(fieldOutput.GetValue())
+	stringValue := fmt.Sprint(fieldString(fieldOutput.GetValue()))
 
 	v := reflect.ValueOf(types.StringValue(stringValue))
 	if fieldOutput.GetValue() == nil {
@@ -114,10 +210,11 @@ func writeBoolField(fieldOutput *lidarr.Field, fieldCase interface{}) {
 
 // writeIntField writes a lidarr int field into struct field.
 func writeIntField(fieldOutput *lidarr.Field, fieldCase interface{}) {
-	intValue, _ := fieldOutput.GetValue().(float64)
+	// Lidarr omits unset numbers or sends them as empty strings: keep them null instead of 0.
+	intValue, ok := fieldOutput.GetValue().(float64)
 
 	v := reflect.ValueOf(types.Int64Value(int64(intValue)))
-	if fieldOutput.GetValue() == nil {
+	if !ok {
 		v = reflect.ValueOf(types.Int64Null())
 	}
 
@@ -126,16 +223,32 @@ func writeIntField(fieldOutput *lidarr.Field, fieldCase interface{}) {
 
 // writeFloatField writes a lidarr float field into struct field.
 func writeFloatField(fieldOutput *lidarr.Field, fieldCase interface{}) {
-	floatValue, _ := fieldOutput.GetValue().(float64)
+	floatValue, ok := fieldOutput.GetValue().(float64)
 
-	v := reflect.ValueOf(types.Float64Value(floatValue))
-	if fieldOutput.GetValue() == nil {
+	v := reflect.ValueOf(types.Float64Value(normalizeFloat(floatValue)))
+	if !ok {
 		v = reflect.ValueOf(types.Float64Null())
 	}
 
 	selectWriteField(fieldOutput, fieldCase).Set(v)
 }
 
+// normalizeFloat drops the precision noise Lidarr adds when storing floats (e.g. 2.299999952316284 for 2.3),
+// so that the value in state matches the configured one.
+// Only values exactly stored as float32 are rounded, any other value is kept as is.
+func normalizeFloat(value float64) float64 {
+	if float64(float32(value)) != value {
+		return value
+	}
+
+	normalized, err := strconv.ParseFloat(strconv.FormatFloat(value, 'g', -1, 32), 64)
+	if err != nil {
+		return value
+	}
+
+	return normalized
+}
+
 // writeStringSliceField writes a lidarr string slice field into struct field.
 func writeStringSliceField(ctx context.Context, fieldOutput *lidarr.Field, fieldCase interface{}) {
 	sliceValue, _ := fieldOutput.GetValue().([]interface{})
@@ -256,6 +369,222 @@ func (f Fields) getList(list string) []string {
 	return output
 }
 
+// contains checks if a field name is managed by any of the lists.
+func (f Fields) contains(name string) bool {
+	r := reflect.ValueOf(f)
+	for i := 0; i < r.NumField(); i++ {
+		if list, ok := r.Field(i).Interface().([]string); ok && slices.Contains(list, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// fieldValueKind returns the JSON type of a lidarr field value, false if the value is empty.
+func fieldValueKind(value interface{}) (mapFieldKind, bool) {
+	switch value.(type) {
+	case string:
+		return mapFieldString, true
+	case float64, int64, int32, int:
+		return mapFieldNumber, true
+	case bool:
+		return mapFieldBool, true
+	case []interface{}, map[string]interface{}:
+		return mapFieldJSON, true
+	default:
+		return mapFieldString, false
+	}
+}
+
+// definitionKind returns the JSON type expected by a lidarr field definition.
+// The default value of the definition wins, the field type is used for definitions without it.
+func definitionKind(definition *lidarr.Field) mapFieldKind {
+	if kind, ok := fieldValueKind(normalizeFieldValue(definition)); ok {
+		return kind
+	}
+
+	switch definition.GetType() {
+	case fieldTypeNumber:
+		return mapFieldNumber
+	case fieldTypeCheckbox:
+		return mapFieldBool
+	case fieldTypeSelect:
+		if len(definition.GetSelectOptions()) == 0 {
+			return mapFieldString
+		}
+
+		return mapFieldNumber
+	case fieldTypeTagSelect, fieldTypeTag, fieldTypeDevice, fieldTypePlaylist, fieldTypeKeyValueList:
+		return mapFieldJSON
+	default:
+		return mapFieldString
+	}
+}
+
+// parseMapFieldValue converts a raw map value into a lidarr field value of the given kind.
+// Lists and objects are written as JSON.
+func parseMapFieldValue(value string, kind mapFieldKind) (interface{}, error) {
+	switch kind {
+	case mapFieldNumber:
+		return strconv.ParseFloat(value, 64)
+	case mapFieldBool:
+		return strconv.ParseBool(value)
+	case mapFieldJSON:
+		var decoded interface{}
+		if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+			return nil, err
+		}
+
+		return decoded, nil
+	default:
+		return value, nil
+	}
+}
+
+// encodeMapFieldValue converts a lidarr field value into a raw map value.
+// Strings are kept as they are, anything else is JSON encoded.
+func encodeMapFieldValue(value interface{}) string {
+	if stringValue, ok := value.(string); ok {
+		return stringValue
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+
+	return string(encoded)
+}
+
+// mapFieldValueEqual checks if a raw map value represents the given lidarr field value, e.g. "1.0" and 1.
+func mapFieldValueEqual(raw string, value interface{}) bool {
+	kind, ok := fieldValueKind(value)
+	if !ok {
+		return false
+	}
+
+	parsed, err := parseMapFieldValue(raw, kind)
+	if err != nil {
+		return false
+	}
+
+	if number, ok := parsed.(float64); ok {
+		return fmt.Sprint(number) == fmt.Sprint(fieldNumber(value))
+	}
+
+	return reflect.DeepEqual(parsed, value)
+}
+
+// ReadMapFields takes in input a map of raw field values and populates a lidarr.Field slice.
+// Values are kept as strings, TypeMapFields converts them to the type expected by Lidarr.
+func ReadMapFields(ctx context.Context, fieldMap types.Map) []lidarr.Field {
+	if fieldMap.IsNull() || fieldMap.IsUnknown() {
+		return nil
+	}
+
+	values := make(map[string]string, len(fieldMap.Elements()))
+	fieldMap.ElementsAs(ctx, &values, false)
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	output := make([]lidarr.Field, len(names))
+	for i, name := range names {
+		output[i] = setField(name, values[name])
+	}
+
+	return output
+}
+
+// TypeMapFields converts the raw values of the named fields to the type of the matching lidarr field definition.
+// Values are never guessed from their content: fields without definition are sent as strings.
+func TypeMapFields(fields []lidarr.Field, names []string, definitions []lidarr.Field) ([]lidarr.Field, error) {
+	output := slices.Clone(fields)
+
+	for i := range output {
+		name := output[i].GetName()
+
+		raw, ok := output[i].GetValue().(string)
+		if !ok || !slices.Contains(names, name) {
+			continue
+		}
+
+		index := slices.IndexFunc(definitions, func(d lidarr.Field) bool { return d.GetName() == name })
+		if index < 0 {
+			continue
+		}
+
+		value, err := parseMapFieldValue(raw, definitionKind(&definitions[index]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for field %s: %w", name, err)
+		}
+
+		output[i].SetValue(value)
+	}
+
+	return output, nil
+}
+
+// WriteMapFields takes in input a lidarr.Field slice and returns the raw values of the fields not managed by the lists.
+// If the current map is known, only its keys are written back to keep state consistent with configuration,
+// keeping the configured values equivalent to the ones returned by Lidarr.
+func WriteMapFields(ctx context.Context, fields []lidarr.Field, fieldLists Fields, current types.Map) types.Map {
+	known := !current.IsNull() && !current.IsUnknown()
+	previous := make(map[string]string)
+
+	if known {
+		current.ElementsAs(ctx, &previous, false)
+	}
+
+	output := make(map[string]attr.Value)
+
+	for _, f := range fields {
+		name := f.GetName()
+		configured, isConfigured := previous[name]
+
+		if (known && !isConfigured) || (!known && fieldLists.contains(name)) {
+			continue
+		}
+
+		// Manage sensitive and empty data.
+		if f.GetValue() == nil || f.GetValue() == SensitiveValue {
+			continue
+		}
+
+		if isConfigured && mapFieldValueEqual(configured, f.GetValue()) {
+			output[name] = types.StringValue(configured)
+
+			continue
+		}
+
+		output[name] = types.StringValue(encodeMapFieldValue(f.GetValue()))
+	}
+
+	// Keep configured values not returned by the API.
+	for name, value := range previous {
+		if _, ok := output[name]; !ok {
+			output[name] = types.StringValue(value)
+		}
+	}
+
+	return types.MapValueMust(types.StringType, output)
+}
+
+// MergeFields appends the overrides to a lidarr.Field slice, replacing the fields with the same name.
+func MergeFields(fields []lidarr.Field, overrides []lidarr.Field) []lidarr.Field {
+	for _, o := range overrides {
+		fields = slices.DeleteFunc(fields, func(f lidarr.Field) bool { return f.GetName() == o.GetName() })
+		fields = append(fields, o)
+	}
+
+	return fields
+}
+
 // ReadFields takes in input a field container and populates a lidarr.Field slice.
 func ReadFields(ctx context.Context, fieldContainer interface{}, fieldLists Fields) []lidarr.Field {
 	var output []lidarr.Field
@@ -315,6 +644,8 @@ func WriteFields(ctx context.Context, fieldContainer interface{}, fields []lidar
 	// Loop over each field and populate the related container field with the corresponding write function.
 	for _, f := range fields {
 		fieldName := f.GetName()
+		f.SetValue(normalizeFieldValue(&f))
+
 		// Manage sensitive data.
 		if f.GetValue() == SensitiveValue {
 			if tempField := readStringField(fieldName, fieldContainer); tempField.GetValue() != nil {
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
@@ -101,27 +102,49 @@ func TestWriteBoolField(t *testing.T) {
 func TestWriteIntField(t *testing.T) {
 	t.Parallel()
 
-	value := float64(50)
-
 	tests := map[string]struct {
 		// use float to simulate unmarshal response
-		value    *float64
+		value    interface{}
 		name     string
 		written  Test
 		expected Test
 	}{
 		"working": {
 			name:     "in",
-			value:    &value,
+			value:    float64(50),
 			written:  Test{},
 			expected: Test{In: types.Int64Value(50)},
 		},
+		"zero": {
+			name:     "in",
+			value:    float64(0),
+			written:  Test{},
+			expected: Test{In: types.Int64Value(0)},
+		},
 		"seedtime": {
 			name:     "seedCriteria.seedTime",
-			value:    &value,
+			value:    float64(50),
 			written:  Test{},
 			expected: Test{SeedTime: types.Int64Value(50)},
 		},
+		"seedtime zero": {
+			name:     "seedCriteria.seedTime",
+			value:    float64(0),
+			written:  Test{},
+			expected: Test{SeedTime: types.Int64Value(0)},
+		},
+		"seedtime unset": {
+			name:     "seedCriteria.seedTime",
+			value:    nil,
+			written:  Test{SeedTime: types.Int64Value(10)},
+			expected: Test{SeedTime: types.Int64Null()},
+		},
+		"empty string": {
+			name:     "in",
+			value:    "",
+			written:  Test{},
+			expected: Test{In: types.Int64Null()},
+		},
 		"nil": {
 			name:     "in",
 			value:    nil,
@@ -137,7 +160,7 @@ func TestWriteIntField(t *testing.T) {
 
 			field := lidarr.NewField()
 			if test.value != nil {
-				field.SetValue(*test.value)
+				field.SetValue(test.value)
 			}
 
 			field.SetName(test.name)
@@ -147,10 +170,58 @@ func TestWriteIntField(t *testing.T) {
 	}
 }
 
+func TestNormalizeFloat(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value    float64
+		expected float64
+	}{
+		"half": {
+			value:    0.5,
+			expected: 0.5,
+		},
+		"integer": {
+			value:    1.0,
+			expected: 1,
+		},
+		"decimal": {
+			value:    2.75,
+			expected: 2.75,
+		},
+		"single precision": {
+			value:    float64(float32(2.3)),
+			expected: 2.3,
+		},
+		"zero": {
+			value:    0,
+			expected: 0,
+		},
+		"double precision": {
+			value:    1.23456789012,
+			expected: 1.23456789012,
+		},
+		"above single range": {
+			value:    1e300,
+			expected: 1e300,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, normalizeFloat(test.value))
+		})
+	}
+}
+
 func TestWriteFloatField(t *testing.T) {
 	t.Parallel()
 
 	value := float64(3.5)
+	drift := float64(float32(2.3))
+	zero := float64(0)
 
 	tests := map[string]struct {
 		value    *float64
@@ -162,7 +233,20 @@ func TestWriteFloatField(t *testing.T) {
 			written:  Test{},
 			expected: Test{Fl: types.Float64Value(value)},
 		},
-		"nil": {},
+		"drift": {
+			value:    &drift,
+			written:  Test{},
+			expected: Test{Fl: types.Float64Value(2.3)},
+		},
+		"zero": {
+			value:    &zero,
+			written:  Test{},
+			expected: Test{Fl: types.Float64Value(0)},
+		},
+		"nil": {
+			written:  Test{},
+			expected: Test{Fl: types.Float64Null()},
+		},
 	}
 	for name, test := range tests {
 		test := test
@@ -290,11 +374,14 @@ func TestReadStringField(t *testing.T) {
 func TestReadIntField(t *testing.T) {
 	t.Parallel()
 
+	zero := 0
+	ten := 10
+
 	tests := map[string]struct {
 		name      string
 		tfName    string
 		fieldCase Test
-		value     int
+		value     *int
 	}{
 		"working": {
 			fieldCase: Test{
@@ -302,13 +389,27 @@ func TestReadIntField(t *testing.T) {
 			},
 			name:   "in",
 			tfName: "in",
-			value:  10,
+			value:  &ten,
+		},
+		"zero": {
+			fieldCase: Test{
+				In: types.Int64Value(0),
+			},
+			name:   "in",
+			tfName: "in",
+			value:  &zero,
 		},
 		"nil": {
 			fieldCase: Test{},
 			name:      "in",
 			tfName:    "in",
-			value:     0,
+		},
+		"unknown": {
+			fieldCase: Test{
+				In: types.Int64Unknown(),
+			},
+			name:   "in",
+			tfName: "in",
 		},
 		"seedtime": {
 			fieldCase: Test{
@@ -316,16 +417,31 @@ func TestReadIntField(t *testing.T) {
 			},
 			name:   "seedCriteria.seedTime",
 			tfName: "seedTime",
-			value:  10,
+			value:  &ten,
+		},
+		"seedtime zero": {
+			fieldCase: Test{
+				SeedTime: types.Int64Value(0),
+			},
+			name:   "seedCriteria.seedTime",
+			tfName: "seedTime",
+			value:  &zero,
+		},
+		"seedtime unset": {
+			fieldCase: Test{
+				SeedTime: types.Int64Null(),
+			},
+			name:   "seedCriteria.seedTime",
+			tfName: "seedTime",
 		},
 	}
 	for name, test := range tests {
 		test := test
 
 		expected := *lidarr.NewField()
-		if test.value != 0 {
+		if test.value != nil {
 			expected.SetName(test.name)
-			expected.SetValue(int64(test.value))
+			expected.SetValue(int64(*test.value))
 		}
 
 		t.Run(name, func(t *testing.T) {
@@ -657,3 +773,386 @@ func TestWriteFields(t *testing.T) {
 		})
 	}
 }
+
+func TestNormalizeFieldValue(t *testing.T) {
+	t.Parallel()
+
+	options := []lidarr.SelectOption{{Value: lidarr.PtrInt32(1)}}
+
+	tests := map[string]struct {
+		value     interface{}
+		expected  interface{}
+		fieldType string
+		options   []lidarr.SelectOption
+	}{
+		"select option": {
+			fieldType: "select",
+			options:   options,
+			value:     float64(1),
+			expected:  float64(1),
+		},
+		"select option string": {
+			fieldType: "select",
+			options:   options,
+			value:     "1",
+			expected:  float64(1),
+		},
+		"select string enum": {
+			fieldType: "select",
+			value:     "high",
+			expected:  "high",
+		},
+		"tag select": {
+			fieldType: "tagSelect",
+			value:     []interface{}{float64(1), "2"},
+			expected:  []interface{}{float64(1), float64(2)},
+		},
+		"tag select single": {
+			fieldType: "tagSelect",
+			value:     float64(3),
+			expected:  []interface{}{float64(3)},
+		},
+		"tag": {
+			fieldType: "tag",
+			value:     []interface{}{"music", float64(1000000)},
+			expected:  []interface{}{"music", "1000000"},
+		},
+		"device single": {
+			fieldType: "device",
+			value:     "phone",
+			expected:  []interface{}{"phone"},
+		},
+		"device empty": {
+			fieldType: "device",
+			value:     "",
+			expected:  []interface{}{},
+		},
+		"playlist nil": {
+			fieldType: "playlist",
+			value:     nil,
+			expected:  nil,
+		},
+		"textbox": {
+			fieldType: "textbox",
+			value:     float64(1),
+			expected:  float64(1),
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			field := lidarr.NewField()
+			field.SetType(test.fieldType)
+			field.SetValue(test.value)
+			field.SetSelectOptions(test.options)
+			assert.Equal(t, test.expected, normalizeFieldValue(field))
+		})
+	}
+}
+
+// FieldTypes is the container for the field type round trip test.
+type FieldTypes struct {
+	Topics     types.Set
+	DeviceIds  types.Set
+	GrabFields types.Set
+	Sound      types.String
+	Priority   types.Int64
+}
+
+func TestFieldTypesRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	fieldLists := Fields{
+		Ints:         []string{"priority"},
+		Strings:      []string{"sound"},
+		StringSlices: []string{"topics", "deviceIds"},
+		IntSlices:    []string{"grabFields"},
+	}
+	options := []lidarr.SelectOption{{Value: lidarr.PtrInt32(1)}, {Value: lidarr.PtrInt32(2)}}
+
+	tests := map[string]struct {
+		value     interface{}
+		expected  interface{}
+		name      string
+		fieldType string
+		options   []lidarr.SelectOption
+	}{
+		"select": {
+			name:      "priority",
+			fieldType: "select",
+			options:   options,
+			value:     "2",
+			expected:  int64(2),
+		},
+		"select string enum": {
+			name:      "sound",
+			fieldType: "select",
+			value:     "bike",
+			expected:  "bike",
+		},
+		"tag": {
+			name:      "topics",
+			fieldType: "tag",
+			value:     []interface{}{"lidarr", float64(1)},
+			expected:  []string{"1", "lidarr"},
+		},
+		"device": {
+			name:      "deviceIds",
+			fieldType: "device",
+			value:     "phone",
+			expected:  []string{"phone"},
+		},
+		"tag select": {
+			name:      "grabFields",
+			fieldType: "tagSelect",
+			options:   options,
+			value:     []interface{}{"1", float64(2)},
+			expected:  []int64{1, 2},
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			field := lidarr.NewField()
+			field.SetName(test.name)
+			field.SetType(test.fieldType)
+			field.SetValue(test.value)
+			field.SetSelectOptions(test.options)
+
+			container := FieldTypes{
+				Topics:     types.SetNull(types.StringType),
+				DeviceIds:  types.SetNull(types.StringType),
+				GrabFields: types.SetNull(types.Int64Type),
+			}
+			WriteFields(context.Background(), &container, []lidarr.Field{*field}, fieldLists)
+
+			fields := ReadFields(context.Background(), &container, fieldLists)
+			if !assert.Len(t, fields, 1) {
+				return
+			}
+
+			assert.Equal(t, test.name, fields[0].GetName())
+
+			// sets have no order
+			switch value := fields[0].GetValue().(type) {
+			case []string:
+				assert.ElementsMatch(t, test.expected, value)
+			case []int64:
+				assert.ElementsMatch(t, test.expected, value)
+			default:
+				assert.Equal(t, test.expected, value)
+			}
+		})
+	}
+}
+
+func TestWriteFieldsMasked(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		current  types.String
+		expected types.String
+	}{
+		"prior": {
+			current:  types.StringValue("Secret"),
+			expected: types.StringValue("Secret"),
+		},
+		"null": {
+			current:  types.StringNull(),
+			expected: types.StringValue(SensitiveValue),
+		},
+		"unknown": {
+			current:  types.StringUnknown(),
+			expected: types.StringValue(SensitiveValue),
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// emulate a Lidarr response with a masked secret among other fields
+			fields := []lidarr.Field{
+				setField("str", SensitiveValue),
+				setField("in", float64(55)),
+				setField("boo", true),
+			}
+
+			container := Test{Str: test.current}
+			WriteFields(context.TODO(), &container, fields, Fields{Strings: []string{"str"}, Ints: []string{"in"}, Bools: []string{"boo"}})
+			assert.Equal(t, Test{Str: test.expected, In: types.Int64Value(55), Boo: types.BoolValue(true)}, container)
+		})
+	}
+}
+
+func TestReadMapFields(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		fieldMap types.Map
+		expected []lidarr.Field
+	}{
+		"null": {
+			fieldMap: types.MapNull(types.StringType),
+			expected: nil,
+		},
+		"values": {
+			fieldMap: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"str":  types.StringValue("string"),
+				"in":   types.StringValue("5"),
+				"boo":  types.StringValue("true"),
+				"list": types.StringValue("[1,2]"),
+			}),
+			expected: []lidarr.Field{
+				setField("boo", "true"),
+				setField("in", "5"),
+				setField("list", "[1,2]"),
+				setField("str", "string"),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			fields := ReadMapFields(context.Background(), test.fieldMap)
+			assert.Equal(t, test.expected, fields)
+		})
+	}
+}
+
+func TestWriteMapFields(t *testing.T) {
+	t.Parallel()
+
+	fields := []lidarr.Field{
+		setField("str", "string"),
+		setField("in", float64(5)),
+		setField("object", map[string]interface{}{"key": "value"}),
+		setField("password", SensitiveValue),
+		setField("empty", nil),
+	}
+
+	tests := map[string]struct {
+		current  types.Map
+		expected types.Map
+	}{
+		"unknown": {
+			current: types.MapUnknown(types.StringType),
+			expected: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"in":     types.StringValue("5"),
+				"object": types.StringValue(`{"key":"value"}`),
+			}),
+		},
+		"configured": {
+			current: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"str":      types.StringValue("old"),
+				"password": types.StringValue("secret"),
+			}),
+			expected: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"str":      types.StringValue("string"),
+				"password": types.StringValue("secret"),
+			}),
+		},
+		"equivalent": {
+			current: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"in":     types.StringValue("5.0"),
+				"object": types.StringValue(`{ "key": "value" }`),
+			}),
+			expected: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"in":     types.StringValue("5.0"),
+				"object": types.StringValue(`{ "key": "value" }`),
+			}),
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			output := WriteMapFields(context.Background(), fields, Fields{Strings: []string{"str"}}, test.current)
+			assert.Equal(t, test.expected, output)
+		})
+	}
+}
+
+func TestTypeMapFields(t *testing.T) {
+	t.Parallel()
+
+	definition := func(name, fieldType string, value interface{}) lidarr.Field {
+		field := setField(name, value)
+		field.SetType(fieldType)
+
+		return field
+	}
+
+	definitions := []lidarr.Field{
+		definition("text", "textbox", ""),
+		definition("port", "number", float64(9091)),
+		definition("empty", "number", nil),
+		definition("check", "checkbox", false),
+		definition("tags", "tag", []interface{}{}),
+		definition("layout", "select", float64(0)),
+	}
+
+	tests := map[string]struct {
+		fields   []lidarr.Field
+		names    []string
+		expected []lidarr.Field
+		err      bool
+	}{
+		"strings": {
+			fields:   []lidarr.Field{setField("text", "123"), setField("unknown", "true")},
+			names:    []string{"text", "unknown"},
+			expected: []lidarr.Field{setField("text", "123"), setField("unknown", "true")},
+		},
+		"typed": {
+			fields:   []lidarr.Field{setField("port", "9092"), setField("empty", "1.5"), setField("check", "true"), setField("tags", `["a","b"]`), setField("layout", "2")},
+			names:    []string{"port", "empty", "check", "tags", "layout"},
+			expected: []lidarr.Field{setField("port", float64(9092)), setField("empty", 1.5), setField("check", true), setField("tags", []interface{}{"a", "b"}), setField("layout", float64(2))},
+		},
+		"not from map": {
+			fields:   []lidarr.Field{setField("port", "9092")},
+			names:    []string{"text"},
+			expected: []lidarr.Field{setField("port", "9092")},
+		},
+		"invalid": {
+			fields: []lidarr.Field{setField("port", "abc")},
+			names:  []string{"port"},
+			err:    true,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			fields, err := TypeMapFields(test.fields, test.names, definitions)
+			assert.Equal(t, test.err, err != nil)
+			assert.Equal(t, test.expected, fields)
+		})
+	}
+}
+
+func TestMergeFields(t *testing.T) {
+	t.Parallel()
+
+	fields := []lidarr.Field{setField("str", "string"), setField("in", int64(1))}
+	expected := []lidarr.Field{setField("in", int64(1)), setField("str", "override"), setField("boo", true)}
+
+	assert.Equal(t, expected, MergeFields(fields, []lidarr.Field{setField("str", "override"), setField("boo", true)}))
+}
@@ -0,0 +1,149 @@
+package helpers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// LogTransport is an http.RoundTripper logging requests and responses at debug level with their secrets redacted.
+type LogTransport struct {
+	Base http.RoundTripper
+	// Ctx carries the Terraform logger, since requests are made with a context detached from it.
+	Ctx context.Context
+	// Sensitive lists the names of the secret values, matched ignoring case and underscores.
+	Sensitive []string
+}
+
+var (
+	sensitivePatterns = []string{"password", "token", "apikey", "secret"}
+	sensitiveHeaders  = []string{"X-Api-Key", "Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+)
+
+func (t *LogTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	// A RoundTripper must not modify the original request
+	req = req.Clone(req.Context())
+
+	body, err := readBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Debug(t.Ctx, "Lidarr request", map[string]interface{}{
+		"method":  req.Method,
+		"url":     req.URL.String(),
+		"headers": redactHeaders(req.Header),
+		"body":    t.redact(body),
+	})
+
+	resp, err := base.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	body, err = readBody(&resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Debug(t.Ctx, "Lidarr response", map[string]interface{}{
+		"method": req.Method,
+		"url":    req.URL.String(),
+		"status": resp.StatusCode,
+		"body":   t.redact(body),
+	})
+
+	return resp, nil
+}
+
+// readBody reads the whole body and replaces it with a copy, so that it can be read again.
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+
+	content, err := io.ReadAll(*body)
+	(*body).Close()
+
+	*body = io.NopCloser(bytes.NewReader(content))
+
+	return content, err
+}
+
+// redactHeaders returns a copy of the headers with the authentication ones redacted.
+func redactHeaders(headers http.Header) http.Header {
+	redacted := headers.Clone()
+
+	for _, name := range sensitiveHeaders {
+		if redacted.Get(name) != "" {
+			redacted.Set(name, SensitiveValue)
+		}
+	}
+
+	return redacted
+}
+
+// redact returns the JSON body with the sensitive values redacted.
+func (t *LogTransport) redact(body []byte) string {
+	var content interface{}
+	if err := json.Unmarshal(body, &content); err != nil {
+		return string(body)
+	}
+
+	redacted, _ := json.Marshal(t.redactValue(content))
+
+	return string(redacted)
+}
+
+func (t *LogTransport) redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		// Lidarr fields are name/value pairs
+		if name, ok := v["name"].(string); ok && t.isSensitive(name) && v["value"] != nil {
+			v["value"] = SensitiveValue
+		}
+
+		for key, item := range v {
+			if item != nil && t.isSensitive(key) {
+				v[key] = SensitiveValue
+			} else {
+				v[key] = t.redactValue(item)
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = t.redactValue(item)
+		}
+	}
+
+	return value
+}
+
+// isSensitive checks if a name matches a sensitive pattern or one of the sensitive names.
+func (t *LogTransport) isSensitive(name string) bool {
+	name = normalizeName(name)
+
+	for _, pattern := range sensitivePatterns {
+		if strings.Contains(name, pattern) {
+			return true
+		}
+	}
+
+	return slices.ContainsFunc(t.Sensitive, func(s string) bool { return normalizeName(s) == name })
+}
+
+// normalizeName makes snake case TF names comparable to camel case API ones.
+func normalizeName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
@@ -0,0 +1,77 @@
+package helpers
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogTransportRedact(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		body     string
+		expected string
+	}{
+		"not json": {
+			body:     "plain",
+			expected: "plain",
+		},
+		"pattern": {
+			body:     `{"name":"test","password":"secret","accessToken":"token"}`,
+			expected: `{"accessToken":"********","name":"test","password":"********"}`,
+		},
+		"sensitive name": {
+			body:     `{"userKey":"key","priority":1}`,
+			expected: `{"priority":1,"userKey":"********"}`,
+		},
+		"fields": {
+			body:     `{"fields":[{"name":"botToken","value":"token"},{"name":"chatId","value":"chat"}]}`,
+			expected: `{"fields":[{"name":"botToken","value":"********"},{"name":"chatId","value":"chat"}]}`,
+		},
+		"null": {
+			body:     `[{"apiKey":null}]`,
+			expected: `[{"apiKey":null}]`,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			transport := &LogTransport{Sensitive: []string{"user_key"}}
+			assert.Equal(t, test.expected, transport.redact([]byte(test.body)))
+		})
+	}
+}
+
+func TestLogTransport(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, `{"password":"secret"}`, string(body))
+		assert.Equal(t, "key", r.Header.Get("X-Api-Key"))
+
+		_, _ = w.Write([]byte(`{"id":1}`))
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &LogTransport{Ctx: context.Background()}}
+	req, _ := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(`{"password":"secret"}`))
+	req.Header.Set("X-Api-Key", "key")
+
+	resp, err := client.Do(req)
+	assert.NoError(t, err)
+
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Equal(t, `{"id":1}`, string(body))
+	assert.Equal(t, SensitiveValue, redactHeaders(req.Header).Get("X-Api-Key"))
+}
@@ -0,0 +1,57 @@
+package helpers
+
+import (
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Lookup selects the element of a singular data source, either by ID or by name.
+type Lookup struct {
+	// Normalize is applied to both names before comparing them, nil for an exact match.
+	Normalize func(string) string
+	Field     string
+	Name      string
+	ID        int64
+	ByID      bool
+}
+
+// NewLookup builds the lookup from the configured ID and name attributes, field being the name attribute.
+func NewLookup(id types.Int64, field string, name types.String) Lookup {
+	return Lookup{
+		Field: field,
+		Name:  name.ValueString(),
+		ID:    id.ValueInt64(),
+		ByID:  !id.IsNull(),
+	}
+}
+
+// Matches reports whether the element with the given ID and name is the one looked up.
+func (l Lookup) Matches(id int32, name string) bool {
+	if l.ByID {
+		return int64(id) == l.ID
+	}
+
+	if l.Normalize != nil {
+		return l.Normalize(name) == l.Normalize(l.Name)
+	}
+
+	return name == l.Name
+}
+
+// NotFound describes the failed lookup of the given kind of element, listing the available names.
+func (l Lookup) NotFound(kind string, names []string) string {
+	if l.ByID {
+		return ParseNotFoundError(kind, "id", strconv.FormatInt(l.ID, 10), names)
+	}
+
+	return ParseNotFoundError(kind, l.Field, l.Name, names)
+}
+
+// IDOrName returns a config validator requiring exactly one of the id and the name attribute.
+func IDOrName(field string) datasource.ConfigValidator {
+	return datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot(field))
+}
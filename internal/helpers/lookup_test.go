@@ -0,0 +1,85 @@
+package helpers
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupMatches(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		lookup   Lookup
+		id       int32
+		name     string
+		expected bool
+	}{
+		"id": {
+			lookup:   NewLookup(types.Int64Value(2), "name", types.StringNull()),
+			id:       2,
+			name:     "Other",
+			expected: true,
+		},
+		"other id": {
+			lookup:   NewLookup(types.Int64Value(2), "name", types.StringNull()),
+			id:       3,
+			name:     "",
+			expected: false,
+		},
+		"name": {
+			lookup:   NewLookup(types.Int64Null(), "name", types.StringValue("Test")),
+			id:       5,
+			name:     "Test",
+			expected: true,
+		},
+		"other name": {
+			lookup:   NewLookup(types.Int64Null(), "name", types.StringValue("Test")),
+			id:       5,
+			name:     "test",
+			expected: false,
+		},
+		"normalized name": {
+			lookup:   Lookup{Normalize: strings.ToLower, Field: "label", Name: "Test"},
+			id:       5,
+			name:     "test",
+			expected: true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, test.lookup.Matches(test.id, test.name))
+		})
+	}
+}
+
+func TestLookupNotFound(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		lookup   Lookup
+		expected string
+	}{
+		"id": {
+			lookup:   NewLookup(types.Int64Value(2), "name", types.StringNull()),
+			expected: "Unable to find lidarr_indexer, got error: data source not found: no lidarr_indexer with id '2', available: 'Newznab', 'Torznab'",
+		},
+		"name": {
+			lookup:   NewLookup(types.Int64Null(), "label", types.StringValue("test")),
+			expected: "Unable to find lidarr_indexer, got error: data source not found: no lidarr_indexer with label 'test', available: 'Newznab', 'Torznab'",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, test.lookup.NotFound("lidarr_indexer", []string{"Torznab", "Newznab"}))
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// UnableToUpgradeState is the summary of the state upgrade errors.
+const UnableToUpgradeState = "Unable to Upgrade Resource State"
+
+// AttributeUpgrade describes the migration of a prior state attribute to the current schema.
+type AttributeUpgrade struct {
+	// Convert returns the current value from a non null prior one, when the attribute type changed.
+	Convert func(prior tftypes.Value) (tftypes.Value, error)
+	// Prior is the attribute name in the prior schema.
+	Prior string
+	// Current is the attribute name in the current schema.
+	Current string
+}
+
+// UpgradeState copies the prior state attributes into the current schema, applying the given renames and type changes.
+// Attributes missing in the prior state, or whose type changed without a conversion, are set to null.
+func UpgradeState(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse, upgrades ...AttributeUpgrade) {
+	if req.State == nil {
+		resp.Diagnostics.AddError(UnableToUpgradeState, "Prior schema is required to upgrade the state attributes.")
+
+		return
+	}
+
+	var prior map[string]tftypes.Value
+	if err := req.State.Raw.As(&prior); err != nil {
+		resp.Diagnostics.AddError(UnableToUpgradeState, fmt.Sprintf("Unable to read prior state, got error: %s", err))
+
+		return
+	}
+
+	currentType, ok := resp.State.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		resp.Diagnostics.AddError(UnableToUpgradeState, "Current schema is not an object.")
+
+		return
+	}
+
+	changes := make(map[string]AttributeUpgrade, len(upgrades))
+	for _, upgrade := range upgrades {
+		changes[upgrade.Current] = upgrade
+	}
+
+	values := make(map[string]tftypes.Value, len(currentType.AttributeTypes))
+
+	for name, attributeType := range currentType.AttributeTypes {
+		upgrade, ok := changes[name]
+		if !ok {
+			upgrade = AttributeUpgrade{Prior: name, Current: name}
+		}
+
+		value, err := upgradeValue(prior[upgrade.Prior], attributeType, upgrade.Convert)
+		if err != nil {
+			resp.Diagnostics.AddError(UnableToUpgradeState, fmt.Sprintf("Unable to upgrade attribute %s to %s, got error: %s", upgrade.Prior, name, err))
+
+			return
+		}
+
+		if value.Type() == nil || !value.Type().Equal(attributeType) {
+			resp.Diagnostics.AddError(UnableToUpgradeState, fmt.Sprintf("Unable to upgrade attribute %s to %s, converted type %s does not match %s", upgrade.Prior, name, value.Type(), attributeType))
+
+			return
+		}
+
+		values[name] = value
+	}
+
+	resp.State.Raw = tftypes.NewValue(currentType, values)
+}
+
+// upgradeValue converts a prior value to the current type, defaulting to null.
+func upgradeValue(prior tftypes.Value, current tftypes.Type, convert func(tftypes.Value) (tftypes.Value, error)) (tftypes.Value, error) {
+	if prior.Type() == nil || prior.IsNull() {
+		return tftypes.NewValue(current, nil), nil
+	}
+
+	if convert == nil {
+		if prior.Type().Equal(current) {
+			return prior, nil
+		}
+
+		return tftypes.NewValue(current, nil), nil
+	}
+
+	return convert(prior)
+}
@@ -0,0 +1,109 @@
+package helpers
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpgradeState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	prior := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.Int64Attribute{Computed: true},
+			"old":     schema.StringAttribute{Optional: true},
+			"flag":    schema.BoolAttribute{Optional: true},
+			"mode":    schema.StringAttribute{Optional: true},
+			"removed": schema.StringAttribute{Optional: true},
+		},
+	}
+	current := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":    schema.Int64Attribute{Computed: true},
+			"new":   schema.StringAttribute{Optional: true},
+			"level": schema.Int64Attribute{Optional: true},
+			"mode":  schema.Int64Attribute{Optional: true},
+			"added": schema.StringAttribute{Optional: true},
+		},
+	}
+	boolToNumber := func(value tftypes.Value) (tftypes.Value, error) {
+		var flag bool
+		if err := value.As(&flag); err != nil {
+			return tftypes.Value{}, err
+		}
+
+		if flag {
+			return tftypes.NewValue(tftypes.Number, 1), nil
+		}
+
+		return tftypes.NewValue(tftypes.Number, 0), nil
+	}
+
+	tests := map[string]struct {
+		upgrades []AttributeUpgrade
+		expected map[string]tftypes.Value
+		err      bool
+	}{
+		"copy": {
+			expected: map[string]tftypes.Value{
+				"id":    tftypes.NewValue(tftypes.Number, 1),
+				"new":   tftypes.NewValue(tftypes.String, nil),
+				"level": tftypes.NewValue(tftypes.Number, nil),
+				"mode":  tftypes.NewValue(tftypes.Number, nil),
+				"added": tftypes.NewValue(tftypes.String, nil),
+			},
+		},
+		"rename and convert": {
+			upgrades: []AttributeUpgrade{
+				{Prior: "old", Current: "new"},
+				{Prior: "flag", Current: "level", Convert: boolToNumber},
+			},
+			expected: map[string]tftypes.Value{
+				"id":    tftypes.NewValue(tftypes.Number, 1),
+				"new":   tftypes.NewValue(tftypes.String, "value"),
+				"level": tftypes.NewValue(tftypes.Number, big.NewFloat(1)),
+				"mode":  tftypes.NewValue(tftypes.Number, nil),
+				"added": tftypes.NewValue(tftypes.String, nil),
+			},
+		},
+		"wrong conversion": {
+			upgrades: []AttributeUpgrade{
+				{Prior: "mode", Current: "mode", Convert: func(value tftypes.Value) (tftypes.Value, error) { return value, nil }},
+			},
+			err: true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			priorType := prior.Type().TerraformType(ctx)
+			state := tftypes.NewValue(priorType, map[string]tftypes.Value{
+				"id":      tftypes.NewValue(tftypes.Number, 1),
+				"old":     tftypes.NewValue(tftypes.String, "value"),
+				"flag":    tftypes.NewValue(tftypes.Bool, true),
+				"mode":    tftypes.NewValue(tftypes.String, "all"),
+				"removed": tftypes.NewValue(tftypes.String, "value"),
+			})
+
+			req := resource.UpgradeStateRequest{State: &tfsdk.State{Raw: state, Schema: prior}}
+			resp := resource.UpgradeStateResponse{State: tfsdk.State{Schema: current}}
+			UpgradeState(ctx, req, &resp, test.upgrades...)
+			assert.Equal(t, test.err, resp.Diagnostics.HasError())
+
+			if !test.err {
+				assert.True(t, tftypes.NewValue(current.Type().TerraformType(ctx), test.expected).Equal(resp.State.Raw), resp.State.Raw.String())
+			}
+		})
+	}
+}
@@ -32,3 +32,16 @@ func ImportStatePassthroughIntID(ctx context.Context, attrPath path.Path, req re
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attrPath, id)...)
 }
+
+// ImportStateByName sets the given state attribute to the only ID matching
+// an import by name, reporting missing or ambiguous names otherwise.
+func ImportStateByName(ctx context.Context, attrPath path.Path, resourceName, name string, ids []int32, resp *resource.ImportStateResponse) {
+	switch len(ids) {
+	case 0:
+		resp.Diagnostics.AddError(UnexpectedImportIdentifier, fmt.Sprintf("No %s found with name '%s'", resourceName, name))
+	case 1:
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attrPath, int64(ids[0]))...)
+	default:
+		resp.Diagnostics.AddError(UnexpectedImportIdentifier, fmt.Sprintf("Found %d %ss with name '%s', import by ID instead", len(ids), resourceName, name))
+	}
+}
@@ -0,0 +1,71 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImportStateByName(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		expected string
+		ids      []int32
+		id       types.Int64
+	}{
+		"single": {
+			ids:      []int32{1},
+			expected: "",
+			id:       types.Int64Value(1),
+		},
+		"missing": {
+			ids:      []int32{},
+			expected: "No notification found with name 'test'",
+			id:       types.Int64Null(),
+		},
+		"ambiguous": {
+			ids:      []int32{1, 2},
+			expected: "Found 2 notifications with name 'test', import by ID instead",
+			id:       types.Int64Null(),
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := resource.ImportStateResponse{
+				State: tfsdk.State{
+					Schema: schema.Schema{
+						Attributes: map[string]schema.Attribute{
+							"id": schema.Int64Attribute{Computed: true},
+						},
+					},
+					Raw: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.Number}}, nil),
+				},
+			}
+			ImportStateByName(context.Background(), path.Root("id"), "notification", "test", test.ids, &resp)
+
+			if test.expected == "" {
+				assert.False(t, resp.Diagnostics.HasError())
+			} else {
+				assert.Equal(t, 1, resp.Diagnostics.ErrorsCount())
+				assert.Equal(t, test.expected, resp.Diagnostics.Errors()[0].Detail())
+			}
+
+			var id types.Int64
+
+			resp.State.GetAttribute(context.Background(), path.Root("id"), &id)
+			assert.Equal(t, test.id, id)
+		})
+	}
+}
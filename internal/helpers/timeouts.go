@@ -0,0 +1,58 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = durationValidator{}
+
+// TimeoutFunc returns the duration of an operation of the timeouts block, such as the timeouts.Value Create method.
+type TimeoutFunc func(ctx context.Context, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics)
+
+// WithTimeout returns a copy of the context with the deadline configured for the given operation, if any.
+// Unset operations are only limited by the provider timeout of each request.
+func WithTimeout(ctx context.Context, timeout TimeoutFunc, diags *diag.Diagnostics) (context.Context, context.CancelFunc) {
+	duration, timeoutDiags := timeout(ctx, 0)
+	diags.Append(timeoutDiags...)
+
+	if duration <= 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, duration)
+}
+
+// durationValidator validates that a string is a valid duration.
+type durationValidator struct{}
+
+// Duration returns a validator which ensures that the string is a valid duration.
+func Duration() validator.String {
+	return durationValidator{}
+}
+
+func (v durationValidator) Description(_ context.Context) string {
+	return "value must be a valid duration, e.g. 30s or 20m"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.ParseDuration(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("Attribute %s value '%s' is not a valid duration: %s", req.Path, req.ConfigValue.ValueString(), err),
+		)
+	}
+}
@@ -0,0 +1,90 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithTimeout(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		timeout  time.Duration
+		diags    diag.Diagnostics
+		deadline bool
+	}{
+		"unset": {},
+		"set": {
+			timeout:  10 * time.Minute,
+			deadline: true,
+		},
+		"invalid": {
+			diags: diag.Diagnostics{diag.NewErrorDiagnostic("Timeout Cannot Be Parsed", "time: invalid duration")},
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+
+			ctx, cancel := WithTimeout(context.Background(), func(_ context.Context, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics) {
+				assert.Zero(t, defaultTimeout)
+
+				return test.timeout, test.diags
+			}, &diags)
+			defer cancel()
+
+			deadline, ok := ctx.Deadline()
+			assert.Equal(t, test.deadline, ok)
+			assert.Equal(t, test.diags.HasError(), diags.HasError())
+
+			if test.deadline {
+				assert.WithinDuration(t, time.Now().Add(10*time.Minute), deadline, time.Minute)
+			}
+		})
+	}
+}
+
+func TestDurationValidator(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value types.String
+		err   bool
+	}{
+		"null": {
+			value: types.StringNull(),
+		},
+		"valid": {
+			value: types.StringValue("1h30m"),
+		},
+		"invalid": {
+			value: types.StringValue("30"),
+			err:   true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := validator.StringResponse{}
+			durationValidator{}.ValidateString(context.Background(), validator.StringRequest{
+				Path:        path.Root("timeouts").AtName(Create),
+				ConfigValue: test.value,
+			}, &resp)
+			assert.Equal(t, test.err, resp.Diagnostics.HasError())
+		})
+	}
+}
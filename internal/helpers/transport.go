@@ -0,0 +1,213 @@
+package helpers
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RetryTransport is an http.RoundTripper retrying rate limited and transient failed requests with exponential backoff.
+// Idempotent requests are retried on 429, 502, 503 and 504, the others on 429 only.
+// Every request is retried on 500 when Lidarr reports its database as locked.
+type RetryTransport struct {
+	Base         http.RoundTripper
+	MaxRetries   int
+	InitialDelay time.Duration
+}
+
+// LimitTransport is an http.RoundTripper limiting the number of concurrent requests.
+type LimitTransport struct {
+	Base      http.RoundTripper
+	semaphore chan struct{}
+}
+
+// TimeoutTransport is an http.RoundTripper applying a timeout to the requests whose context has no deadline.
+type TimeoutTransport struct {
+	Base    http.RoundTripper
+	Timeout time.Duration
+}
+
+// cancelBody cancels the request context once the response body is closed.
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+// HeaderTransport is an http.RoundTripper adding the given headers to every request.
+type HeaderTransport struct {
+	Base    http.RoundTripper
+	Headers http.Header
+}
+
+var (
+	idempotentMethods = []string{http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete}
+	transientStatuses = []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout}
+)
+
+// databaseLockedError is the message of the SQLite error raised by concurrent writes.
+const databaseLockedError = "database is locked"
+
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	for attempt := 0; ; attempt++ {
+		resp, err := base.RoundTrip(req)
+		if err != nil || attempt >= t.MaxRetries || !t.retryable(req, resp) {
+			return resp, err
+		}
+
+		// The body must be replayed on the next attempt
+		if req.Body != nil && req.Body != http.NoBody {
+			if req.GetBody == nil {
+				return resp, nil
+			}
+
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return resp, nil
+			}
+
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+
+		delay := retryAfter(resp, time.Now())
+		if delay <= 0 {
+			delay = t.InitialDelay << attempt
+		}
+
+		resp.Body.Close()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (t *HeaderTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	if len(t.Headers) == 0 {
+		return base.RoundTrip(req)
+	}
+
+	// A RoundTripper must not modify the original request
+	req = req.Clone(req.Context())
+	for name, values := range t.Headers {
+		req.Header[name] = values
+	}
+
+	return base.RoundTrip(req)
+}
+
+func (t *TimeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	if _, ok := req.Context().Deadline(); ok || t.Timeout <= 0 {
+		return base.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), t.Timeout)
+
+	resp, err := base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+
+		return resp, err
+	}
+
+	resp.Body = &cancelBody{ReadCloser: resp.Body, cancel: cancel}
+
+	return resp, nil
+}
+
+func (b *cancelBody) Close() error {
+	defer b.cancel()
+
+	return b.ReadCloser.Close()
+}
+
+// retryable checks if a request can be retried after receiving the given response.
+func (t *RetryTransport) retryable(req *http.Request, resp *http.Response) bool {
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusInternalServerError:
+		return databaseLocked(resp)
+	default:
+		return slices.Contains(idempotentMethods, req.Method) && slices.Contains(transientStatuses, resp.StatusCode)
+	}
+}
+
+// databaseLocked checks if the response reports a locked database, keeping its body readable.
+func databaseLocked(resp *http.Response) bool {
+	body, err := readBody(&resp.Body)
+
+	return err == nil && strings.Contains(string(body), databaseLockedError)
+}
+
+// NewLimitTransport returns a LimitTransport allowing at most the given number of concurrent requests.
+func NewLimitTransport(base http.RoundTripper, limit int) *LimitTransport {
+	return &LimitTransport{
+		Base:      base,
+		semaphore: make(chan struct{}, limit),
+	}
+}
+
+// Limit returns the maximum number of concurrent requests.
+func (t *LimitTransport) Limit() int {
+	return cap(t.semaphore)
+}
+
+func (t *LimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	select {
+	case t.semaphore <- struct{}{}:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+
+	defer func() { <-t.semaphore }()
+
+	return base.RoundTrip(req)
+}
+
+// retryAfter returns the delay requested by the Retry-After header, either in seconds or as an HTTP date.
+func retryAfter(resp *http.Response, now time.Time) time.Duration {
+	header := resp.Header.Get("Retry-After")
+	if header == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(header); err == nil {
+		return date.Sub(now)
+	}
+
+	return 0
+}
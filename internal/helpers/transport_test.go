@@ -0,0 +1,368 @@
+package helpers
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryTransport(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		method     string
+		retryAfter string
+		statuses   []int
+		maxRetries int
+		expected   int
+		calls      int32
+		locked     bool
+	}{
+		"rate limited": {
+			method:     http.MethodGet,
+			statuses:   []int{http.StatusTooManyRequests, http.StatusOK},
+			maxRetries: 3,
+			expected:   http.StatusOK,
+			calls:      2,
+		},
+		"retry after": {
+			method:     http.MethodGet,
+			statuses:   []int{http.StatusTooManyRequests, http.StatusOK},
+			retryAfter: "0",
+			maxRetries: 3,
+			expected:   http.StatusOK,
+			calls:      2,
+		},
+		"transient": {
+			method:     http.MethodPut,
+			statuses:   []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusOK},
+			maxRetries: 3,
+			expected:   http.StatusOK,
+			calls:      3,
+		},
+		"post rate limited": {
+			method:     http.MethodPost,
+			statuses:   []int{http.StatusTooManyRequests, http.StatusCreated},
+			maxRetries: 3,
+			expected:   http.StatusCreated,
+			calls:      2,
+		},
+		"post transient": {
+			method:     http.MethodPost,
+			statuses:   []int{http.StatusServiceUnavailable, http.StatusCreated},
+			maxRetries: 3,
+			expected:   http.StatusServiceUnavailable,
+			calls:      1,
+		},
+		"exhausted": {
+			method:     http.MethodGet,
+			statuses:   []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusOK},
+			maxRetries: 1,
+			expected:   http.StatusTooManyRequests,
+			calls:      2,
+		},
+		"post database locked": {
+			method:     http.MethodPost,
+			statuses:   []int{http.StatusInternalServerError, http.StatusCreated},
+			locked:     true,
+			maxRetries: 3,
+			expected:   http.StatusCreated,
+			calls:      2,
+		},
+		"server error": {
+			method:     http.MethodGet,
+			statuses:   []int{http.StatusInternalServerError, http.StatusOK},
+			maxRetries: 3,
+			expected:   http.StatusInternalServerError,
+			calls:      1,
+		},
+		"client error": {
+			method:     http.MethodGet,
+			statuses:   []int{http.StatusUnauthorized, http.StatusOK},
+			maxRetries: 3,
+			expected:   http.StatusUnauthorized,
+			calls:      1,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls int32
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				call := atomic.AddInt32(&calls, 1)
+				body, _ := io.ReadAll(r.Body)
+				assert.Equal(t, "body", string(body))
+
+				if test.retryAfter != "" {
+					w.Header().Set("Retry-After", test.retryAfter)
+				}
+
+				w.WriteHeader(test.statuses[call-1])
+
+				if test.locked && call == 1 {
+					_, _ = w.Write([]byte(`{"message":"database is locked"}`))
+				}
+			}))
+			defer server.Close()
+
+			client := &http.Client{Transport: &RetryTransport{MaxRetries: test.maxRetries, InitialDelay: time.Millisecond}}
+			req, _ := http.NewRequest(test.method, server.URL, strings.NewReader("body"))
+
+			resp, err := client.Do(req)
+			assert.NoError(t, err)
+			resp.Body.Close()
+			assert.Equal(t, test.expected, resp.StatusCode)
+			assert.Equal(t, test.calls, atomic.LoadInt32(&calls))
+		})
+	}
+}
+
+// stubTransport is an http.RoundTripper returning the next stubbed response on each request.
+type stubTransport struct {
+	responses []stubResponse
+	bodies    []string
+}
+
+type stubResponse struct {
+	body   string
+	status int
+}
+
+func (s *stubTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, _ := io.ReadAll(req.Body)
+	s.bodies = append(s.bodies, string(body))
+	stub := s.responses[len(s.bodies)-1]
+
+	return &http.Response{
+		StatusCode: stub.status,
+		Body:       io.NopCloser(strings.NewReader(stub.body)),
+		Header:     http.Header{},
+		Request:    req,
+	}, nil
+}
+
+func TestRetryTransportDatabaseLocked(t *testing.T) {
+	t.Parallel()
+
+	locked := stubResponse{status: http.StatusInternalServerError, body: `{"message":"database is locked"}`}
+
+	tests := map[string]struct {
+		method     string
+		maxRetries int
+		expected   int
+		calls      int
+	}{
+		"create": {
+			method:     http.MethodPost,
+			maxRetries: 3,
+			expected:   http.StatusCreated,
+			calls:      3,
+		},
+		"update": {
+			method:     http.MethodPut,
+			maxRetries: 3,
+			expected:   http.StatusCreated,
+			calls:      3,
+		},
+		"exhausted": {
+			method:     http.MethodPost,
+			maxRetries: 1,
+			expected:   http.StatusInternalServerError,
+			calls:      2,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			stub := &stubTransport{responses: []stubResponse{locked, locked, {status: http.StatusCreated, body: `{"id":1}`}}}
+			client := &http.Client{Transport: &RetryTransport{Base: stub, MaxRetries: test.maxRetries, InitialDelay: time.Millisecond}}
+			req, _ := http.NewRequest(test.method, "http://lidarr/api/v1/tag", strings.NewReader("body"))
+
+			resp, err := client.Do(req)
+			assert.NoError(t, err)
+
+			body, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			assert.Equal(t, test.expected, resp.StatusCode)
+			assert.Equal(t, stub.responses[test.calls-1].body, string(body))
+			assert.Len(t, stub.bodies, test.calls)
+
+			for _, b := range stub.bodies {
+				assert.Equal(t, "body", b)
+			}
+		})
+	}
+}
+
+func TestHeaderTransport(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "proxy", r.Header.Get("Proxy-Authorization"))
+		assert.Equal(t, "key", r.Header.Get("X-Api-Key"))
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &HeaderTransport{Headers: http.Header{"Proxy-Authorization": {"proxy"}}}}
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	req.Header.Set("X-Api-Key", "key")
+
+	resp, err := client.Do(req)
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Empty(t, req.Header.Get("Proxy-Authorization"))
+}
+
+func TestLimitTransport(t *testing.T) {
+	t.Parallel()
+
+	var current, peak int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count := atomic.AddInt32(&current, 1)
+		defer atomic.AddInt32(&current, -1)
+
+		for {
+			old := atomic.LoadInt32(&peak)
+			if count <= old || atomic.CompareAndSwapInt32(&peak, old, count) {
+				break
+			}
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewLimitTransport(nil, 2)}
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			resp, err := client.Get(server.URL)
+			if assert.NoError(t, err) {
+				resp.Body.Close()
+			}
+		}()
+	}
+
+	wg.Wait()
+	assert.LessOrEqual(t, atomic.LoadInt32(&peak), int32(2))
+}
+
+func TestTimeoutTransport(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(200 * time.Millisecond):
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	tests := map[string]struct {
+		timeout  time.Duration
+		deadline time.Duration
+		err      bool
+	}{
+		"no timeout": {},
+		"timeout": {
+			timeout: 10 * time.Millisecond,
+			err:     true,
+		},
+		"context deadline": {
+			timeout:  10 * time.Millisecond,
+			deadline: 5 * time.Second,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			if test.deadline > 0 {
+				var cancel context.CancelFunc
+
+				ctx, cancel = context.WithTimeout(ctx, test.deadline)
+				defer cancel()
+			}
+
+			client := &http.Client{Transport: &TimeoutTransport{Timeout: test.timeout}}
+			req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+
+			resp, err := client.Do(req)
+			assert.Equal(t, test.err, err != nil)
+
+			if err == nil {
+				_, err = io.ReadAll(resp.Body)
+				assert.NoError(t, err)
+				resp.Body.Close()
+			}
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		header   string
+		expected time.Duration
+	}{
+		"missing": {
+			header:   "",
+			expected: 0,
+		},
+		"seconds": {
+			header:   "5",
+			expected: 5 * time.Second,
+		},
+		"date": {
+			header:   now.Add(10 * time.Second).Format(http.TimeFormat),
+			expected: 10 * time.Second,
+		},
+		"invalid": {
+			header:   "soon",
+			expected: 0,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &http.Response{Header: http.Header{}}
+			if test.header != "" {
+				resp.Header.Set("Retry-After", test.header)
+			}
+
+			assert.Equal(t, test.expected, retryAfter(resp, now))
+		})
+	}
+}
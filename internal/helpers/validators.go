@@ -0,0 +1,128 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// maxPort is the highest TCP port number.
+const maxPort = 65535
+
+var (
+	_ validator.String = emailAddressValidator{}
+	_ validator.Set    = emailAddressSetValidator{}
+	_ validator.String = regexpValidator{}
+)
+
+// emailAddressValidator validates that a string is a valid email address.
+type emailAddressValidator struct{}
+
+// EmailAddress returns a validator which ensures that a string is a valid email address.
+func EmailAddress() validator.String {
+	return emailAddressValidator{}
+}
+
+func (v emailAddressValidator) Description(_ context.Context) string {
+	return "value must be a valid email address"
+}
+
+func (v emailAddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v emailAddressValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := mail.ParseAddress(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Email Address",
+			fmt.Sprintf("Attribute %s value '%s' is not a valid email address: %s", req.Path, req.ConfigValue.ValueString(), err),
+		)
+	}
+}
+
+// emailAddressSetValidator validates that each element of a string set is a valid email address.
+type emailAddressSetValidator struct{}
+
+// EmailAddresses returns a validator which ensures that each element of a string set is a valid email address.
+func EmailAddresses() validator.Set {
+	return emailAddressSetValidator{}
+}
+
+func (v emailAddressSetValidator) Description(_ context.Context) string {
+	return "each element must be a valid email address"
+}
+
+func (v emailAddressSetValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v emailAddressSetValidator) ValidateSet(_ context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for i, element := range req.ConfigValue.Elements() {
+		value, ok := element.(types.String)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		if _, err := mail.ParseAddress(value.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtSetValue(value),
+				"Invalid Email Address",
+				fmt.Sprintf("Attribute %s element %d value '%s' is not a valid email address: %s", req.Path, i, value.ValueString(), err),
+			)
+		}
+	}
+}
+
+// regexpValidator validates that a string is a valid regular expression.
+type regexpValidator struct{}
+
+// Regexp returns a validator which ensures that a string is a valid regular expression.
+func Regexp() validator.String {
+	return regexpValidator{}
+}
+
+func (v regexpValidator) Description(_ context.Context) string {
+	return "value must be a valid regular expression"
+}
+
+func (v regexpValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v regexpValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Regular Expression",
+			fmt.Sprintf("Attribute %s value '%s' is not a valid regular expression: %s", req.Path, req.ConfigValue.ValueString(), err),
+		)
+	}
+}
+
+// Port returns a validator which ensures that an integer is a valid TCP port.
+func Port() validator.Int64 {
+	return int64validator.Between(1, maxPort)
+}
+
+// PortOrDefault returns a validator which ensures that an integer is a valid TCP port or 0, used by Lidarr when the port is not set.
+func PortOrDefault() validator.Int64 {
+	return int64validator.Between(0, maxPort)
+}
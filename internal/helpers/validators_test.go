@@ -0,0 +1,218 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmailAddress(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value    types.String
+		expected bool
+	}{
+		"valid": {
+			value:    types.StringValue("test@example.com"),
+			expected: false,
+		},
+		"named": {
+			value:    types.StringValue("Lidarr <test@example.com>"),
+			expected: false,
+		},
+		"invalid": {
+			value:    types.StringValue("test.example.com"),
+			expected: true,
+		},
+		"empty": {
+			value:    types.StringValue(""),
+			expected: true,
+		},
+		"null": {
+			value:    types.StringNull(),
+			expected: false,
+		},
+		"unknown": {
+			value:    types.StringUnknown(),
+			expected: false,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{
+				Path:        path.Root("from"),
+				ConfigValue: test.value,
+			}
+			resp := validator.StringResponse{}
+			EmailAddress().ValidateString(context.Background(), req, &resp)
+			assert.Equal(t, test.expected, resp.Diagnostics.HasError())
+		})
+	}
+}
+
+func TestEmailAddresses(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value    types.Set
+		expected string
+	}{
+		"valid": {
+			value: types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("test@example.com"),
+				types.StringValue("test1@example.com"),
+			}),
+			expected: "",
+		},
+		"invalid": {
+			value: types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("test@example.com"),
+				types.StringValue("test1.example.com"),
+			}),
+			expected: "Attribute to element 1 value 'test1.example.com' is not a valid email address: mail: missing '@' or angle-addr",
+		},
+		"empty": {
+			value:    types.SetValueMust(types.StringType, []attr.Value{}),
+			expected: "",
+		},
+		"null": {
+			value:    types.SetNull(types.StringType),
+			expected: "",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.SetRequest{
+				Path:        path.Root("to"),
+				ConfigValue: test.value,
+			}
+			resp := validator.SetResponse{}
+			EmailAddresses().ValidateSet(context.Background(), req, &resp)
+
+			if test.expected == "" {
+				assert.False(t, resp.Diagnostics.HasError())
+
+				return
+			}
+
+			assert.Equal(t, 1, resp.Diagnostics.ErrorsCount())
+			assert.Equal(t, test.expected, resp.Diagnostics.Errors()[0].Detail())
+		})
+	}
+}
+
+func TestRegexp(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value    types.String
+		expected bool
+	}{
+		"valid": {
+			value:    types.StringValue("(?i)not enough space"),
+			expected: false,
+		},
+		"invalid": {
+			value:    types.StringValue("[a-"),
+			expected: true,
+		},
+		"null": {
+			value:    types.StringNull(),
+			expected: false,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{
+				Path:        path.Root("message_pattern"),
+				ConfigValue: test.value,
+			}
+			resp := validator.StringResponse{}
+			Regexp().ValidateString(context.Background(), req, &resp)
+			assert.Equal(t, test.expected, resp.Diagnostics.HasError())
+		})
+	}
+}
+
+func TestPort(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value           types.Int64
+		expected        bool
+		defaultExpected bool
+	}{
+		"valid": {
+			value:           types.Int64Value(8686),
+			expected:        false,
+			defaultExpected: false,
+		},
+		"max": {
+			value:           types.Int64Value(65535),
+			expected:        false,
+			defaultExpected: false,
+		},
+		"default": {
+			value:           types.Int64Value(0),
+			expected:        true,
+			defaultExpected: false,
+		},
+		"negative": {
+			value:           types.Int64Value(-1),
+			expected:        true,
+			defaultExpected: true,
+		},
+		"too high": {
+			value:           types.Int64Value(99999),
+			expected:        true,
+			defaultExpected: true,
+		},
+		"null": {
+			value:           types.Int64Null(),
+			expected:        false,
+			defaultExpected: false,
+		},
+		"unknown": {
+			value:           types.Int64Unknown(),
+			expected:        false,
+			defaultExpected: false,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.Int64Request{
+				Path:        path.Root("port"),
+				ConfigValue: test.value,
+			}
+			resp := validator.Int64Response{}
+			Port().ValidateInt64(context.Background(), req, &resp)
+			assert.Equal(t, test.expected, resp.Diagnostics.HasError())
+
+			resp = validator.Int64Response{}
+			PortOrDefault().ValidateInt64(context.Background(), req, &resp)
+			assert.Equal(t, test.defaultExpected, resp.Diagnostics.HasError())
+		})
+	}
+}
@@ -36,6 +36,10 @@ func (d *ArtistDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "<!-- subcategory:Artists -->\nSingle [Artist](../resources/artist).",
 		Attributes: map[string]schema.Attribute{
+			"monitor_new_items": schema.StringAttribute{
+				MarkdownDescription: "Monitor new albums.",
+				Computed:            true,
+			},
 			"monitored": schema.BoolAttribute{
 				MarkdownDescription: "Monitored flag.",
 				Computed:            true,
@@ -124,5 +128,5 @@ func (a *Artist) find(ctx context.Context, ID string, artists []lidarr.ArtistRes
 		}
 	}
 
-	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(artistDataSourceName, "Foreign artist ID", ID))
+	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(artistDataSourceName, "Foreign artist ID", ID, helpers.Values(artists, (*lidarr.ArtistResource).GetForeignArtistId)))
 }
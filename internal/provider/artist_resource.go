@@ -7,13 +7,18 @@ import (
 
 	"github.com/devopsarr/lidarr-go/lidarr"
 	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -36,6 +41,17 @@ type ArtistResource struct {
 	auth   context.Context
 }
 
+// ArtistResourceData describes the artist resource data model.
+// It extends the artist data model with attributes not returned by Lidarr.
+type ArtistResourceData struct {
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	Artist
+	TagLabels
+	DeletionProtection     types.Bool `tfsdk:"deletion_protection"`
+	SearchForMissingAlbums types.Bool `tfsdk:"search_for_missing_albums"`
+	SkipDefaultTags        types.Bool `tfsdk:"skip_default_tags"`
+}
+
 // Artist describes the artist data model.
 type Artist struct {
 	Genres            types.Set    `tfsdk:"genres"`
@@ -45,6 +61,7 @@ type Artist struct {
 	Status            types.String `tfsdk:"status"`
 	Path              types.String `tfsdk:"path"`
 	Overview          types.String `tfsdk:"overview"`
+	MonitorNewItems   types.String `tfsdk:"monitor_new_items"`
 	ID                types.Int64  `tfsdk:"id"`
 	QualityProfileID  types.Int64  `tfsdk:"quality_profile_id"`
 	MetadataProfileID types.Int64  `tfsdk:"metadata_profile_id"`
@@ -78,6 +95,7 @@ func (a Artist) getType() attr.Type {
 			"status":              types.StringType,
 			"path":                types.StringType,
 			"overview":            types.StringType,
+			"monitor_new_items":   types.StringType,
 			"genres":              types.SetType{}.WithElementType(types.StringType),
 			"tags":                types.SetType{}.WithElementType(types.Int64Type),
 		})
@@ -87,14 +105,30 @@ func (r *ArtistResource) Metadata(_ context.Context, req resource.MetadataReques
 	resp.TypeName = req.ProviderTypeName + "_" + artistResourceName
 }
 
-func (r *ArtistResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *ArtistResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Artists -->\nArtist resource.\nFor more information refer to [Artists](https://wiki.servarr.com/lidarr/library#artists) documentation.",
 		Attributes: map[string]schema.Attribute{
+			"deletion_protection": helpers.DeletionProtectionAttribute(),
+			"search_for_missing_albums": schema.BoolAttribute{
+				MarkdownDescription: "Search for missing albums when the artist is added. Only used at creation, changing it afterwards has no effect. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"monitored": schema.BoolAttribute{
 				MarkdownDescription: "Monitored flag.",
 				Required:            true,
 			},
+			"monitor_new_items": schema.StringAttribute{
+				MarkdownDescription: "Monitor new albums. Valid values are 'all', 'none' and 'new'. Defaults to 'all'.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(string(lidarr.NEWITEMMONITORTYPES_ALL)),
+				Validators: []validator.String{
+					stringvalidator.OneOf("all", "none", "new"),
+				},
+			},
 			"quality_profile_id": schema.Int64Attribute{
 				MarkdownDescription: "Quality profile ID.",
 				Required:            true,
@@ -136,12 +170,23 @@ func (r *ArtistResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels":          tagLabelsAttribute(),
+			"create_missing_tags": createMissingTagsAttribute(),
+			"skip_default_tags":   skipDefaultTagsAttribute(),
 			"genres": schema.SetAttribute{
 				MarkdownDescription: "List genres.",
 				Computed:            true,
 				ElementType:         types.StringType,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -154,7 +199,7 @@ func (r *ArtistResource) Configure(ctx context.Context, req resource.ConfigureRe
 
 func (r *ArtistResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var artist *Artist
+	var artist *ArtistResourceData
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &artist)...)
 
@@ -162,29 +207,50 @@ func (r *ArtistResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	auth, cancel := helpers.WithTimeout(r.auth, artist.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	resolver := newTagResolver(auth, r.client)
+	resolver.resolve(ctx, artist.TagLabels, &artist.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new Artist
 	request := artist.read(ctx, &resp.Diagnostics)
-	// TODO: can parametrize AddArtistOptions
+	applyDefaultTags(auth, request, artist.SkipDefaultTags)
+
+	if !resolver.validate(artistResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	// Add options are only used by Lidarr at creation
 	options := lidarr.NewAddArtistOptions()
 	options.SetMonitor(lidarr.MONITORTYPES_ALL)
-	options.SetSearchForMissingAlbums(true)
+	options.SetSearchForMissingAlbums(artist.SearchForMissingAlbums.ValueBool())
+	request.SetAddOptions(*options)
 
-	response, _, err := r.client.ArtistAPI.CreateArtist(r.auth).ArtistResource(*request).Execute()
+	response, _, err := r.client.ArtistAPI.CreateArtist(auth).ArtistResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, artistResourceName, err))
 
 		return
 	}
 
+	response = helpers.ReadAfterCreate(ctx, response, artistResourceName, r.client.ArtistAPI.GetArtistById(auth, response.GetId()).Execute, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created artist: "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, auth, response, artist.Tags, artist.SkipDefaultTags)
 	artist.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &artist.TagLabels, artist.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &artist)...)
 }
 
 func (r *ArtistResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var artist *Artist
+	var artist *ArtistResourceData
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &artist)...)
 
@@ -192,9 +258,19 @@ func (r *ArtistResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	auth, cancel := helpers.WithTimeout(r.auth, artist.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get artist current value
-	response, _, err := r.client.ArtistAPI.GetArtistById(r.auth, int32(artist.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ArtistAPI.GetArtistById(auth, int32(artist.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			resp.Diagnostics.AddWarning(helpers.ResourceNotFound, helpers.ParseResourceNotFound(artistResourceName, artist.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, artistResourceName, err))
 
 		return
@@ -202,13 +278,15 @@ func (r *ArtistResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	tflog.Trace(ctx, "read "+artistResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	removeDefaultTags(ctx, auth, response, artist.Tags, artist.SkipDefaultTags)
 	artist.write(ctx, response, &resp.Diagnostics)
+	newTagResolver(auth, r.client).write(ctx, &artist.TagLabels, artist.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &artist)...)
 }
 
 func (r *ArtistResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var artist *Artist
+	var artist *ArtistResourceData
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &artist)...)
 
@@ -216,10 +294,25 @@ func (r *ArtistResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	auth, cancel := helpers.WithTimeout(r.auth, artist.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	resolver := newTagResolver(auth, r.client)
+	resolver.resolve(ctx, artist.TagLabels, &artist.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update Artist
 	request := artist.read(ctx, &resp.Diagnostics)
+	applyDefaultTags(auth, request, artist.SkipDefaultTags)
+
+	if !resolver.validate(artistResourceName, request, &resp.Diagnostics) {
+		return
+	}
 
-	response, _, err := r.client.ArtistAPI.UpdateArtist(r.auth, fmt.Sprint(request.GetId())).ArtistResource(*request).Execute()
+	response, _, err := r.client.ArtistAPI.UpdateArtist(auth, fmt.Sprint(request.GetId())).ArtistResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, artistResourceName, err))
 
@@ -228,21 +321,34 @@ func (r *ArtistResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	tflog.Trace(ctx, "updated "+artistResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, auth, response, artist.Tags, artist.SkipDefaultTags)
 	artist.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &artist.TagLabels, artist.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &artist)...)
 }
 
 func (r *ArtistResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID            int64
+		stateTimeouts timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &stateTimeouts)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if helpers.DeletionProtected(ctx, req.State, artistResourceName, &resp.Diagnostics) {
+		return
+	}
+
+	auth, cancel := helpers.WithTimeout(r.auth, stateTimeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete artist current value
-	_, err := r.client.ArtistAPI.DeleteArtist(r.auth, int32(ID)).Execute()
+	_, err := r.client.ArtistAPI.DeleteArtist(auth, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, artistResourceName, err))
 
@@ -255,6 +361,8 @@ func (r *ArtistResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 func (r *ArtistResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deletion_protection"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("search_for_missing_albums"), false)...)
 	tflog.Trace(ctx, "imported "+artistResourceName+": "+req.ID)
 }
 
@@ -267,6 +375,7 @@ func (a *Artist) write(ctx context.Context, artist *lidarr.ArtistResource, diags
 	diags.Append(localDiag...)
 
 	a.Monitored = types.BoolValue(artist.GetMonitored())
+	a.MonitorNewItems = types.StringValue(string(artist.GetMonitorNewItems()))
 	a.ID = types.Int64Value(int64(artist.GetId()))
 	a.ArtistName = types.StringValue(artist.GetArtistName())
 	a.Path = types.StringValue(artist.GetPath())
@@ -281,6 +390,7 @@ func (a *Artist) write(ctx context.Context, artist *lidarr.ArtistResource, diags
 func (a *Artist) read(ctx context.Context, diags *diag.Diagnostics) *lidarr.ArtistResource {
 	artist := lidarr.NewArtistResource()
 	artist.SetMonitored(a.Monitored.ValueBool())
+	artist.SetMonitorNewItems(lidarr.NewItemMonitorTypes(a.MonitorNewItems.ValueString()))
 	artist.SetArtistName(a.ArtistName.ValueString())
 	artist.SetPath(a.Path.ValueString())
 	artist.SetQualityProfileId(int32(a.QualityProfileID.ValueInt64()))
@@ -30,6 +30,7 @@ func TestAccArtistResource(t *testing.T) {
 					resource.TestCheckResourceAttr("lidarr_artist.test", "artist_name", "Queen"),
 					resource.TestCheckResourceAttr("lidarr_artist.test", "status", "ended"),
 					resource.TestCheckResourceAttr("lidarr_artist.test", "monitored", "false"),
+					resource.TestCheckResourceAttr("lidarr_artist.test", "monitor_new_items", "all"),
 					resource.TestCheckResourceAttrSet("lidarr_artist.test", "genres.0"),
 				),
 			},
@@ -45,6 +46,27 @@ func TestAccArtistResource(t *testing.T) {
 					resource.TestCheckResourceAttr("lidarr_artist.test", "path", "/config/test123"),
 				),
 			},
+			// Create only attribute testing
+			{
+				Config: testAccArtistResourceSearchConfig("Queen", "test123", "0383dadf-2a4e-4d10-a46a-e9e041da8eb3"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lidarr_artist.test", "search_for_missing_albums", "true"),
+					resource.TestCheckResourceAttr("lidarr_artist.test", "path", "/config/test123"),
+				),
+			},
+			// Monitor new items testing
+			{
+				Config: testAccArtistResourceMonitorConfig("Queen", "test123", "0383dadf-2a4e-4d10-a46a-e9e041da8eb3", "none"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lidarr_artist.test", "monitor_new_items", "none"),
+				),
+			},
+			{
+				Config: testAccArtistResourceMonitorConfig("Queen", "test123", "0383dadf-2a4e-4d10-a46a-e9e041da8eb3", "new"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lidarr_artist.test", "monitor_new_items", "new"),
+				),
+			},
 			// ImportState testing
 			{
 				ResourceName:      "lidarr_artist.test",
@@ -68,3 +90,31 @@ func testAccArtistResourceConfig(title, path, foreignID string) string {
 		}
 	`, title, path, foreignID)
 }
+
+func testAccArtistResourceSearchConfig(title, path, foreignID string) string {
+	return fmt.Sprintf(`
+		resource "lidarr_artist" "test" {
+			monitored = false
+			search_for_missing_albums = true
+			artist_name = "%s"
+			path = "/config/%s"
+			quality_profile_id = 1
+			metadata_profile_id = 1
+			foreign_artist_id = "%s"
+		}
+	`, title, path, foreignID)
+}
+
+func testAccArtistResourceMonitorConfig(title, path, foreignID, monitorNewItems string) string {
+	return fmt.Sprintf(`
+		resource "lidarr_artist" "test" {
+			monitored = false
+			monitor_new_items = "%s"
+			artist_name = "%s"
+			path = "/config/%s"
+			quality_profile_id = 1
+			metadata_profile_id = 1
+			foreign_artist_id = "%s"
+		}
+	`, monitorNewItems, title, path, foreignID)
+}
@@ -2,12 +2,15 @@ package provider
 
 import (
 	"context"
+	"slices"
 	"strconv"
 
 	"github.com/devopsarr/lidarr-go/lidarr"
 	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -31,6 +34,7 @@ type ArtistsDataSource struct {
 type Artists struct {
 	Artists types.Set    `tfsdk:"artists"`
 	ID      types.String `tfsdk:"id"`
+	TagID   types.Int64  `tfsdk:"tag_id"`
 }
 
 func (d *ArtistsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -42,6 +46,10 @@ func (d *ArtistsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "<!-- subcategory:Artists -->\nList all available [Artists](../resources/artist).",
 		Attributes: map[string]schema.Attribute{
+			"tag_id": schema.Int64Attribute{
+				MarkdownDescription: "Only list the artists with the given tag.",
+				Optional:            true,
+			},
 			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
 			"id": schema.StringAttribute{
 				Computed: true,
@@ -51,6 +59,10 @@ func (d *ArtistsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				Computed:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
+						"monitor_new_items": schema.StringAttribute{
+							MarkdownDescription: "Monitor new albums.",
+							Computed:            true,
+						},
 						"monitored": schema.BoolAttribute{
 							MarkdownDescription: "Monitored flag.",
 							Computed:            true,
@@ -111,8 +123,16 @@ func (d *ArtistsDataSource) Configure(ctx context.Context, req datasource.Config
 	}
 }
 
-func (d *ArtistsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
-	// Get artists current value
+func (d *ArtistsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *Artists
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get artists current value, the endpoint is neither paginated nor filtered by tag
 	response, _, err := d.client.ArtistAPI.ListArtist(d.auth).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, artistsDataSourceName, err))
@@ -122,12 +142,35 @@ func (d *ArtistsDataSource) Read(ctx context.Context, _ datasource.ReadRequest,
 
 	tflog.Trace(ctx, "read "+artistsDataSourceName)
 	// Map response body to resource schema attribute
-	artists := make([]Artist, len(response))
-	for i, m := range response {
-		artists[i].write(ctx, &m, &resp.Diagnostics)
-	}
+	artists := writeArtists(ctx, response, data.TagID, &resp.Diagnostics)
 
-	artistList, diags := types.SetValueFrom(ctx, Artist{}.getType(), artists)
+	artistList, diags := types.SetValue(Artist{}.getType(), artists)
 	resp.Diagnostics.Append(diags...)
-	resp.Diagnostics.Append(resp.State.Set(ctx, Artists{Artists: artistList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
+
+	data.Artists = artistList
+	data.ID = types.StringValue(strconv.Itoa(len(artists)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// writeArtists converts each artist with the tag, if any, straight into a set element, without an intermediate slice of models.
+func writeArtists(ctx context.Context, response []lidarr.ArtistResource, tagID types.Int64, diags *diag.Diagnostics) []attr.Value {
+	attrTypes := Artist{}.getType().(types.ObjectType).AttrTypes
+	artists := make([]attr.Value, 0, len(response))
+
+	var artist Artist
+
+	for i := range response {
+		if !tagID.IsNull() && !slices.Contains(response[i].GetTags(), int32(tagID.ValueInt64())) {
+			continue
+		}
+
+		artist.write(ctx, &response[i], diags)
+
+		element, localDiag := types.ObjectValueFrom(ctx, attrTypes, artist)
+		diags.Append(localDiag...)
+
+		artists = append(artists, element)
+	}
+
+	return artists
 }
@@ -1,10 +1,15 @@
 package provider
 
 import (
+	"context"
 	"regexp"
 	"testing"
 
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccArtistsDataSource(t *testing.T) {
@@ -35,3 +40,54 @@ data "lidarr_artists" "test" {
 	depends_on = [lidarr_artist.test]
 }
 `
+
+func TestWriteArtists(t *testing.T) {
+	t.Parallel()
+
+	// synthetic library, one artist out of four is tagged
+	response := make([]lidarr.ArtistResource, 4000)
+	for i := range response {
+		response[i].SetId(int32(i + 1))
+		response[i].SetArtistName("Artist")
+		response[i].SetTags([]int32{})
+
+		if i%4 == 0 {
+			response[i].SetTags([]int32{1, 2})
+		}
+	}
+
+	tests := map[string]struct {
+		tagID types.Int64
+		count int
+	}{
+		"all": {
+			tagID: types.Int64Null(),
+			count: 4000,
+		},
+		"tagged": {
+			tagID: types.Int64Value(2),
+			count: 1000,
+		},
+		"missing tag": {
+			tagID: types.Int64Value(3),
+			count: 0,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+
+			artists := writeArtists(context.Background(), response, test.tagID, &diags)
+			assert.False(t, diags.HasError())
+			assert.Len(t, artists, test.count)
+
+			set, localDiag := types.SetValue(Artist{}.getType(), artists)
+			assert.False(t, localDiag.HasError())
+			assert.Len(t, set.Elements(), test.count)
+		})
+	}
+}
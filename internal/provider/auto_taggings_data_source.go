@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const autoTaggingsDataSourceName = "auto_taggings"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AutoTaggingsDataSource{}
+
+func NewAutoTaggingsDataSource() datasource.DataSource {
+	return &AutoTaggingsDataSource{}
+}
+
+// AutoTaggingsDataSource defines the auto taggings implementation.
+type AutoTaggingsDataSource struct {
+	client *lidarr.APIClient
+	auth   context.Context
+}
+
+// AutoTaggings describes the auto taggings data model.
+type AutoTaggings struct {
+	AutoTaggings types.Set    `tfsdk:"auto_taggings"`
+	ID           types.String `tfsdk:"id"`
+}
+
+// AutoTagging describes the auto tagging data model.
+type AutoTagging struct {
+	Tags                    types.Set    `tfsdk:"tags"`
+	Specifications          types.Set    `tfsdk:"specifications"`
+	Name                    types.String `tfsdk:"name"`
+	ID                      types.Int64  `tfsdk:"id"`
+	RemoveTagsAutomatically types.Bool   `tfsdk:"remove_tags_automatically"`
+}
+
+func (a AutoTagging) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"tags":                      types.SetType{}.WithElementType(types.Int64Type),
+			"specifications":            types.SetType{}.WithElementType(AutoTaggingSpecification{}.getType()),
+			"name":                      types.StringType,
+			"id":                        types.Int64Type,
+			"remove_tags_automatically": types.BoolType,
+		})
+}
+
+// AutoTaggingSpecification describes the auto tagging specification data model.
+type AutoTaggingSpecification struct {
+	Fields         types.Map    `tfsdk:"fields"`
+	Name           types.String `tfsdk:"name"`
+	Implementation types.String `tfsdk:"implementation"`
+	Negate         types.Bool   `tfsdk:"negate"`
+	Required       types.Bool   `tfsdk:"required"`
+}
+
+func (s AutoTaggingSpecification) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"fields":         types.MapType{}.WithElementType(types.StringType),
+			"name":           types.StringType,
+			"implementation": types.StringType,
+			"negate":         types.BoolType,
+			"required":       types.BoolType,
+		})
+}
+
+func (d *AutoTaggingsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + autoTaggingsDataSourceName
+}
+
+func (d *AutoTaggingsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the delay server.
+		MarkdownDescription: "<!-- subcategory:Tags -->\nList all available Auto Taggings.\nFor more information refer to [Tags](https://wiki.servarr.com/lidarr/settings#tags) documentation.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"auto_taggings": schema.SetNestedAttribute{
+				MarkdownDescription: "Auto Tagging list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Auto Tagging ID.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Auto Tagging name.",
+							Computed:            true,
+						},
+						"remove_tags_automatically": schema.BoolAttribute{
+							MarkdownDescription: "Remove tags automatically flag.",
+							Computed:            true,
+						},
+						"tags": schema.SetAttribute{
+							MarkdownDescription: "List of tags applied by the rule.",
+							Computed:            true,
+							ElementType:         types.Int64Type,
+						},
+						"specifications": schema.SetNestedAttribute{
+							MarkdownDescription: "Specifications.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										MarkdownDescription: "Specification name.",
+										Computed:            true,
+									},
+									"implementation": schema.StringAttribute{
+										MarkdownDescription: "Specification implementation.",
+										Computed:            true,
+									},
+									"negate": schema.BoolAttribute{
+										MarkdownDescription: "Negate flag.",
+										Computed:            true,
+									},
+									"required": schema.BoolAttribute{
+										MarkdownDescription: "Required flag.",
+										Computed:            true,
+									},
+									"fields": schema.MapAttribute{
+										MarkdownDescription: "Raw field values, keyed by API field name. Non string values are JSON encoded.",
+										Computed:            true,
+										ElementType:         types.StringType,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AutoTaggingsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *AutoTaggingsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get auto taggings current value
+	response, _, err := d.client.AutoTaggingAPI.ListAutoTagging(d.auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, autoTaggingsDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+autoTaggingsDataSourceName)
+	// Map response body to resource schema attribute
+	autoTaggings := make([]AutoTagging, len(response))
+	for i, a := range response {
+		autoTaggings[i].write(ctx, &a, &resp.Diagnostics)
+	}
+
+	autoTaggingList, diags := types.SetValueFrom(ctx, AutoTagging{}.getType(), autoTaggings)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, AutoTaggings{AutoTaggings: autoTaggingList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
+}
+
+func (a *AutoTagging) write(ctx context.Context, autoTagging *lidarr.AutoTaggingResource, diags *diag.Diagnostics) {
+	var localDiag diag.Diagnostics
+
+	a.ID = types.Int64Value(int64(autoTagging.GetId()))
+	a.Name = types.StringValue(autoTagging.GetName())
+	a.RemoveTagsAutomatically = types.BoolValue(autoTagging.GetRemoveTagsAutomatically())
+	a.Tags, localDiag = types.SetValueFrom(ctx, types.Int64Type, autoTagging.GetTags())
+	diags.Append(localDiag...)
+
+	specifications := make([]AutoTaggingSpecification, len(autoTagging.GetSpecifications()))
+	for i, s := range autoTagging.GetSpecifications() {
+		specifications[i].write(ctx, &s)
+	}
+
+	a.Specifications, localDiag = types.SetValueFrom(ctx, AutoTaggingSpecification{}.getType(), specifications)
+	diags.Append(localDiag...)
+}
+
+func (s *AutoTaggingSpecification) write(ctx context.Context, specification *lidarr.AutoTaggingSpecificationSchema) {
+	s.Name = types.StringValue(specification.GetName())
+	s.Implementation = types.StringValue(specification.GetImplementation())
+	s.Negate = types.BoolValue(specification.GetNegate())
+	s.Required = types.BoolValue(specification.GetRequired())
+	s.Fields = helpers.WriteMapFields(ctx, specification.GetFields(), helpers.Fields{}, types.MapNull(types.StringType))
+}
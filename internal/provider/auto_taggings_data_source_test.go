@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccAutoTaggingsDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccAutoTaggingsDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccAutoTaggingsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.lidarr_auto_taggings.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccAutoTaggingsDataSourceConfig = `
+data "lidarr_auto_taggings" "test" {
+}
+`
+
+func TestAutoTaggingWrite(t *testing.T) {
+	t.Parallel()
+
+	field := lidarr.NewField()
+	field.SetName("value")
+	field.SetValue([]interface{}{"rock", "metal"})
+
+	specification := lidarr.NewAutoTaggingSpecificationSchema()
+	specification.SetName("Rock")
+	specification.SetImplementation("GenreSpecification")
+	specification.SetRequired(true)
+	specification.SetFields([]lidarr.Field{*field})
+
+	autoTagging := lidarr.NewAutoTaggingResource()
+	autoTagging.SetId(1)
+	autoTagging.SetName("Heavy")
+	autoTagging.SetTags([]int32{2})
+	autoTagging.SetSpecifications([]lidarr.AutoTaggingSpecificationSchema{*specification})
+
+	var (
+		data           AutoTagging
+		specifications []AutoTaggingSpecification
+		diags          diag.Diagnostics
+	)
+
+	data.write(context.Background(), autoTagging, &diags)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "Heavy", data.Name.ValueString())
+	assert.False(t, data.RemoveTagsAutomatically.ValueBool())
+	assert.Empty(t, data.Specifications.ElementsAs(context.Background(), &specifications, false))
+	assert.Len(t, specifications, 1)
+	assert.Equal(t, types.StringValue(`["rock","metal"]`), specifications[0].Fields.Elements()["value"])
+}
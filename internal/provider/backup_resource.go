@@ -0,0 +1,249 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	backupResourceName = "backup"
+	backupCommandName  = "Backup"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BackupResource{}
+
+func NewBackupResource() resource.Resource {
+	return &BackupResource{}
+}
+
+// BackupResource defines the backup implementation.
+type BackupResource struct {
+	client *lidarr.APIClient
+	auth   context.Context
+}
+
+// Backup describes the backup data model.
+type Backup struct {
+	Triggers types.Map    `tfsdk:"triggers"`
+	Timeout  types.String `tfsdk:"timeout"`
+	Name     types.String `tfsdk:"name"`
+	Path     types.String `tfsdk:"path"`
+	Time     types.String `tfsdk:"time"`
+	ID       types.Int64  `tfsdk:"id"`
+	Size     types.Int64  `tfsdk:"size"`
+}
+
+// backupNotFoundError describes a completed backup command whose backup is missing in the list.
+type backupNotFoundError struct {
+	since time.Time
+}
+
+func (e *backupNotFoundError) Error() string {
+	return fmt.Sprintf("no manual backup created since %s", e.since)
+}
+
+func (r *BackupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + backupResourceName
+}
+
+func (r *BackupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:System -->\nBackup resource. Takes a Lidarr backup on creation, changing `triggers` takes a new one. Destroying the resource only removes it from state, the backup is kept.\nFor more information refer to [Backup](https://wiki.servarr.com/lidarr/system#backup) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values which take a new backup when changed.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"timeout": schema.StringAttribute{
+				MarkdownDescription: "Maximum time to wait for the backup, as a duration string such as `30s` or `20m`. Defaults to `" + defaultCommandTimeout + "`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(defaultCommandTimeout),
+				Validators: []validator.String{
+					helpers.Duration(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Backup file name.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Backup download path.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"time": schema.StringAttribute{
+				MarkdownDescription: "Backup time.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "Backup size in bytes.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Backup ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *BackupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+		r.auth = auth
+	}
+}
+
+func (r *BackupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var backup *Backup
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &backup)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, err := time.ParseDuration(backup.Timeout.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ResourceError, fmt.Sprintf("Unable to parse timeout, got error: %s", err))
+
+		return
+	}
+
+	auth, cancel := context.WithTimeout(r.auth, timeout)
+	defer cancel()
+
+	// Run the backup command
+	command, err := postCommand(auth, r.client, map[string]interface{}{"name": backupCommandName})
+	if err == nil {
+		command, err = waitForCommand(auth, r.client, command.GetId(), commandPollInterval)
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, backupResourceName, err))
+
+		return
+	}
+
+	// Match the new backup
+	backups, _, err := r.client.BackupAPI.ListSystemBackup(auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, backupResourceName, err))
+
+		return
+	}
+
+	response, err := findBackup(backups, command.GetQueued())
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ResourceError, helpers.ParseClientError(helpers.Read, backupResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "created "+backupResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	backup.write(response)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &backup)...)
+}
+
+func (r *BackupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Lidarr rotates the backups, the state only records the last one taken
+	var backup *Backup
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &backup)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read "+backupResourceName+": "+strconv.Itoa(int(backup.ID.ValueInt64())))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &backup)...)
+}
+
+func (r *BackupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Only timeout can be updated, without taking a new backup
+	var backup *Backup
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &backup)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+backupResourceName+": "+strconv.Itoa(int(backup.ID.ValueInt64())))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &backup)...)
+}
+
+func (r *BackupResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Keep the backup file, only remove it from state
+	tflog.Trace(ctx, "deleted "+backupResourceName)
+	resp.State.RemoveResource(ctx)
+}
+
+func (b *Backup) write(backup *lidarr.BackupResource) {
+	b.ID = types.Int64Value(int64(backup.GetId()))
+	b.Name = types.StringValue(backup.GetName())
+	b.Path = types.StringValue(backup.GetPath())
+	b.Time = types.StringValue(backup.GetTime().String())
+	b.Size = types.Int64Value(backup.GetSize())
+}
+
+// findBackup returns the latest manual backup taken since the given time.
+// Backup times have a second precision, so the comparison ignores the fraction of the command time.
+func findBackup(backups []lidarr.BackupResource, since time.Time) (*lidarr.BackupResource, error) {
+	var found *lidarr.BackupResource
+
+	since = since.Truncate(time.Second)
+
+	for i, b := range backups {
+		if b.GetType() != lidarr.BACKUPTYPE_MANUAL || b.GetTime().Before(since) {
+			continue
+		}
+
+		if found == nil || b.GetTime().After(found.GetTime()) {
+			found = &backups[i]
+		}
+	}
+
+	if found == nil {
+		return nil, &backupNotFoundError{since: since}
+	}
+
+	return found, nil
+}
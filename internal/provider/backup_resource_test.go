@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccBackupResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccBackupResourceConfig("1") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccBackupResourceConfig("1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("lidarr_backup.test", "name", regexp.MustCompile(`^lidarr_backup_.*\.zip$`)),
+					resource.TestCheckResourceAttrSet("lidarr_backup.test", "path"),
+					resource.TestCheckResourceAttrSet("lidarr_backup.test", "id"),
+				),
+			},
+			// Triggers testing
+			{
+				Config: testAccBackupResourceConfig("2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lidarr_backup.test", "triggers.run", "2"),
+					resource.TestCheckResourceAttrSet("lidarr_backup.test", "time"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccBackupResourceConfig(run string) string {
+	return fmt.Sprintf(`
+		resource "lidarr_backup" "test" {
+			triggers = {
+				run = "%s"
+			}
+		}
+	`, run)
+}
+
+func TestFindBackup(t *testing.T) {
+	t.Parallel()
+
+	since := time.Date(2024, 1, 1, 10, 0, 0, 500, time.UTC)
+	backup := func(id int32, backupType lidarr.BackupType, offset time.Duration) lidarr.BackupResource {
+		b := lidarr.NewBackupResource()
+		b.SetId(id)
+		b.SetType(backupType)
+		b.SetTime(since.Truncate(time.Second).Add(offset))
+
+		return *b
+	}
+
+	tests := map[string]struct {
+		backups  []lidarr.BackupResource
+		expected int32
+	}{
+		"same second": {
+			backups:  []lidarr.BackupResource{backup(1, lidarr.BACKUPTYPE_MANUAL, 0)},
+			expected: 1,
+		},
+		"latest manual": {
+			backups: []lidarr.BackupResource{
+				backup(1, lidarr.BACKUPTYPE_MANUAL, time.Second),
+				backup(2, lidarr.BACKUPTYPE_MANUAL, 2*time.Second),
+				backup(3, lidarr.BACKUPTYPE_SCHEDULED, 3*time.Second),
+			},
+			expected: 2,
+		},
+		"older": {
+			backups: []lidarr.BackupResource{backup(1, lidarr.BACKUPTYPE_MANUAL, -time.Minute)},
+		},
+		"empty": {},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			found, err := findBackup(test.backups, since)
+			assert.Equal(t, test.expected == 0, err != nil)
+
+			if test.expected != 0 {
+				assert.Equal(t, test.expected, found.GetId())
+			}
+		})
+	}
+}
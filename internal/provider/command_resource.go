@@ -0,0 +1,385 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	commandResourceName   = "command"
+	defaultCommandTimeout = "10m"
+	commandPollInterval   = 2 * time.Second
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CommandResource{}
+
+func NewCommandResource() resource.Resource {
+	return &CommandResource{}
+}
+
+// CommandResource defines the command implementation.
+type CommandResource struct {
+	client *lidarr.APIClient
+	auth   context.Context
+}
+
+// Command describes the command data model.
+type Command struct {
+	Body              types.Map    `tfsdk:"body"`
+	Triggers          types.Map    `tfsdk:"triggers"`
+	Name              types.String `tfsdk:"name"`
+	Timeout           types.String `tfsdk:"timeout"`
+	Status            types.String `tfsdk:"status"`
+	Message           types.String `tfsdk:"message"`
+	ID                types.Int64  `tfsdk:"id"`
+	WaitForCompletion types.Bool   `tfsdk:"wait_for_completion"`
+}
+
+// commandFailedError describes a command which did not complete successfully.
+type commandFailedError struct {
+	name    string
+	status  string
+	message string
+}
+
+func (e *commandFailedError) Error() string {
+	return fmt.Sprintf("command %s ended with status %s: %s", e.name, e.status, e.message)
+}
+
+func (r *CommandResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + commandResourceName
+}
+
+func (r *CommandResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:System -->\nCommand resource. Runs a Lidarr command on creation, changing `name`, `body` or `triggers` runs it again. Destroying the resource only removes it from state.\nFor more information refer to [Tasks](https://wiki.servarr.com/lidarr/system#tasks) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Command name, e.g. `RefreshArtist` or `RssSync`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"body": schema.MapAttribute{
+				MarkdownDescription: "Command parameters, e.g. `artist_ids`. Keys are converted to camel case, values are parsed as JSON when possible, otherwise sent as strings.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.NoneOf("name")),
+				},
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values which run the command again when changed.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				MarkdownDescription: "Wait for the command to complete, reporting its failure as an error. Reaching `timeout` only reports a warning. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"timeout": schema.StringAttribute{
+				MarkdownDescription: "Maximum time to wait for the command, as a duration string such as `30s` or `20m`. Defaults to `" + defaultCommandTimeout + "`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(defaultCommandTimeout),
+				Validators: []validator.String{
+					helpers.Duration(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Command status when the resource was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"message": schema.StringAttribute{
+				MarkdownDescription: "Command message when the resource was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Command ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CommandResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+		r.auth = auth
+	}
+}
+
+func (r *CommandResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var command *Command
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &command)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, err := time.ParseDuration(command.Timeout.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ResourceError, fmt.Sprintf("Unable to parse timeout, got error: %s", err))
+
+		return
+	}
+
+	auth, cancel := context.WithTimeout(r.auth, timeout)
+	defer cancel()
+
+	// Run new Command
+	response, err := postCommand(auth, r.client, command.read(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, commandResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "created "+commandResourceName+": "+strconv.Itoa(int(response.GetId())))
+
+	if command.WaitForCompletion.ValueBool() {
+		completed, err := waitForCommand(auth, r.client, response.GetId(), commandPollInterval)
+
+		var failed *commandFailedError
+
+		switch {
+		case errors.As(err, &failed):
+			// The failed command is saved as tainted, to run it again on the next apply
+			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, commandResourceName, err))
+
+			response = completed
+		case err != nil:
+			// The command was already posted, keep it in state instead of running it again
+			resp.Diagnostics.AddWarning(helpers.ClientError, helpers.ParseClientError(helpers.Create, commandResourceName, err))
+		default:
+			response = completed
+
+			tflog.Trace(ctx, "completed "+commandResourceName+": "+strconv.Itoa(int(response.GetId())))
+		}
+	}
+
+	// Generate resource state struct
+	command.write(response)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &command)...)
+}
+
+func (r *CommandResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Lidarr purges the command history, the state only records the last run
+	var command *Command
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &command)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read "+commandResourceName+": "+strconv.Itoa(int(command.ID.ValueInt64())))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &command)...)
+}
+
+func (r *CommandResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Only wait_for_completion and timeout can be updated, without running the command again
+	var command *Command
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &command)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+commandResourceName+": "+strconv.Itoa(int(command.ID.ValueInt64())))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &command)...)
+}
+
+func (r *CommandResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Commands cannot be undone, only remove them from state
+	tflog.Trace(ctx, "deleted "+commandResourceName)
+	resp.State.RemoveResource(ctx)
+}
+
+func (c *Command) write(command *lidarr.CommandResource) {
+	c.ID = types.Int64Value(int64(command.GetId()))
+	c.Status = types.StringValue(string(command.GetStatus()))
+	c.Message = types.StringValue(command.GetMessage())
+}
+
+func (c *Command) read(ctx context.Context) map[string]interface{} {
+	parameters := map[string]interface{}{"name": c.Name.ValueString()}
+
+	for _, f := range helpers.ReadMapFields(ctx, c.Body) {
+		parameters[commandParameterName(f.GetName())] = commandParameterValue(f.GetValue())
+	}
+
+	return parameters
+}
+
+// commandParameterValue decodes a JSON body value, anything else is sent as a plain string.
+// Commands have no schema describing the type of their parameters.
+func commandParameterValue(value interface{}) interface{} {
+	raw, ok := value.(string)
+	if !ok {
+		return value
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal([]byte(raw), &decoded); err != nil {
+		return raw
+	}
+
+	return decoded
+}
+
+// commandParameterName converts a snake case body key into the camel case Lidarr parameter.
+func commandParameterName(name string) string {
+	parts := strings.Split(name, "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+
+	return strings.Join(parts, "")
+}
+
+// commandParametersTransport is an http.RoundTripper merging the command specific parameters into the request body.
+// The generated command resource only models the parameters shared by every command.
+type commandParametersTransport struct {
+	base       http.RoundTripper
+	parameters map[string]interface{}
+}
+
+func (t *commandParametersTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body := make(map[string]interface{}, len(t.parameters))
+
+	if req.Body != nil && req.Body != http.NoBody {
+		err := json.NewDecoder(req.Body).Decode(&body)
+		req.Body.Close()
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	maps.Copy(body, t.parameters)
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	// A RoundTripper must not modify the original request
+	req = req.Clone(req.Context())
+	req.Body = io.NopCloser(bytes.NewReader(data))
+	req.ContentLength = int64(len(data))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(data)), nil
+	}
+
+	return t.base.RoundTrip(req)
+}
+
+// postCommand sends a command with its parameters.
+func postCommand(ctx context.Context, client *lidarr.APIClient, parameters map[string]interface{}) (*lidarr.CommandResource, error) {
+	config := *client.GetConfig()
+
+	httpClient := http.Client{}
+	if config.HTTPClient != nil {
+		httpClient = *config.HTTPClient
+	}
+
+	base := httpClient.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	httpClient.Transport = &commandParametersTransport{base: base, parameters: parameters}
+	config.HTTPClient = &httpClient
+
+	command := lidarr.NewCommandResource()
+	if name, ok := parameters["name"].(string); ok {
+		command.SetName(name)
+	}
+
+	response, _, err := lidarr.NewAPIClient(&config).CommandAPI.CreateCommand(ctx).CommandResource(*command).Execute()
+
+	return response, err
+}
+
+// waitForCommand polls a command until it ends, returning an error if it did not complete successfully.
+// A command which ended unsuccessfully is returned along with the error.
+func waitForCommand(ctx context.Context, client *lidarr.APIClient, id int32, interval time.Duration) (*lidarr.CommandResource, error) {
+	for {
+		command, _, err := client.CommandAPI.GetCommandById(ctx, id).Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		switch command.GetStatus() {
+		case lidarr.COMMANDSTATUS_COMPLETED:
+			return command, nil
+		case lidarr.COMMANDSTATUS_FAILED, lidarr.COMMANDSTATUS_ABORTED, lidarr.COMMANDSTATUS_CANCELLED, lidarr.COMMANDSTATUS_ORPHANED:
+			message := command.GetMessage()
+			if exception := command.GetException(); exception != "" {
+				message += "\n" + exception
+			}
+
+			return command, &commandFailedError{name: command.GetName(), status: string(command.GetStatus()), message: message}
+		case lidarr.COMMANDSTATUS_QUEUED, lidarr.COMMANDSTATUS_STARTED:
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("command %s did not complete in time: %w", command.GetName(), ctx.Err())
+		case <-time.After(interval):
+		}
+	}
+}
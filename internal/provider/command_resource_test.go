@@ -0,0 +1,286 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCommandResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccCommandResourceConfig("RssSync", "1") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccCommandResourceConfig("RssSync", "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lidarr_command.test", "name", "RssSync"),
+					resource.TestCheckResourceAttr("lidarr_command.test", "status", "completed"),
+					resource.TestCheckResourceAttrSet("lidarr_command.test", "id"),
+				),
+			},
+			// Triggers testing
+			{
+				Config: testAccCommandResourceConfig("RssSync", "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lidarr_command.test", "triggers.run", "2"),
+					resource.TestCheckResourceAttr("lidarr_command.test", "status", "completed"),
+				),
+			},
+			// Body testing
+			{
+				Config: testAccCommandResourceBodyConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lidarr_command.test", "name", "RefreshArtist"),
+					resource.TestCheckResourceAttr("lidarr_command.test", "status", "completed"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCommandResourceConfig(name, run string) string {
+	return fmt.Sprintf(`
+		resource "lidarr_command" "test" {
+			name = "%s"
+			triggers = {
+				run = "%s"
+			}
+		}
+	`, name, run)
+}
+
+func testAccCommandResourceBodyConfig() string {
+	return `
+		resource "lidarr_command" "test" {
+			name = "RefreshArtist"
+			body = {
+				artist_ids = "[]"
+			}
+			timeout = "5m"
+		}
+	`
+}
+
+func TestCommandParameterName(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		name     string
+		expected string
+	}{
+		"single word": {
+			name:     "force",
+			expected: "force",
+		},
+		"snake case": {
+			name:     "artist_ids",
+			expected: "artistIds",
+		},
+		"camel case": {
+			name:     "albumIds",
+			expected: "albumIds",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, commandParameterName(test.name))
+		})
+	}
+}
+
+func TestPostCommand(t *testing.T) {
+	t.Parallel()
+
+	var body map[string]interface{}
+
+	// fake Lidarr recording the command
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/command", r.URL.Path)
+		assert.Equal(t, "key", r.Header.Get("X-Api-Key"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":3,"name":"RefreshArtist","status":"queued"}`))
+	}))
+	defer server.Close()
+
+	config := lidarr.NewConfiguration()
+	config.Servers[0].URL = server.URL
+	client := lidarr.NewAPIClient(config)
+	auth := context.WithValue(context.Background(), lidarr.ContextAPIKeys, map[string]lidarr.APIKey{"X-Api-Key": {Key: "key"}})
+
+	command := Command{
+		Name: types.StringValue("RefreshArtist"),
+		Body: types.MapValueMust(types.StringType, map[string]attr.Value{"artist_ids": types.StringValue("[1,2]")}),
+	}
+
+	response, err := postCommand(auth, client, command.read(context.Background()))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), response.GetId())
+	assert.Equal(t, map[string]interface{}{"name": "RefreshArtist", "artistIds": []interface{}{float64(1), float64(2)}}, body)
+}
+
+func TestPostCommandRejected(t *testing.T) {
+	t.Parallel()
+
+	// fake Lidarr rejecting the command
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"message":"Unknown command"}`))
+	}))
+	defer server.Close()
+
+	config := lidarr.NewConfiguration()
+	config.Servers[0].URL = server.URL
+	client := lidarr.NewAPIClient(config)
+
+	_, err := postCommand(context.Background(), client, map[string]interface{}{"name": "Unknown"})
+	assert.Error(t, err)
+	assert.Contains(t, helpers.ParseClientError(helpers.Create, commandResourceName, err), "Unknown command")
+}
+
+func TestWaitForCommand(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		status  string
+		message string
+		err     bool
+	}{
+		"completed": {
+			status:  "completed",
+			message: "Completed",
+		},
+		"failed": {
+			status:  "failed",
+			message: "Artist not found",
+			err:     true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls int32
+
+			// fake Lidarr running the command for the first requests
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				status := test.status
+				if atomic.AddInt32(&calls, 1) <= 2 {
+					status = "started"
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = fmt.Fprintf(w, `{"id":1,"name":"RefreshArtist","status":"%s","message":"%s"}`, status, test.message)
+			}))
+			defer server.Close()
+
+			config := lidarr.NewConfiguration()
+			config.Servers[0].URL = server.URL
+			client := lidarr.NewAPIClient(config)
+
+			command, err := waitForCommand(context.Background(), client, 1, time.Millisecond)
+			assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+			assert.Equal(t, test.err, err != nil)
+
+			if test.err {
+				assert.Contains(t, err.Error(), test.message)
+			} else {
+				assert.Equal(t, test.message, command.GetMessage())
+			}
+		})
+	}
+}
+
+func TestCommandResourceCreateWait(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		fixture string
+		status  string
+		err     bool
+	}{
+		"timeout": {
+			fixture: "command_queued.json",
+			status:  "queued",
+		},
+		"failed": {
+			fixture: "command_failed.json",
+			status:  "failed",
+			err:     true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data, _ := testFixtureProvider(t, map[string]string{
+				"POST /api/v1/command":  "command_queued.json",
+				"GET /api/v1/command/1": test.fixture,
+			})
+
+			ctx := context.Background()
+			r := NewCommandResource()
+			state := testResourceState(t, r, data)
+			plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw.Copy()}
+
+			assert.False(t, plan.Set(ctx, &Command{
+				Body:              types.MapNull(types.StringType),
+				Triggers:          types.MapNull(types.StringType),
+				Name:              types.StringValue("RssSync"),
+				Timeout:           types.StringValue("10ms"),
+				Status:            types.StringUnknown(),
+				Message:           types.StringUnknown(),
+				ID:                types.Int64Unknown(),
+				WaitForCompletion: types.BoolValue(true),
+			}).HasError())
+
+			resp := fwresource.CreateResponse{State: state}
+			r.Create(ctx, fwresource.CreateRequest{Plan: plan}, &resp)
+			assert.Equal(t, test.err, resp.Diagnostics.HasError(), resp.Diagnostics)
+			assert.Equal(t, !test.err, resp.Diagnostics.WarningsCount() == 1)
+
+			// the posted command is saved in state in any case
+			var command Command
+
+			assert.False(t, resp.State.Get(ctx, &command).HasError())
+			assert.Equal(t, int64(1), command.ID.ValueInt64())
+			assert.Equal(t, test.status, command.Status.ValueString())
+		})
+	}
+}
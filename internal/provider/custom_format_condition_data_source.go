@@ -2,6 +2,10 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
 
 	"github.com/devopsarr/lidarr-go/lidarr"
 	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
@@ -21,6 +25,13 @@ var customFormatFields = helpers.Fields{
 	Ints:    []string{"min", "max"},
 }
 
+// customFormatImplementations lists the fields required by each supported specification implementation.
+var customFormatImplementations = map[string][]string{
+	customFormatConditionReleaseGroupImplementation: {"value"},
+	customFormatConditionReleaseTitleImplementation: {"value"},
+	customFormatConditionSizeImplementation:         {"min", "max"},
+}
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ datasource.DataSource = &CustomFormatConditionDataSource{}
 
@@ -165,3 +176,47 @@ func (c *CustomFormatCondition) read(ctx context.Context) *lidarr.CustomFormatSp
 
 	return spec
 }
+
+// validate checks the implementation and its field shape, returning the problem found if any.
+func (c *CustomFormatCondition) validate() string {
+	if c.Implementation.IsUnknown() {
+		return ""
+	}
+
+	required, ok := customFormatImplementations[c.Implementation.ValueString()]
+	if !ok {
+		allowed := make([]string, 0, len(customFormatImplementations))
+		for implementation := range customFormatImplementations {
+			allowed = append(allowed, implementation)
+		}
+
+		sort.Strings(allowed)
+
+		return fmt.Sprintf("implementation %q is not supported, allowed implementations: %s", c.Implementation.ValueString(), strings.Join(allowed, ", "))
+	}
+
+	fields := []struct {
+		value attr.Value
+		name  string
+	}{
+		{name: "value", value: c.Value},
+		{name: "min", value: c.Min},
+		{name: "max", value: c.Max},
+	}
+
+	for _, field := range fields {
+		if field.value.IsUnknown() {
+			continue
+		}
+
+		if slices.Contains(required, field.name) {
+			if field.value.IsNull() {
+				return fmt.Sprintf("%s requires %s to be set", c.Implementation.ValueString(), field.name)
+			}
+		} else if !field.value.IsNull() {
+			return fmt.Sprintf("%s does not support %s, expected %s", c.Implementation.ValueString(), field.name, strings.Join(required, " and "))
+		}
+	}
+
+	return ""
+}
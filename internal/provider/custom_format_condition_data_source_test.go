@@ -3,7 +3,9 @@ package provider
 import (
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccCustomFormatConditionDataSource(t *testing.T) {
@@ -49,3 +51,76 @@ resource "lidarr_custom_format" "test" {
 	
 	specifications = [data.lidarr_custom_format_condition.test,data.lidarr_custom_format_condition.test1]	
 }`
+
+func TestCustomFormatConditionValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		condition CustomFormatCondition
+		expected  string
+	}{
+		"value": {
+			condition: CustomFormatCondition{
+				Implementation: types.StringValue("ReleaseTitleSpecification"),
+				Value:          types.StringValue("FLAC"),
+				Min:            types.Int64Null(),
+				Max:            types.Int64Null(),
+			},
+			expected: "",
+		},
+		"min max": {
+			condition: CustomFormatCondition{
+				Implementation: types.StringValue("SizeSpecification"),
+				Value:          types.StringNull(),
+				Min:            types.Int64Value(0),
+				Max:            types.Int64Value(100),
+			},
+			expected: "",
+		},
+		"unknown": {
+			condition: CustomFormatCondition{
+				Implementation: types.StringValue("SizeSpecification"),
+				Value:          types.StringNull(),
+				Min:            types.Int64Unknown(),
+				Max:            types.Int64Value(100),
+			},
+			expected: "",
+		},
+		"unsupported implementation": {
+			condition: CustomFormatCondition{
+				Implementation: types.StringValue("ReleaseTitleSpec"),
+				Value:          types.StringValue("FLAC"),
+				Min:            types.Int64Null(),
+				Max:            types.Int64Null(),
+			},
+			expected: `implementation "ReleaseTitleSpec" is not supported, allowed implementations: ReleaseGroupSpecification, ReleaseTitleSpecification, SizeSpecification`,
+		},
+		"missing value": {
+			condition: CustomFormatCondition{
+				Implementation: types.StringValue("ReleaseGroupSpecification"),
+				Value:          types.StringNull(),
+				Min:            types.Int64Null(),
+				Max:            types.Int64Null(),
+			},
+			expected: "ReleaseGroupSpecification requires value to be set",
+		},
+		"unexpected value": {
+			condition: CustomFormatCondition{
+				Implementation: types.StringValue("SizeSpecification"),
+				Value:          types.StringValue("10"),
+				Min:            types.Int64Value(0),
+				Max:            types.Int64Value(100),
+			},
+			expected: "SizeSpecification does not support value, expected min and max",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, test.condition.validate())
+		})
+	}
+}
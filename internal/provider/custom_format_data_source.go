@@ -14,7 +14,10 @@ import (
 const customFormatDataSourceName = "custom_format"
 
 // Ensure provider defined types fully satisfy framework interfaces.
-var _ datasource.DataSource = &CustomFormatDataSource{}
+var (
+	_ datasource.DataSource                     = &CustomFormatDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &CustomFormatDataSource{}
+)
 
 func NewCustomFormatDataSource() datasource.DataSource {
 	return &CustomFormatDataSource{}
@@ -41,10 +44,12 @@ func (d *CustomFormatDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Custom Format name.",
-				Required:            true,
+				Optional:            true,
+				Computed:            true,
 			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Custom Format ID.",
+				Optional:            true,
 				Computed:            true,
 			},
 			"specifications": schema.SetNestedAttribute{
@@ -88,6 +93,12 @@ func (d *CustomFormatDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 	}
 }
 
+func (d *CustomFormatDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		helpers.IDOrName("name"),
+	}
+}
+
 func (d *CustomFormatDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
 		d.client = client
@@ -112,18 +123,18 @@ func (d *CustomFormatDataSource) Read(ctx context.Context, req datasource.ReadRe
 	}
 
 	tflog.Trace(ctx, "read "+customFormatDataSourceName)
-	data.find(ctx, data.Name.ValueString(), response, &resp.Diagnostics)
+	data.find(ctx, helpers.NewLookup(data.ID, "name", data.Name), response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (c *CustomFormat) find(ctx context.Context, name string, customFormats []lidarr.CustomFormatResource, diags *diag.Diagnostics) {
+func (c *CustomFormat) find(ctx context.Context, lookup helpers.Lookup, customFormats []lidarr.CustomFormatResource, diags *diag.Diagnostics) {
 	for _, i := range customFormats {
-		if i.GetName() == name {
+		if lookup.Matches(i.GetId(), i.GetName()) {
 			c.write(ctx, &i, diags)
 
 			return
 		}
 	}
 
-	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(customFormatDataSourceName, "name", name))
+	diags.AddError(helpers.DataSourceError, lookup.NotFound(customFormatDataSourceName, helpers.Values(customFormats, (*lidarr.CustomFormatResource).GetName)))
 }
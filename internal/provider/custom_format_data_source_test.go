@@ -32,6 +32,17 @@ func TestAccCustomFormatDataSource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.lidarr_custom_format.test", "id"),
 					resource.TestCheckResourceAttr("data.lidarr_custom_format.test", "include_custom_format_when_renaming", "false")),
 			},
+			// Conflicting lookup testing
+			{
+				Config:      testAccCustomFormatDataSourceConflictConfig,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			// Read by ID testing
+			{
+				Config: testAccCustomFormatResourceConfig("dataTest", "false") + testAccCustomFormatDataSourceIDConfig("lidarr_custom_format.test.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lidarr_custom_format.test", "name", "dataTest")),
+			},
 		},
 	})
 }
@@ -43,3 +54,18 @@ func testAccCustomFormatDataSourceConfig(name string) string {
 	}
 	`, name)
 }
+
+const testAccCustomFormatDataSourceConflictConfig = `
+data "lidarr_custom_format" "test" {
+	id = 1
+	name = "Error"
+}
+`
+
+func testAccCustomFormatDataSourceIDConfig(id string) string {
+	return fmt.Sprintf(`
+	data "lidarr_custom_format" "test" {
+		id = %s
+	}
+	`, id)
+}
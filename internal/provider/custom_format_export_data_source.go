@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const customFormatExportDataSourceName = "custom_format_export"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource                     = &CustomFormatExportDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &CustomFormatExportDataSource{}
+)
+
+func NewCustomFormatExportDataSource() datasource.DataSource {
+	return &CustomFormatExportDataSource{}
+}
+
+// CustomFormatExportDataSource defines the custom format export implementation.
+type CustomFormatExportDataSource struct {
+	client *lidarr.APIClient
+	auth   context.Context
+}
+
+// CustomFormatExport describes the custom format export data model.
+type CustomFormatExport struct {
+	Name types.String `tfsdk:"name"`
+	JSON types.String `tfsdk:"json"`
+	ID   types.Int64  `tfsdk:"id"`
+}
+
+// customFormatExportDocument is a custom format as exported by the Lidarr UI.
+type customFormatExportDocument struct { //nolint:govet // key order matches the UI export
+	Name                            string                            `json:"name"`
+	IncludeCustomFormatWhenRenaming bool                              `json:"includeCustomFormatWhenRenaming"`
+	Specifications                  []customFormatExportSpecification `json:"specifications"`
+}
+
+// customFormatExportSpecification is a specification as exported by the Lidarr UI, with its fields as an object.
+type customFormatExportSpecification struct { //nolint:govet // key order matches the UI export
+	Name           string                   `json:"name"`
+	Implementation string                   `json:"implementation"`
+	Negate         bool                     `json:"negate"`
+	Required       bool                     `json:"required"`
+	Fields         customFormatExportFields `json:"fields"`
+}
+
+// customFormatExportFields marshals the specification fields as an object, keeping the Lidarr order.
+type customFormatExportFields []lidarr.Field
+
+func (f customFormatExportFields) MarshalJSON() ([]byte, error) {
+	var buffer bytes.Buffer
+
+	buffer.WriteByte('{')
+
+	for i, field := range f {
+		if i > 0 {
+			buffer.WriteByte(',')
+		}
+
+		for _, value := range []interface{}{field.GetName(), field.GetValue()} {
+			encoded, err := marshalExportJSON(value)
+			if err != nil {
+				return nil, err
+			}
+
+			buffer.Write(encoded)
+			buffer.WriteByte(':')
+		}
+
+		buffer.Truncate(buffer.Len() - 1)
+	}
+
+	buffer.WriteByte('}')
+
+	return buffer.Bytes(), nil
+}
+
+func (d *CustomFormatExportDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + customFormatExportDataSourceName
+}
+
+func (d *CustomFormatExportDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Profiles -->\nSingle [Custom Format](../resources/custom_format) exported as Lidarr JSON, the same produced by the UI export. It can be imported through the `json` attribute of the resource.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Custom Format name.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Custom Format ID.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"json": schema.StringAttribute{
+				MarkdownDescription: "Custom Format JSON export.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *CustomFormatExportDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		helpers.IDOrName("name"),
+	}
+}
+
+func (d *CustomFormatExportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *CustomFormatExportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *CustomFormatExport
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Get customFormat current value
+	response, _, err := d.client.CustomFormatAPI.ListCustomFormat(d.auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, customFormatExportDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+customFormatExportDataSourceName)
+	data.find(helpers.NewLookup(data.ID, "name", data.Name), response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (c *CustomFormatExport) find(lookup helpers.Lookup, customFormats []lidarr.CustomFormatResource, diags *diag.Diagnostics) {
+	for _, i := range customFormats {
+		if lookup.Matches(i.GetId(), i.GetName()) {
+			c.write(&i, diags)
+
+			return
+		}
+	}
+
+	diags.AddError(helpers.DataSourceError, lookup.NotFound(customFormatExportDataSourceName, helpers.Values(customFormats, (*lidarr.CustomFormatResource).GetName)))
+}
+
+func (c *CustomFormatExport) write(customFormat *lidarr.CustomFormatResource, diags *diag.Diagnostics) {
+	export, err := exportCustomFormatJSON(customFormat)
+	if err != nil {
+		diags.AddError(helpers.DataSourceError, "Unable to export "+customFormatExportDataSourceName+", got error: "+err.Error())
+
+		return
+	}
+
+	c.ID = types.Int64Value(int64(customFormat.GetId()))
+	c.Name = types.StringValue(customFormat.GetName())
+	c.JSON = types.StringValue(export)
+}
+
+// exportCustomFormatJSON renders a custom format like the Lidarr UI export: without IDs and display only
+// attributes, with the specification fields as an object, indented by two spaces.
+func exportCustomFormatJSON(customFormat *lidarr.CustomFormatResource) (string, error) {
+	document := customFormatExportDocument{
+		Name:                            customFormat.GetName(),
+		IncludeCustomFormatWhenRenaming: customFormat.GetIncludeCustomFormatWhenRenaming(),
+		Specifications:                  make([]customFormatExportSpecification, len(customFormat.GetSpecifications())),
+	}
+
+	for i, specification := range customFormat.GetSpecifications() {
+		document.Specifications[i] = customFormatExportSpecification{
+			Name:           specification.GetName(),
+			Implementation: specification.GetImplementation(),
+			Negate:         specification.GetNegate(),
+			Required:       specification.GetRequired(),
+			Fields:         specification.GetFields(),
+		}
+	}
+
+	var buffer bytes.Buffer
+
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(document); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(buffer.String(), "\n"), nil
+}
+
+// marshalExportJSON marshals a value without escaping HTML characters, like JSON.stringify.
+func marshalExportJSON(value interface{}) ([]byte, error) {
+	var buffer bytes.Buffer
+
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(value); err != nil {
+		return nil, err
+	}
+
+	return bytes.TrimSuffix(buffer.Bytes(), []byte("\n")), nil
+}
@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCustomFormatExportDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccCustomFormatExportDataSourceConfig("\"Error\"") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Not found testing
+			{
+				Config:      testAccCustomFormatExportDataSourceConfig("\"Error\""),
+				ExpectError: regexp.MustCompile("Unable to find custom_format_export"),
+			},
+			// Read testing
+			{
+				Config: testAccCustomFormatResourceConfig("exportDataTest", "false") + testAccCustomFormatExportDataSourceConfig("lidarr_custom_format.test.name"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.lidarr_custom_format_export.test", "id", "lidarr_custom_format.test", "id"),
+					resource.TestMatchResourceAttr("data.lidarr_custom_format_export.test", "json", regexp.MustCompile(`"name": "exportDataTest"`)),
+					resource.TestMatchResourceAttr("data.lidarr_custom_format_export.test", "json", regexp.MustCompile(`"min": 0`))),
+			},
+			// Import the export in another custom format
+			{
+				Config: testAccCustomFormatResourceConfig("exportDataTest", "false") + testAccCustomFormatExportDataSourceConfig("lidarr_custom_format.test.name") + testAccCustomFormatExportImportConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lidarr_custom_format.imported", "name", "exportDataTest"),
+					resource.TestCheckResourceAttr("lidarr_custom_format.imported", "specifications.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("lidarr_custom_format.imported", "specifications.*", map[string]string{
+						"name":  "Size",
+						"min":   "0",
+						"max":   "100",
+						"value": "",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCustomFormatExportDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+	data "lidarr_custom_format_export" "test" {
+		name = %s
+	}
+	`, name)
+}
+
+const testAccCustomFormatExportImportConfig = `
+resource "lidarr_custom_format" "imported" {
+	json = data.lidarr_custom_format_export.test.json
+}
+`
+
+func testCustomFormatFixture(t *testing.T) *lidarr.CustomFormatResource {
+	t.Helper()
+
+	var format lidarr.CustomFormatResource
+
+	content, err := os.ReadFile("testdata/custom_format.json")
+	assert.NoError(t, err)
+	assert.NoError(t, json.Unmarshal(content, &format))
+
+	return &format
+}
+
+func TestExportCustomFormatJSON(t *testing.T) {
+	t.Parallel()
+
+	title := lidarr.NewCustomFormatSpecificationSchema()
+	title.SetName("Lossless & <Hi-Res>")
+	title.SetImplementation("ReleaseTitleSpecification")
+	title.SetFields([]lidarr.Field{{Name: *lidarr.NewNullableString(lidarr.PtrString("value")), Value: "\\b(FLAC|24bit)\\b"}})
+
+	empty := lidarr.NewCustomFormatResource()
+	empty.SetName("Empty")
+
+	titleFormat := lidarr.NewCustomFormatResource()
+	titleFormat.SetName("Title")
+	titleFormat.SetSpecifications([]lidarr.CustomFormatSpecificationSchema{*title})
+
+	tests := map[string]struct {
+		format   *lidarr.CustomFormatResource
+		expected string
+	}{
+		"fixture": {
+			format: testCustomFormatFixture(t),
+			expected: `{
+  "name": "Preferred Group",
+  "includeCustomFormatWhenRenaming": true,
+  "specifications": [
+    {
+      "name": "Group",
+      "implementation": "ReleaseGroupSpecification",
+      "negate": false,
+      "required": true,
+      "fields": {
+        "value": "^(DEFLATE)$"
+      }
+    },
+    {
+      "name": "Size",
+      "implementation": "SizeSpecification",
+      "negate": true,
+      "required": false,
+      "fields": {
+        "min": 1,
+        "max": 100
+      }
+    }
+  ]
+}`,
+		},
+		"unescaped": {
+			format: titleFormat,
+			expected: `{
+  "name": "Title",
+  "includeCustomFormatWhenRenaming": false,
+  "specifications": [
+    {
+      "name": "Lossless & <Hi-Res>",
+      "implementation": "ReleaseTitleSpecification",
+      "negate": false,
+      "required": false,
+      "fields": {
+        "value": "\\b(FLAC|24bit)\\b"
+      }
+    }
+  ]
+}`,
+		},
+		"empty": {
+			format: empty,
+			expected: `{
+  "name": "Empty",
+  "includeCustomFormatWhenRenaming": false,
+  "specifications": []
+}`,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			export, err := exportCustomFormatJSON(test.format)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, export)
+		})
+	}
+}
+
+func TestCustomFormatExportRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	format := testCustomFormatFixture(t)
+
+	var (
+		diags    diag.Diagnostics
+		state    CustomFormat
+		expected []CustomFormatCondition
+	)
+
+	state.write(ctx, format, &diags)
+	assert.False(t, diags.HasError())
+	assert.False(t, state.Specifications.ElementsAs(ctx, &expected, false).HasError())
+
+	// Importing the export gives the same custom format as reading it from Lidarr
+	export, err := exportCustomFormatJSON(format)
+	assert.NoError(t, err)
+
+	definition, err := parseCustomFormatJSON(export)
+	assert.NoError(t, err)
+	assert.Equal(t, format.GetName(), definition.Name)
+	assert.Equal(t, format.GetIncludeCustomFormatWhenRenaming(), definition.IncludeCustomFormatWhenRenaming)
+	assert.ElementsMatch(t, expected, definition.Specifications)
+
+	// Sending the imported custom format and exporting it again loses nothing
+	data := CustomFormatResourceData{
+		CustomFormat: CustomFormat{
+			Name:                            types.StringValue(definition.Name),
+			ID:                              types.Int64Value(1),
+			IncludeCustomFormatWhenRenaming: types.BoolValue(definition.IncludeCustomFormatWhenRenaming),
+		},
+		JSON: types.StringValue(export),
+	}
+
+	request := data.read(ctx, &diags)
+	assert.False(t, diags.HasError())
+
+	reexport, err := exportCustomFormatJSON(request)
+	assert.NoError(t, err)
+
+	redefinition, err := parseCustomFormatJSON(reexport)
+	assert.NoError(t, err)
+	assert.Equal(t, definition.Name, redefinition.Name)
+	assert.Equal(t, definition.IncludeCustomFormatWhenRenaming, redefinition.IncludeCustomFormatWhenRenaming)
+	assert.ElementsMatch(t, definition.Specifications, redefinition.Specifications)
+}
@@ -2,10 +2,15 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/devopsarr/lidarr-go/lidarr"
 	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -17,12 +22,19 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-const customFormatResourceName = "custom_format"
+const (
+	customFormatResourceName = "custom_format"
+	invalidCustomFormatJSON  = "Invalid Custom Format JSON"
+	invalidCustomFormatSpec  = "Invalid Custom Format Specification"
+)
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &CustomFormatResource{}
-	_ resource.ResourceWithImportState = &CustomFormatResource{}
+	_ resource.Resource                     = &CustomFormatResource{}
+	_ resource.ResourceWithImportState      = &CustomFormatResource{}
+	_ resource.ResourceWithConfigValidators = &CustomFormatResource{}
+	_ resource.ResourceWithModifyPlan       = &CustomFormatResource{}
+	_ resource.ConfigValidator              = customFormatSpecificationsValidator{}
 )
 
 func NewCustomFormatResource() resource.Resource {
@@ -43,6 +55,13 @@ type CustomFormat struct {
 	IncludeCustomFormatWhenRenaming types.Bool   `tfsdk:"include_custom_format_when_renaming"`
 }
 
+// CustomFormatResourceData describes the custom format resource data model.
+// The JSON export is only stored in the Terraform state, the data source does not expose it.
+type CustomFormatResourceData struct {
+	JSON types.String `tfsdk:"json"`
+	CustomFormat
+}
+
 func (c CustomFormat) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -62,13 +81,18 @@ func (r *CustomFormatResource) Schema(_ context.Context, _ resource.SchemaReques
 		MarkdownDescription: "<!-- subcategory:Profiles -->\nCustom Format resource.\nFor more information refer to [Custom Format](https://wiki.servarr.com/lidarr/settings#custom-formats).",
 		Attributes: map[string]schema.Attribute{
 			"include_custom_format_when_renaming": schema.BoolAttribute{
-				MarkdownDescription: "Include custom format when renaming flag.",
+				MarkdownDescription: "Include custom format when renaming flag. Defaults to the `json` value when set.",
 				Optional:            true,
 				Computed:            true,
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "Custom Format name.",
-				Required:            true,
+				MarkdownDescription: "Custom Format name. Required unless `json` is set, in which case it overrides the exported name.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"json": schema.StringAttribute{
+				MarkdownDescription: "Custom Format JSON, as exported by Lidarr. Conflicts with `specifications`, which are computed from it.",
+				Optional:            true,
 			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Custom Format ID.",
@@ -78,8 +102,9 @@ func (r *CustomFormatResource) Schema(_ context.Context, _ resource.SchemaReques
 				},
 			},
 			"specifications": schema.SetNestedAttribute{
-				MarkdownDescription: "Specifications.",
-				Required:            true,
+				MarkdownDescription: "Specifications. Required unless `json` is set.",
+				Optional:            true,
+				Computed:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: r.getSpecificationSchema().Attributes,
 				},
@@ -107,7 +132,7 @@ func (r CustomFormatResource) getSpecificationSchema() schema.Schema {
 				Computed:            true,
 			},
 			"implementation": schema.StringAttribute{
-				MarkdownDescription: "Implementation.",
+				MarkdownDescription: "Implementation. Supported values are `ReleaseGroupSpecification` and `ReleaseTitleSpecification` with `value`, `SizeSpecification` with `min` and `max`.",
 				Optional:            true,
 				Computed:            true,
 			},
@@ -131,6 +156,101 @@ func (r CustomFormatResource) getSpecificationSchema() schema.Schema {
 	}
 }
 
+func (r *CustomFormatResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("json"),
+			path.MatchRoot("specifications"),
+		),
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("json"),
+			path.MatchRoot("name"),
+		),
+		customFormatSpecificationsValidator{},
+	}
+}
+
+// customFormatSpecificationsValidator reports unsupported specification implementations and fields before reaching Lidarr.
+type customFormatSpecificationsValidator struct{}
+
+func (v customFormatSpecificationsValidator) Description(_ context.Context) string {
+	return "specifications must use a supported implementation with its fields"
+}
+
+func (v customFormatSpecificationsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v customFormatSpecificationsValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var specifications types.Set
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("specifications"), &specifications)...)
+
+	if resp.Diagnostics.HasError() || specifications.IsNull() || specifications.IsUnknown() {
+		return
+	}
+
+	conditions := make([]CustomFormatCondition, len(specifications.Elements()))
+	resp.Diagnostics.Append(specifications.ElementsAs(ctx, &conditions, false)...)
+
+	for n, condition := range conditions {
+		if detail := condition.validate(); detail != "" {
+			resp.Diagnostics.AddAttributeError(path.Root("specifications"), invalidCustomFormatSpec, fmt.Sprintf("specifications[%d] %q: %s.", n, condition.Name.ValueString(), detail))
+		}
+	}
+}
+
+func (r *CustomFormatResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config, plan CustomFormatResourceData
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() || config.JSON.IsNull() || config.JSON.IsUnknown() {
+		return
+	}
+
+	definition, err := parseCustomFormatJSON(config.JSON.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("json"), invalidCustomFormatJSON, err.Error())
+
+		return
+	}
+
+	// Exported values are used unless overridden in the configuration
+	if config.Name.IsNull() {
+		if definition.Name == "" {
+			resp.Diagnostics.AddAttributeError(path.Root("name"), invalidCustomFormatJSON, "name must be set when missing in the custom format JSON.")
+
+			return
+		}
+
+		plan.Name = types.StringValue(definition.Name)
+	}
+
+	if config.IncludeCustomFormatWhenRenaming.IsNull() {
+		plan.IncludeCustomFormatWhenRenaming = types.BoolValue(definition.IncludeCustomFormatWhenRenaming)
+	}
+
+	// Specifications are only known after apply when the JSON changes
+	if !req.State.Raw.IsNull() {
+		var state CustomFormatResourceData
+
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+		if state.JSON.Equal(config.JSON) {
+			plan.Specifications = state.Specifications
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
 func (r *CustomFormatResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -140,7 +260,7 @@ func (r *CustomFormatResource) Configure(ctx context.Context, req resource.Confi
 
 func (r *CustomFormatResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var format *CustomFormat
+	var format *CustomFormatResourceData
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &format)...)
 
@@ -151,6 +271,10 @@ func (r *CustomFormatResource) Create(ctx context.Context, req resource.CreateRe
 	// Create new CustomFormat
 	request := format.read(ctx, &resp.Diagnostics)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	response, _, err := r.client.CustomFormatAPI.CreateCustomFormat(r.auth).CustomFormatResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, customFormatResourceName, err))
@@ -158,10 +282,12 @@ func (r *CustomFormatResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	response = helpers.ReadAfterCreate(ctx, response, customFormatResourceName, r.client.CustomFormatAPI.GetCustomFormatById(r.auth, response.GetId()).Execute, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+customFormatResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state CustomFormat
+	state := CustomFormatResourceData{JSON: format.JSON}
 
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
@@ -169,7 +295,7 @@ func (r *CustomFormatResource) Create(ctx context.Context, req resource.CreateRe
 
 func (r *CustomFormatResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var format CustomFormat
+	var format CustomFormatResourceData
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &format)...)
 
@@ -178,8 +304,15 @@ func (r *CustomFormatResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Get CustomFormat current value
-	response, _, err := r.client.CustomFormatAPI.GetCustomFormatById(r.auth, int32(format.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.CustomFormatAPI.GetCustomFormatById(r.auth, int32(format.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			resp.Diagnostics.AddWarning(helpers.ResourceNotFound, helpers.ParseResourceNotFound(customFormatResourceName, format.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, customFormatResourceName, err))
 
 		return
@@ -188,7 +321,7 @@ func (r *CustomFormatResource) Read(ctx context.Context, req resource.ReadReques
 	tflog.Trace(ctx, "read "+customFormatResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state CustomFormat
+	state := CustomFormatResourceData{JSON: format.JSON}
 
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
@@ -196,7 +329,7 @@ func (r *CustomFormatResource) Read(ctx context.Context, req resource.ReadReques
 
 func (r *CustomFormatResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var format *CustomFormat
+	var format *CustomFormatResourceData
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &format)...)
 
@@ -207,6 +340,10 @@ func (r *CustomFormatResource) Update(ctx context.Context, req resource.UpdateRe
 	// Update CustomFormat
 	request := format.read(ctx, &resp.Diagnostics)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	response, _, err := r.client.CustomFormatAPI.UpdateCustomFormat(r.auth, strconv.Itoa(int(request.GetId()))).CustomFormatResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, customFormatResourceName, err))
@@ -217,7 +354,7 @@ func (r *CustomFormatResource) Update(ctx context.Context, req resource.UpdateRe
 	tflog.Trace(ctx, "updated "+customFormatResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state CustomFormat
+	state := CustomFormatResourceData{JSON: format.JSON}
 
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
@@ -264,6 +401,27 @@ func (c *CustomFormat) write(ctx context.Context, customFormat *lidarr.CustomFor
 	diags.Append(tempDiag...)
 }
 
+// read builds the custom format request, computing the specifications from the JSON export when set.
+func (c *CustomFormatResourceData) read(ctx context.Context, diags *diag.Diagnostics) *lidarr.CustomFormatResource {
+	if c.JSON.IsNull() {
+		return c.CustomFormat.read(ctx, diags)
+	}
+
+	definition, err := parseCustomFormatJSON(c.JSON.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("json"), invalidCustomFormatJSON, err.Error())
+
+		return nil
+	}
+
+	var tempDiag diag.Diagnostics
+
+	c.Specifications, tempDiag = types.SetValueFrom(ctx, CustomFormatCondition{}.getType(), definition.Specifications)
+	diags.Append(tempDiag...)
+
+	return c.CustomFormat.read(ctx, diags)
+}
+
 func (c *CustomFormat) read(ctx context.Context, diags *diag.Diagnostics) *lidarr.CustomFormatResource {
 	specifications := make([]CustomFormatCondition, len(c.Specifications.Elements()))
 	diags.Append(c.Specifications.ElementsAs(ctx, &specifications, false)...)
@@ -281,3 +439,183 @@ func (c *CustomFormat) read(ctx context.Context, diags *diag.Diagnostics) *lidar
 
 	return format
 }
+
+// customFormatDefinition describes a custom format parsed from a Lidarr JSON export.
+type customFormatDefinition struct {
+	Name                            string
+	Specifications                  []CustomFormatCondition
+	IncludeCustomFormatWhenRenaming bool
+}
+
+// customFormatJSONError reports an invalid custom format JSON with the offending path.
+type customFormatJSONError struct {
+	path   string
+	detail string
+}
+
+func (e customFormatJSONError) Error() string {
+	return fmt.Sprintf("invalid custom format JSON at %s: %s", e.path, e.detail)
+}
+
+// parseCustomFormatJSON converts a Lidarr custom format JSON export into its definition.
+// Specification fields can be either exported as a list of name/value objects or as an object.
+func parseCustomFormatJSON(raw string) (*customFormatDefinition, error) {
+	decoder := json.NewDecoder(strings.NewReader(raw))
+	decoder.UseNumber()
+
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			return nil, customFormatJSONError{path: "$", detail: fmt.Sprintf("%s (offset %d)", syntaxErr.Error(), syntaxErr.Offset)}
+		}
+
+		return nil, customFormatJSONError{path: "$", detail: err.Error()}
+	}
+
+	root, ok := document.(map[string]interface{})
+	if !ok {
+		return nil, customFormatJSONError{path: "$", detail: "expected an object"}
+	}
+
+	definition := &customFormatDefinition{}
+
+	if name, found := root["name"]; found {
+		if definition.Name, ok = name.(string); !ok {
+			return nil, customFormatJSONError{path: "$.name", detail: "expected a string"}
+		}
+	}
+
+	if include, found := root["includeCustomFormatWhenRenaming"]; found {
+		if definition.IncludeCustomFormatWhenRenaming, ok = include.(bool); !ok {
+			return nil, customFormatJSONError{path: "$.includeCustomFormatWhenRenaming", detail: "expected a boolean"}
+		}
+	}
+
+	specifications, ok := root["specifications"].([]interface{})
+	if !ok {
+		return nil, customFormatJSONError{path: "$.specifications", detail: "expected an array"}
+	}
+
+	definition.Specifications = make([]CustomFormatCondition, len(specifications))
+
+	for n, s := range specifications {
+		specPath := fmt.Sprintf("$.specifications[%d]", n)
+		if err := definition.Specifications[n].parse(specPath, s); err != nil {
+			return nil, err
+		}
+
+		if detail := definition.Specifications[n].validate(); detail != "" {
+			return nil, customFormatJSONError{path: specPath, detail: detail}
+		}
+	}
+
+	return definition, nil
+}
+
+// parse populates the condition from a JSON exported specification.
+func (c *CustomFormatCondition) parse(jsonPath string, raw interface{}) error {
+	spec, ok := raw.(map[string]interface{})
+	if !ok {
+		return customFormatJSONError{path: jsonPath, detail: "expected an object"}
+	}
+
+	name, ok := spec["name"].(string)
+	if !ok {
+		return customFormatJSONError{path: jsonPath + ".name", detail: "expected a string"}
+	}
+
+	implementation, ok := spec["implementation"].(string)
+	if !ok {
+		return customFormatJSONError{path: jsonPath + ".implementation", detail: "expected a string"}
+	}
+
+	flags := map[string]bool{}
+
+	for _, key := range []string{"negate", "required"} {
+		if flags[key], ok = spec[key].(bool); !ok && spec[key] != nil {
+			return customFormatJSONError{path: jsonPath + "." + key, detail: "expected a boolean"}
+		}
+	}
+
+	c.Name = types.StringValue(name)
+	c.Implementation = types.StringValue(implementation)
+	c.Negate = types.BoolValue(flags["negate"])
+	c.Required = types.BoolValue(flags["required"])
+	c.Value = types.StringNull()
+	c.Min = types.Int64Null()
+	c.Max = types.Int64Null()
+
+	return c.parseFields(jsonPath+".fields", spec["fields"])
+}
+
+// parseFields populates the condition fields, exported either as an object or as a list of name/value objects.
+func (c *CustomFormatCondition) parseFields(jsonPath string, raw interface{}) error {
+	switch fields := raw.(type) {
+	case nil:
+		return nil
+	case map[string]interface{}:
+		for name, value := range fields {
+			if err := c.parseField(jsonPath+"."+name, name, value); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for n, f := range fields {
+			fieldPath := fmt.Sprintf("%s[%d]", jsonPath, n)
+
+			field, ok := f.(map[string]interface{})
+			if !ok {
+				return customFormatJSONError{path: fieldPath, detail: "expected an object"}
+			}
+
+			name, ok := field["name"].(string)
+			if !ok {
+				return customFormatJSONError{path: fieldPath + ".name", detail: "expected a string"}
+			}
+
+			if err := c.parseField(fieldPath+".value", name, field["value"]); err != nil {
+				return err
+			}
+		}
+	default:
+		return customFormatJSONError{path: jsonPath, detail: "expected an object or an array"}
+	}
+
+	return nil
+}
+
+// parseField populates a condition field value, numeric values are accepted for the string value.
+func (c *CustomFormatCondition) parseField(jsonPath, name string, raw interface{}) error {
+	switch name {
+	case "value":
+		switch value := raw.(type) {
+		case string:
+			c.Value = types.StringValue(value)
+		case json.Number:
+			c.Value = types.StringValue(value.String())
+		default:
+			return customFormatJSONError{path: jsonPath, detail: "expected a string"}
+		}
+	case "min", "max":
+		number, ok := raw.(json.Number)
+		if !ok {
+			return customFormatJSONError{path: jsonPath, detail: "expected a number"}
+		}
+
+		value, err := number.Int64()
+		if err != nil {
+			return customFormatJSONError{path: jsonPath, detail: "expected an integer"}
+		}
+
+		if name == "min" {
+			c.Min = types.Int64Value(value)
+		} else {
+			c.Max = types.Int64Value(value)
+		}
+	default:
+		return customFormatJSONError{path: jsonPath, detail: "unsupported field " + name + ", expected one of value, min, max"}
+	}
+
+	return nil
+}
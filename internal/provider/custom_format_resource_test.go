@@ -1,16 +1,24 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"os"
 	"regexp"
 	"testing"
 
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccCustomFormatResource(t *testing.T) {
 	t.Parallel()
 
+	var id int32
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -38,6 +46,25 @@ func TestAccCustomFormatResource(t *testing.T) {
 				Config: testAccCustomFormatResourceConfig("resourceTest", "true"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("lidarr_custom_format.test", "include_custom_format_when_renaming", "true"),
+					testAccResourceID("lidarr_custom_format.test", &id),
+				),
+			},
+			// Out of band delete testing
+			{
+				PreConfig: func() {
+					if _, err := testAccAPIClient().CustomFormatAPI.DeleteCustomFormat(context.Background(), id).Execute(); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:             testAccCustomFormatResourceConfig("resourceTest", "true"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Recreate testing
+			{
+				Config: testAccCustomFormatResourceConfig("resourceTest", "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("lidarr_custom_format.test", "id"),
 				),
 			},
 			// ImportState testing
@@ -46,11 +73,65 @@ func TestAccCustomFormatResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// JSON testing
+			{
+				Config: testAccCustomFormatResourceJSONConfig(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lidarr_custom_format.test", "name", "jsonTest"),
+					resource.TestCheckResourceAttr("lidarr_custom_format.test", "include_custom_format_when_renaming", "true"),
+					resource.TestCheckResourceAttr("lidarr_custom_format.test", "specifications.#", "2"),
+				),
+			},
+			// JSON name override testing
+			{
+				Config: testAccCustomFormatResourceJSONConfig("name = \"resourceTest\""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lidarr_custom_format.test", "name", "resourceTest"),
+					resource.TestCheckResourceAttr("lidarr_custom_format.test", "specifications.#", "2"),
+				),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
 }
 
+func TestAccCustomFormatResourceInvalidJSON(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "lidarr_custom_format" "test" {
+					json = jsonencode({
+						name = "invalid"
+						specifications = [{ name = "Size", implementation = "SizeSpecification", fields = { min = "small" } }]
+					})
+				}`,
+				ExpectError: regexp.MustCompile(`\$\.specifications\[0\]\.fields\.min: expected a number`),
+			},
+			{
+				Config: `
+				resource "lidarr_custom_format" "test" {
+					name = "invalid"
+					specifications = [{ name = "Size", implementation = "SizeSpecification", value = "10" }]
+				}`,
+				ExpectError: regexp.MustCompile(`SizeSpecification does not support value, expected min and max`),
+			},
+			{
+				Config: `
+				resource "lidarr_custom_format" "test" {
+					name = "invalid"
+					specifications = [{ name = "Words", implementation = "ReleaseTitleSpec", value = "flac" }]
+				}`,
+				ExpectError: regexp.MustCompile(`allowed implementations: ReleaseGroupSpecification`),
+			},
+		},
+	})
+}
+
 func testAccCustomFormatResourceConfig(name, enable string) string {
 	return fmt.Sprintf(`
 	resource "lidarr_custom_format" "test" {
@@ -76,3 +157,195 @@ func testAccCustomFormatResourceConfig(name, enable string) string {
 		]
 	}`, enable, name)
 }
+
+func testAccCustomFormatResourceJSONConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "lidarr_custom_format" "test" {
+		%s
+		json = jsonencode({
+			name = "jsonTest"
+			includeCustomFormatWhenRenaming = true
+			specifications = [
+				{
+					name = "Preferred Words"
+					implementation = "ReleaseTitleSpecification"
+					negate = false
+					required = false
+					fields = { value = "\\b(SPARKS|Framestor)\\b" }
+				},
+				{
+					name = "Size"
+					implementation = "SizeSpecification"
+					negate = false
+					required = false
+					fields = [{ name = "min", value = 0 }, { name = "max", value = 100 }]
+				}
+			]
+		})
+	}`, name)
+}
+
+func TestParseCustomFormatJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		expected *customFormatDefinition
+		json     string
+		err      string
+	}{
+		"fields object": {
+			json: `{"name":"test","includeCustomFormatWhenRenaming":true,"specifications":[{"name":"Words","implementation":"ReleaseTitleSpecification","negate":true,"fields":{"value":"flac"}}]}`,
+			expected: &customFormatDefinition{
+				Name:                            "test",
+				IncludeCustomFormatWhenRenaming: true,
+				Specifications: []CustomFormatCondition{{
+					Name:           types.StringValue("Words"),
+					Implementation: types.StringValue("ReleaseTitleSpecification"),
+					Value:          types.StringValue("flac"),
+					Min:            types.Int64Null(),
+					Max:            types.Int64Null(),
+					Negate:         types.BoolValue(true),
+					Required:       types.BoolValue(false),
+				}},
+			},
+		},
+		"fields array": {
+			json: `{"name":"test","specifications":[{"name":"Size","implementation":"SizeSpecification","required":true,"fields":[{"name":"min","value":1},{"name":"max","value":10}]}]}`,
+			expected: &customFormatDefinition{
+				Name: "test",
+				Specifications: []CustomFormatCondition{{
+					Name:           types.StringValue("Size"),
+					Implementation: types.StringValue("SizeSpecification"),
+					Value:          types.StringNull(),
+					Min:            types.Int64Value(1),
+					Max:            types.Int64Value(10),
+					Negate:         types.BoolValue(false),
+					Required:       types.BoolValue(true),
+				}},
+			},
+		},
+		"truncated": {
+			json: `{"name":`,
+			err:  "invalid custom format JSON at $: unexpected EOF",
+		},
+		"syntax": {
+			json: `{"name" "test"}`,
+			err:  "invalid custom format JSON at $: invalid character '\"' after object key (offset 9)",
+		},
+		"not an object": {
+			json: `[]`,
+			err:  "invalid custom format JSON at $: expected an object",
+		},
+		"missing specifications": {
+			json: `{"name":"test"}`,
+			err:  "invalid custom format JSON at $.specifications: expected an array",
+		},
+		"missing implementation": {
+			json: `{"specifications":[{"name":"Words"}]}`,
+			err:  "invalid custom format JSON at $.specifications[0].implementation: expected a string",
+		},
+		"invalid flag": {
+			json: `{"specifications":[{"name":"Words","implementation":"ReleaseTitleSpecification","negate":"yes"}]}`,
+			err:  "invalid custom format JSON at $.specifications[0].negate: expected a boolean",
+		},
+		"invalid min": {
+			json: `{"specifications":[{"name":"Size","implementation":"SizeSpecification","fields":{"min":1.5}}]}`,
+			err:  "invalid custom format JSON at $.specifications[0].fields.min: expected an integer",
+		},
+		"unsupported implementation": {
+			json: `{"specifications":[{"name":"Words","implementation":"ReleaseTitleSpec","fields":{"value":"flac"}}]}`,
+			err:  "invalid custom format JSON at $.specifications[0]: implementation \"ReleaseTitleSpec\" is not supported",
+		},
+		"unsupported field": {
+			json: `{"specifications":[{"name":"Size","implementation":"SizeSpecification","fields":[{"name":"size","value":1}]}]}`,
+			err:  "invalid custom format JSON at $.specifications[0].fields[0].value: unsupported field size, expected one of value, min, max",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			definition, err := parseCustomFormatJSON(test.json)
+			if test.err != "" {
+				assert.ErrorContains(t, err, test.err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, definition)
+		})
+	}
+}
+
+func TestCustomFormatResourceMapping(t *testing.T) {
+	t.Parallel()
+
+	data, transport := testFixtureProvider(t, map[string]string{
+		"GET /api/v1/customformat/1": "custom_format.json",
+	})
+	r := NewCustomFormatResource()
+
+	// Read maps the Lidarr custom format to the resource attributes
+	state := testResourceRead(t, r, data, 1)
+
+	var format CustomFormatResourceData
+
+	assert.False(t, state.Get(context.Background(), &format).HasError())
+	assert.Equal(t, "Preferred Group", format.Name.ValueString())
+	assert.True(t, format.IncludeCustomFormatWhenRenaming.ValueBool())
+	assert.True(t, format.JSON.IsNull())
+
+	specifications := make([]CustomFormatCondition, 0)
+	assert.False(t, format.Specifications.ElementsAs(context.Background(), &specifications, false).HasError())
+	assert.ElementsMatch(t, []CustomFormatCondition{
+		{
+			Name:           types.StringValue("Group"),
+			Implementation: types.StringValue("ReleaseGroupSpecification"),
+			Value:          types.StringValue("^(DEFLATE)$"),
+			Min:            types.Int64Null(),
+			Max:            types.Int64Null(),
+			Negate:         types.BoolValue(false),
+			Required:       types.BoolValue(true),
+		},
+		{
+			Name:           types.StringValue("Size"),
+			Implementation: types.StringValue("SizeSpecification"),
+			Value:          types.StringNull(),
+			Min:            types.Int64Value(1),
+			Max:            types.Int64Value(100),
+			Negate:         types.BoolValue(true),
+			Required:       types.BoolValue(false),
+		},
+	}, specifications)
+
+	// Applying the read state back must send the same custom format to Lidarr
+	testResourceUpdate(t, r, state)
+
+	var expected, actual lidarr.CustomFormatResource
+
+	content, err := os.ReadFile("testdata/custom_format.json")
+	assert.NoError(t, err)
+	assert.NoError(t, json.Unmarshal(content, &expected))
+	assert.NoError(t, json.Unmarshal(transport.request("PUT /api/v1/customformat/1"), &actual))
+
+	assert.Equal(t, expected.GetName(), actual.GetName())
+	assert.Equal(t, expected.GetIncludeCustomFormatWhenRenaming(), actual.GetIncludeCustomFormatWhenRenaming())
+
+	sent := make(map[string]lidarr.CustomFormatSpecificationSchema)
+	for _, specification := range actual.GetSpecifications() {
+		sent[specification.GetName()] = specification
+	}
+
+	assert.Len(t, sent, len(expected.GetSpecifications()))
+
+	for _, specification := range expected.GetSpecifications() {
+		request := sent[specification.GetName()]
+		assert.Equal(t, specification.GetImplementation(), request.GetImplementation())
+		assert.Equal(t, specification.GetNegate(), request.GetNegate())
+		assert.Equal(t, specification.GetRequired(), request.GetRequired())
+		assert.Equal(t, testFieldValues(specification.GetFields()), testFieldValues(request.GetFields()))
+	}
+}
@@ -113,5 +113,5 @@ func (p *DelayProfile) find(ctx context.Context, id int64, profiles []lidarr.Del
 		}
 	}
 
-	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(delayProfileDataSourceName, "id", strconv.Itoa(int(id))))
+	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(delayProfileDataSourceName, "id", strconv.Itoa(int(id)), helpers.Values(profiles, (*lidarr.DelayProfileResource).GetId)))
 }
@@ -2,10 +2,12 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
 	"github.com/devopsarr/lidarr-go/lidarr"
 	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -23,8 +25,9 @@ const delayProfileResourceName = "delay_profile"
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &DelayProfileResource{}
-	_ resource.ResourceWithImportState = &DelayProfileResource{}
+	_ resource.Resource                     = &DelayProfileResource{}
+	_ resource.ResourceWithImportState      = &DelayProfileResource{}
+	_ resource.ResourceWithConfigValidators = &DelayProfileResource{}
 )
 
 func NewDelayProfileResource() resource.Resource {
@@ -37,6 +40,14 @@ type DelayProfileResource struct {
 	auth   context.Context
 }
 
+// DelayProfileResourceData describes the delay profile resource data model.
+// It extends the delay profile data model with attributes not returned by Lidarr.
+type DelayProfileResourceData struct {
+	DelayProfile
+	TagLabels
+	SkipDefaultTags types.Bool `tfsdk:"skip_default_tags"`
+}
+
 // DelayProfile describes the delay profile data model.
 type DelayProfile struct {
 	Tags              types.Set    `tfsdk:"tags"`
@@ -104,22 +115,33 @@ func (r *DelayProfileResource) Schema(_ context.Context, _ resource.SchemaReques
 				Computed:            true,
 			},
 			"tags": schema.SetAttribute{
-				MarkdownDescription: "List of associated tags.",
-				Required:            true,
+				MarkdownDescription: "List of associated tags. Exactly one of `tags` and `tag_labels` must be set.",
+				Optional:            true,
+				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels":          tagLabelsAttribute(),
+			"create_missing_tags": createMissingTagsAttribute(),
+			"skip_default_tags":   skipDefaultTagsAttribute(),
 			"preferred_protocol": schema.StringAttribute{
-				MarkdownDescription: "Preferred protocol.",
+				MarkdownDescription: "Preferred protocol. Valid values are 'usenet' and 'torrent', the protocol must not be disabled.",
 				Optional:            true,
 				Computed:            true,
 				Validators: []validator.String{
-					stringvalidator.OneOf("usenet", "torrent"),
+					stringvalidator.OneOf(string(lidarr.DOWNLOADPROTOCOL_USENET), string(lidarr.DOWNLOADPROTOCOL_TORRENT)),
 				},
 			},
 		},
 	}
 }
 
+func (r *DelayProfileResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		delayProfileProtocolValidator{},
+		resourcevalidator.ExactlyOneOf(path.MatchRoot("tags"), path.MatchRoot("tag_labels")),
+	}
+}
+
 func (r *DelayProfileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -129,7 +151,7 @@ func (r *DelayProfileResource) Configure(ctx context.Context, req resource.Confi
 
 func (r *DelayProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var profile *DelayProfile
+	var profile *DelayProfileResourceData
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &profile)...)
 
@@ -137,8 +159,20 @@ func (r *DelayProfileResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, profile.TagLabels, &profile.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Build Create resource
 	request := profile.read(ctx, &resp.Diagnostics)
+	applyDefaultTags(r.auth, request, profile.SkipDefaultTags)
+
+	if !resolver.validate(delayProfileResourceName, request, &resp.Diagnostics) {
+		return
+	}
 
 	// Create new DelayProfile
 	response, _, err := r.client.DelayProfileAPI.CreateDelayProfile(r.auth).DelayProfileResource(*request).Execute()
@@ -148,6 +182,8 @@ func (r *DelayProfileResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	response = helpers.ReadAfterCreate(ctx, response, delayProfileResourceName, r.client.DelayProfileAPI.GetDelayProfileById(r.auth, response.GetId()).Execute, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created"+delayProfileResourceName+": "+strconv.Itoa(int(response.GetId())))
 
 	// Set order on create
@@ -163,13 +199,15 @@ func (r *DelayProfileResource) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, profile.Tags, profile.SkipDefaultTags)
 	profile.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &profile.TagLabels, profile.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &profile)...)
 }
 
 func (r *DelayProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var profile *DelayProfile
+	var profile *DelayProfileResourceData
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &profile)...)
 
@@ -178,8 +216,15 @@ func (r *DelayProfileResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Get delayprofile current value
-	response, _, err := r.client.DelayProfileAPI.GetDelayProfileById(r.auth, int32(profile.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DelayProfileAPI.GetDelayProfileById(r.auth, int32(profile.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			resp.Diagnostics.AddWarning(helpers.ResourceNotFound, helpers.ParseResourceNotFound(delayProfileResourceName, profile.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, delayProfileResourceName, err))
 
 		return
@@ -187,13 +232,15 @@ func (r *DelayProfileResource) Read(ctx context.Context, req resource.ReadReques
 
 	tflog.Trace(ctx, "read "+delayProfileResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	removeDefaultTags(ctx, r.auth, response, profile.Tags, profile.SkipDefaultTags)
 	profile.write(ctx, response, &resp.Diagnostics)
+	newTagResolver(r.auth, r.client).write(ctx, &profile.TagLabels, profile.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &profile)...)
 }
 
 func (r *DelayProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var profile *DelayProfile
+	var profile *DelayProfileResourceData
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &profile)...)
 
@@ -201,8 +248,20 @@ func (r *DelayProfileResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, profile.TagLabels, &profile.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Build Update resource
 	request := profile.read(ctx, &resp.Diagnostics)
+	applyDefaultTags(r.auth, request, profile.SkipDefaultTags)
+
+	if !resolver.validate(delayProfileResourceName, request, &resp.Diagnostics) {
+		return
+	}
 
 	// Update DelayProfile
 	response, _, err := r.client.DelayProfileAPI.UpdateDelayProfile(r.auth, strconv.Itoa(int(request.GetId()))).DelayProfileResource(*request).Execute()
@@ -214,7 +273,9 @@ func (r *DelayProfileResource) Update(ctx context.Context, req resource.UpdateRe
 
 	tflog.Trace(ctx, "updated "+delayProfileResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, profile.Tags, profile.SkipDefaultTags)
 	profile.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &profile.TagLabels, profile.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &profile)...)
 }
 
@@ -271,3 +332,44 @@ func (p *DelayProfile) read(ctx context.Context, diags *diag.Diagnostics) *lidar
 
 	return profile
 }
+
+// delayProfileProtocolValidator rejects a preferred protocol explicitly disabled by its enable flag.
+type delayProfileProtocolValidator struct{}
+
+func (v delayProfileProtocolValidator) Description(_ context.Context) string {
+	return "preferred_protocol must not be disabled"
+}
+
+func (v delayProfileProtocolValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v delayProfileProtocolValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var protocol types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("preferred_protocol"), &protocol)...)
+
+	if protocol.IsNull() || protocol.IsUnknown() {
+		return
+	}
+
+	var enabled types.Bool
+
+	attribute := "enable_" + protocol.ValueString()
+
+	// Skip the invalid protocols, already reported by the attribute validator
+	if diags := req.Config.GetAttribute(ctx, path.Root(attribute), &enabled); diags.HasError() {
+		return
+	}
+
+	// Null flags are computed by Lidarr, only explicitly disabled ones can be detected
+	if enabled.IsNull() || enabled.IsUnknown() || enabled.ValueBool() {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		path.Root("preferred_protocol"),
+		"Preferred Protocol Disabled",
+		fmt.Sprintf("Preferred protocol %s is disabled by %s, enable it or prefer the other protocol.", protocol.ValueString(), attribute),
+	)
+}
@@ -20,6 +20,16 @@ func TestAccDelayProfileResource(t *testing.T) {
 				Config:      testAccDelayProfileResourceConfig("usenet", "0") + testUnauthorizedProvider,
 				ExpectError: regexp.MustCompile("Client Error"),
 			},
+			// Disabled preferred protocol
+			{
+				Config: `
+				resource "lidarr_delay_profile" "test" {
+					enable_torrent = false
+					preferred_protocol = "torrent"
+					tags = [1]
+				}`,
+				ExpectError: regexp.MustCompile("Preferred protocol torrent is disabled by enable_torrent"),
+			},
 			// Create and Read testing
 			{
 				Config: testAccTagResourceConfig("test", "delay_profile_resource") + testAccDelayProfileResourceConfig("usenet", "lidarr_tag.test.id"),
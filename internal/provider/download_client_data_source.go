@@ -15,7 +15,10 @@ import (
 const downloadClientDataSourceName = "download_client"
 
 // Ensure provider defined types fully satisfy framework interfaces.
-var _ datasource.DataSource = &DownloadClientDataSource{}
+var (
+	_ datasource.DataSource                     = &DownloadClientDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &DownloadClientDataSource{}
+)
 
 func NewDownloadClientDataSource() datasource.DataSource {
 	return &DownloadClientDataSource{}
@@ -62,7 +65,8 @@ func (d *DownloadClientDataSource) Schema(_ context.Context, _ datasource.Schema
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Download Client name.",
-				Required:            true,
+				Optional:            true,
+				Computed:            true,
 			},
 			"protocol": schema.StringAttribute{
 				MarkdownDescription: "Protocol. Valid values are 'usenet' and 'torrent'.",
@@ -75,6 +79,7 @@ func (d *DownloadClientDataSource) Schema(_ context.Context, _ datasource.Schema
 			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
+				Optional:            true,
 				Computed:            true,
 			},
 			// Field values
@@ -213,10 +218,21 @@ func (d *DownloadClientDataSource) Schema(_ context.Context, _ datasource.Schema
 				Computed:            true,
 				ElementType:         types.StringType,
 			},
+			"fields": schema.MapAttribute{
+				MarkdownDescription: "Raw field values for settings not covered by other attributes, keyed by API field name.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
 		},
 	}
 }
 
+func (d *DownloadClientDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		helpers.IDOrName("name"),
+	}
+}
+
 func (d *DownloadClientDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
 		d.client = client
@@ -240,20 +256,20 @@ func (d *DownloadClientDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
-	data.find(ctx, data.Name.ValueString(), response, &resp.Diagnostics)
+	data.find(ctx, helpers.NewLookup(data.ID, "name", data.Name), response, &resp.Diagnostics)
 	tflog.Trace(ctx, "read "+downloadClientDataSourceName)
 	// Map response body to resource schema attribute
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (d *DownloadClient) find(ctx context.Context, name string, downloadClients []lidarr.DownloadClientResource, diags *diag.Diagnostics) {
+func (d *DownloadClient) find(ctx context.Context, lookup helpers.Lookup, downloadClients []lidarr.DownloadClientResource, diags *diag.Diagnostics) {
 	for _, client := range downloadClients {
-		if client.GetName() == name {
+		if lookup.Matches(client.GetId(), client.GetName()) {
 			d.write(ctx, &client, diags)
 
 			return
 		}
 	}
 
-	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(downloadClientDataSourceName, "name", name))
+	diags.AddError(helpers.DataSourceError, lookup.NotFound(downloadClientDataSourceName, helpers.Values(downloadClients, (*lidarr.DownloadClientResource).GetName)))
 }
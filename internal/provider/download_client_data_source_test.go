@@ -32,6 +32,17 @@ func TestAccDownloadClientDataSource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.lidarr_download_client.test", "id"),
 					resource.TestCheckResourceAttr("data.lidarr_download_client.test", "protocol", "torrent")),
 			},
+			// Conflicting lookup testing
+			{
+				Config:      testAccDownloadClientDataSourceConflictConfig,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			// Read by ID testing
+			{
+				Config: testAccDownloadClientResourceConfig("dataTest", "true") + testAccDownloadClientDataSourceIDConfig("lidarr_download_client.test.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lidarr_download_client.test", "name", "dataTest")),
+			},
 		},
 	})
 }
@@ -43,3 +54,18 @@ func testAccDownloadClientDataSourceConfig(name string) string {
 	}
 	`, name)
 }
+
+const testAccDownloadClientDataSourceConflictConfig = `
+data "lidarr_download_client" "test" {
+	id = 1
+	name = "Error"
+}
+`
+
+func testAccDownloadClientDataSourceIDConfig(id string) string {
+	return fmt.Sprintf(`
+	data "lidarr_download_client" "test" {
+		id = %s
+	}
+	`, id)
+}
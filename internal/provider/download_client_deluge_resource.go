@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -43,6 +44,8 @@ type DownloadClientDelugeResource struct {
 
 // DownloadClientDeluge describes the download client data model.
 type DownloadClientDeluge struct {
+	TagLabels
+	ExtraFields              types.Map    `tfsdk:"extra_fields"`
 	Tags                     types.Set    `tfsdk:"tags"`
 	Name                     types.String `tfsdk:"name"`
 	Host                     types.String `tfsdk:"host"`
@@ -60,10 +63,14 @@ type DownloadClientDeluge struct {
 	Enable                   types.Bool   `tfsdk:"enable"`
 	RemoveFailedDownloads    types.Bool   `tfsdk:"remove_failed_downloads"`
 	RemoveCompletedDownloads types.Bool   `tfsdk:"remove_completed_downloads"`
+	TestOnCreate             types.Bool   `tfsdk:"test_on_create"`
+	TestOnUpdate             types.Bool   `tfsdk:"test_on_update"`
+	SkipDefaultTags          types.Bool   `tfsdk:"skip_default_tags"`
 }
 
 func (d DownloadClientDeluge) toDownloadClient() *DownloadClient {
 	return &DownloadClient{
+		Fields:                   d.ExtraFields,
 		Tags:                     d.Tags,
 		Name:                     d.Name,
 		Host:                     d.Host,
@@ -105,6 +112,10 @@ func (d *DownloadClientDeluge) fromDownloadClient(client *DownloadClient) {
 	d.Enable = client.Enable
 	d.RemoveFailedDownloads = client.RemoveFailedDownloads
 	d.RemoveCompletedDownloads = client.RemoveCompletedDownloads
+
+	if !d.ExtraFields.IsNull() {
+		d.ExtraFields = client.Fields
+	}
 }
 
 func (r *DownloadClientDelugeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -115,6 +126,7 @@ func (r *DownloadClientDelugeResource) Schema(_ context.Context, _ resource.Sche
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->\nDownload Client Deluge resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/lidarr/settings#download-clients) and [Deluge](https://wiki.servarr.com/lidarr/supported#deluge).",
 		Attributes: map[string]schema.Attribute{
+			"extra_fields": helpers.ExtraFieldsAttribute(),
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
@@ -139,12 +151,27 @@ func (r *DownloadClientDelugeResource) Schema(_ context.Context, _ resource.Sche
 				MarkdownDescription: "Download Client name.",
 				Required:            true,
 			},
+			"test_on_create": schema.BoolAttribute{
+				MarkdownDescription: "Run the Lidarr download client test before creating it. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"test_on_update": schema.BoolAttribute{
+				MarkdownDescription: "Run the Lidarr download client test before updating it. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"tags": schema.SetAttribute{
 				MarkdownDescription: "List of associated tags.",
 				Optional:            true,
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels":          tagLabelsAttribute(),
+			"create_missing_tags": createMissingTagsAttribute(),
+			"skip_default_tags":   skipDefaultTagsAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -167,6 +194,9 @@ func (r *DownloadClientDelugeResource) Schema(_ context.Context, _ resource.Sche
 				MarkdownDescription: "Port.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Int64{
+					helpers.Port(),
+				},
 			},
 			"recent_music_priority": schema.Int64Attribute{
 				MarkdownDescription: "Recent Music priority. `0` Last, `1` First.",
@@ -231,9 +261,30 @@ func (r *DownloadClientDelugeResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, client.TagLabels, &client.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new DownloadClientDeluge
 	request := client.read(ctx, &resp.Diagnostics)
 
+	if !typeDownloadClientFields(r.auth, r.client, request, downloadClientDelugeResourceName, &resp.Diagnostics, client.ExtraFields) {
+		return
+	}
+
+	applyDefaultTags(r.auth, request, client.SkipDefaultTags)
+
+	if !resolver.validate(downloadClientDelugeResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	if client.TestOnCreate.ValueBool() && !testDownloadClient(r.auth, r.client, request, downloadClientDelugeResourceName, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientDelugeResourceName, err))
@@ -241,9 +292,13 @@ func (r *DownloadClientDelugeResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	response = helpers.ReadAfterCreate(ctx, response, downloadClientDelugeResourceName, r.client.DownloadClientAPI.GetDownloadClientById(r.auth, response.GetId()).Execute, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+downloadClientDelugeResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, client.Tags, client.SkipDefaultTags)
 	client.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &client.TagLabels, client.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -258,8 +313,15 @@ func (r *DownloadClientDelugeResource) Read(ctx context.Context, req resource.Re
 	}
 
 	// Get DownloadClientDeluge current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			resp.Diagnostics.AddWarning(helpers.ResourceNotFound, helpers.ParseResourceNotFound(downloadClientDelugeResourceName, client.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientDelugeResourceName, err))
 
 		return
@@ -267,7 +329,12 @@ func (r *DownloadClientDelugeResource) Read(ctx context.Context, req resource.Re
 
 	tflog.Trace(ctx, "read "+downloadClientDelugeResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	removeDefaultTags(ctx, r.auth, response, client.Tags, client.SkipDefaultTags)
 	client.write(ctx, response, &resp.Diagnostics)
+	newTagResolver(r.auth, r.client).write(ctx, &client.TagLabels, client.Tags, &resp.Diagnostics)
+	// Test flags are not stored by Lidarr, keep the state value or use the default on import
+	client.TestOnCreate = types.BoolValue(client.TestOnCreate.ValueBool())
+	client.TestOnUpdate = types.BoolValue(client.TestOnUpdate.ValueBool())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -281,9 +348,31 @@ func (r *DownloadClientDelugeResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, client.TagLabels, &client.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update DownloadClientDeluge
 	request := client.read(ctx, &resp.Diagnostics)
 
+	if !typeDownloadClientFields(r.auth, r.client, request, downloadClientDelugeResourceName, &resp.Diagnostics, client.ExtraFields) {
+		return
+	}
+
+	preserveDownloadClientFields(r.auth, r.client, request)
+	applyDefaultTags(r.auth, request, client.SkipDefaultTags)
+
+	if !resolver.validate(downloadClientDelugeResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	if client.TestOnUpdate.ValueBool() && !testDownloadClient(r.auth, r.client, request, downloadClientDelugeResourceName, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientDelugeResourceName, err))
@@ -293,7 +382,9 @@ func (r *DownloadClientDelugeResource) Update(ctx context.Context, req resource.
 
 	tflog.Trace(ctx, "updated "+downloadClientDelugeResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, client.Tags, client.SkipDefaultTags)
 	client.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &client.TagLabels, client.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
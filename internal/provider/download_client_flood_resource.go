@@ -10,8 +10,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -41,6 +43,8 @@ type DownloadClientFloodResource struct {
 
 // DownloadClientFlood describes the download client data model.
 type DownloadClientFlood struct {
+	TagLabels
+	ExtraFields              types.Map    `tfsdk:"extra_fields"`
 	Tags                     types.Set    `tfsdk:"tags"`
 	FieldTags                types.Set    `tfsdk:"field_tags"`
 	AdditionalTags           types.Set    `tfsdk:"additional_tags"`
@@ -59,10 +63,14 @@ type DownloadClientFlood struct {
 	Enable                   types.Bool   `tfsdk:"enable"`
 	RemoveFailedDownloads    types.Bool   `tfsdk:"remove_failed_downloads"`
 	RemoveCompletedDownloads types.Bool   `tfsdk:"remove_completed_downloads"`
+	TestOnCreate             types.Bool   `tfsdk:"test_on_create"`
+	TestOnUpdate             types.Bool   `tfsdk:"test_on_update"`
+	SkipDefaultTags          types.Bool   `tfsdk:"skip_default_tags"`
 }
 
 func (d DownloadClientFlood) toDownloadClient() *DownloadClient {
 	return &DownloadClient{
+		Fields:                   d.ExtraFields,
 		Tags:                     d.Tags,
 		FieldTags:                d.FieldTags,
 		PostImportTags:           d.PostImportTags,
@@ -106,6 +114,10 @@ func (d *DownloadClientFlood) fromDownloadClient(client *DownloadClient) {
 	d.Enable = client.Enable
 	d.RemoveFailedDownloads = client.RemoveFailedDownloads
 	d.RemoveCompletedDownloads = client.RemoveCompletedDownloads
+
+	if !d.ExtraFields.IsNull() {
+		d.ExtraFields = client.Fields
+	}
 }
 
 func (r *DownloadClientFloodResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -116,6 +128,7 @@ func (r *DownloadClientFloodResource) Schema(_ context.Context, _ resource.Schem
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->\nDownload Client Flood resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/lidarr/settings#download-clients) and [Flood](https://wiki.servarr.com/lidarr/supported#flood).",
 		Attributes: map[string]schema.Attribute{
+			"extra_fields": helpers.ExtraFieldsAttribute(),
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
@@ -140,12 +153,27 @@ func (r *DownloadClientFloodResource) Schema(_ context.Context, _ resource.Schem
 				MarkdownDescription: "Download Client name.",
 				Required:            true,
 			},
+			"test_on_create": schema.BoolAttribute{
+				MarkdownDescription: "Run the Lidarr download client test before creating it. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"test_on_update": schema.BoolAttribute{
+				MarkdownDescription: "Run the Lidarr download client test before updating it. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"tags": schema.SetAttribute{
 				MarkdownDescription: "List of associated tags.",
 				Optional:            true,
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels":          tagLabelsAttribute(),
+			"create_missing_tags": createMissingTagsAttribute(),
+			"skip_default_tags":   skipDefaultTagsAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -168,6 +196,9 @@ func (r *DownloadClientFloodResource) Schema(_ context.Context, _ resource.Schem
 				MarkdownDescription: "Port.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Int64{
+					helpers.Port(),
+				},
 			},
 			"host": schema.StringAttribute{
 				MarkdownDescription: "host.",
@@ -234,9 +265,30 @@ func (r *DownloadClientFloodResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, client.TagLabels, &client.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new DownloadClientFlood
 	request := client.read(ctx, &resp.Diagnostics)
 
+	if !typeDownloadClientFields(r.auth, r.client, request, downloadClientFloodResourceName, &resp.Diagnostics, client.ExtraFields) {
+		return
+	}
+
+	applyDefaultTags(r.auth, request, client.SkipDefaultTags)
+
+	if !resolver.validate(downloadClientFloodResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	if client.TestOnCreate.ValueBool() && !testDownloadClient(r.auth, r.client, request, downloadClientFloodResourceName, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientFloodResourceName, err))
@@ -244,9 +296,13 @@ func (r *DownloadClientFloodResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	response = helpers.ReadAfterCreate(ctx, response, downloadClientFloodResourceName, r.client.DownloadClientAPI.GetDownloadClientById(r.auth, response.GetId()).Execute, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+downloadClientFloodResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, client.Tags, client.SkipDefaultTags)
 	client.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &client.TagLabels, client.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -261,8 +317,15 @@ func (r *DownloadClientFloodResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get DownloadClientFlood current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			resp.Diagnostics.AddWarning(helpers.ResourceNotFound, helpers.ParseResourceNotFound(downloadClientFloodResourceName, client.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientFloodResourceName, err))
 
 		return
@@ -270,7 +333,12 @@ func (r *DownloadClientFloodResource) Read(ctx context.Context, req resource.Rea
 
 	tflog.Trace(ctx, "read "+downloadClientFloodResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	removeDefaultTags(ctx, r.auth, response, client.Tags, client.SkipDefaultTags)
 	client.write(ctx, response, &resp.Diagnostics)
+	newTagResolver(r.auth, r.client).write(ctx, &client.TagLabels, client.Tags, &resp.Diagnostics)
+	// Test flags are not stored by Lidarr, keep the state value or use the default on import
+	client.TestOnCreate = types.BoolValue(client.TestOnCreate.ValueBool())
+	client.TestOnUpdate = types.BoolValue(client.TestOnUpdate.ValueBool())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -284,9 +352,31 @@ func (r *DownloadClientFloodResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, client.TagLabels, &client.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update DownloadClientFlood
 	request := client.read(ctx, &resp.Diagnostics)
 
+	if !typeDownloadClientFields(r.auth, r.client, request, downloadClientFloodResourceName, &resp.Diagnostics, client.ExtraFields) {
+		return
+	}
+
+	preserveDownloadClientFields(r.auth, r.client, request)
+	applyDefaultTags(r.auth, request, client.SkipDefaultTags)
+
+	if !resolver.validate(downloadClientFloodResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	if client.TestOnUpdate.ValueBool() && !testDownloadClient(r.auth, r.client, request, downloadClientFloodResourceName, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientFloodResourceName, err))
@@ -296,7 +386,9 @@ func (r *DownloadClientFloodResource) Update(ctx context.Context, req resource.U
 
 	tflog.Trace(ctx, "updated "+downloadClientFloodResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, client.Tags, client.SkipDefaultTags)
 	client.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &client.TagLabels, client.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -10,8 +10,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -41,6 +43,8 @@ type DownloadClientHadoukenResource struct {
 
 // DownloadClientHadouken describes the download client data model.
 type DownloadClientHadouken struct {
+	TagLabels
+	ExtraFields              types.Map    `tfsdk:"extra_fields"`
 	Tags                     types.Set    `tfsdk:"tags"`
 	Name                     types.String `tfsdk:"name"`
 	Host                     types.String `tfsdk:"host"`
@@ -55,10 +59,14 @@ type DownloadClientHadouken struct {
 	Enable                   types.Bool   `tfsdk:"enable"`
 	RemoveFailedDownloads    types.Bool   `tfsdk:"remove_failed_downloads"`
 	RemoveCompletedDownloads types.Bool   `tfsdk:"remove_completed_downloads"`
+	TestOnCreate             types.Bool   `tfsdk:"test_on_create"`
+	TestOnUpdate             types.Bool   `tfsdk:"test_on_update"`
+	SkipDefaultTags          types.Bool   `tfsdk:"skip_default_tags"`
 }
 
 func (d DownloadClientHadouken) toDownloadClient() *DownloadClient {
 	return &DownloadClient{
+		Fields:                   d.ExtraFields,
 		Tags:                     d.Tags,
 		Name:                     d.Name,
 		Host:                     d.Host,
@@ -94,6 +102,10 @@ func (d *DownloadClientHadouken) fromDownloadClient(client *DownloadClient) {
 	d.Enable = client.Enable
 	d.RemoveFailedDownloads = client.RemoveFailedDownloads
 	d.RemoveCompletedDownloads = client.RemoveCompletedDownloads
+
+	if !d.ExtraFields.IsNull() {
+		d.ExtraFields = client.Fields
+	}
 }
 
 func (r *DownloadClientHadoukenResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -104,6 +116,7 @@ func (r *DownloadClientHadoukenResource) Schema(_ context.Context, _ resource.Sc
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->\nDownload Client Hadouken resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/lidarr/settings#download-clients) and [Hadouken](https://wiki.servarr.com/lidarr/supported#hadouken).",
 		Attributes: map[string]schema.Attribute{
+			"extra_fields": helpers.ExtraFieldsAttribute(),
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
@@ -128,12 +141,27 @@ func (r *DownloadClientHadoukenResource) Schema(_ context.Context, _ resource.Sc
 				MarkdownDescription: "Download Client name.",
 				Required:            true,
 			},
+			"test_on_create": schema.BoolAttribute{
+				MarkdownDescription: "Run the Lidarr download client test before creating it. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"test_on_update": schema.BoolAttribute{
+				MarkdownDescription: "Run the Lidarr download client test before updating it. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"tags": schema.SetAttribute{
 				MarkdownDescription: "List of associated tags.",
 				Optional:            true,
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels":          tagLabelsAttribute(),
+			"create_missing_tags": createMissingTagsAttribute(),
+			"skip_default_tags":   skipDefaultTagsAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -151,6 +179,9 @@ func (r *DownloadClientHadoukenResource) Schema(_ context.Context, _ resource.Sc
 				MarkdownDescription: "Port.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Int64{
+					helpers.Port(),
+				},
 			},
 			"host": schema.StringAttribute{
 				MarkdownDescription: "host.",
@@ -197,9 +228,30 @@ func (r *DownloadClientHadoukenResource) Create(ctx context.Context, req resourc
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, client.TagLabels, &client.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new DownloadClientHadouken
 	request := client.read(ctx, &resp.Diagnostics)
 
+	if !typeDownloadClientFields(r.auth, r.client, request, downloadClientHadoukenResourceName, &resp.Diagnostics, client.ExtraFields) {
+		return
+	}
+
+	applyDefaultTags(r.auth, request, client.SkipDefaultTags)
+
+	if !resolver.validate(downloadClientHadoukenResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	if client.TestOnCreate.ValueBool() && !testDownloadClient(r.auth, r.client, request, downloadClientHadoukenResourceName, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientHadoukenResourceName, err))
@@ -207,9 +259,13 @@ func (r *DownloadClientHadoukenResource) Create(ctx context.Context, req resourc
 		return
 	}
 
+	response = helpers.ReadAfterCreate(ctx, response, downloadClientHadoukenResourceName, r.client.DownloadClientAPI.GetDownloadClientById(r.auth, response.GetId()).Execute, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+downloadClientHadoukenResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, client.Tags, client.SkipDefaultTags)
 	client.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &client.TagLabels, client.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -224,8 +280,15 @@ func (r *DownloadClientHadoukenResource) Read(ctx context.Context, req resource.
 	}
 
 	// Get DownloadClientHadouken current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			resp.Diagnostics.AddWarning(helpers.ResourceNotFound, helpers.ParseResourceNotFound(downloadClientHadoukenResourceName, client.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientHadoukenResourceName, err))
 
 		return
@@ -233,7 +296,12 @@ func (r *DownloadClientHadoukenResource) Read(ctx context.Context, req resource.
 
 	tflog.Trace(ctx, "read "+downloadClientHadoukenResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	removeDefaultTags(ctx, r.auth, response, client.Tags, client.SkipDefaultTags)
 	client.write(ctx, response, &resp.Diagnostics)
+	newTagResolver(r.auth, r.client).write(ctx, &client.TagLabels, client.Tags, &resp.Diagnostics)
+	// Test flags are not stored by Lidarr, keep the state value or use the default on import
+	client.TestOnCreate = types.BoolValue(client.TestOnCreate.ValueBool())
+	client.TestOnUpdate = types.BoolValue(client.TestOnUpdate.ValueBool())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -247,9 +315,31 @@ func (r *DownloadClientHadoukenResource) Update(ctx context.Context, req resourc
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, client.TagLabels, &client.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update DownloadClientHadouken
 	request := client.read(ctx, &resp.Diagnostics)
 
+	if !typeDownloadClientFields(r.auth, r.client, request, downloadClientHadoukenResourceName, &resp.Diagnostics, client.ExtraFields) {
+		return
+	}
+
+	preserveDownloadClientFields(r.auth, r.client, request)
+	applyDefaultTags(r.auth, request, client.SkipDefaultTags)
+
+	if !resolver.validate(downloadClientHadoukenResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	if client.TestOnUpdate.ValueBool() && !testDownloadClient(r.auth, r.client, request, downloadClientHadoukenResourceName, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientHadoukenResourceName, err))
@@ -259,7 +349,9 @@ func (r *DownloadClientHadoukenResource) Update(ctx context.Context, req resourc
 
 	tflog.Trace(ctx, "updated "+downloadClientHadoukenResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, client.Tags, client.SkipDefaultTags)
 	client.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &client.TagLabels, client.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
 }
 
@@ -13,8 +13,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -47,12 +49,23 @@ type DownloadClientResource struct {
 	auth   context.Context
 }
 
+// DownloadClientResourceData describes the download client resource data model.
+// It extends the download client data model with attributes not stored by Lidarr.
+type DownloadClientResourceData struct {
+	DownloadClient
+	TagLabels
+	TestOnCreate    types.Bool `tfsdk:"test_on_create"`
+	TestOnUpdate    types.Bool `tfsdk:"test_on_update"`
+	SkipDefaultTags types.Bool `tfsdk:"skip_default_tags"`
+}
+
 // DownloadClient describes the download client data model.
 type DownloadClient struct {
 	Tags                     types.Set    `tfsdk:"tags"`
 	PostImportTags           types.Set    `tfsdk:"post_import_tags"`
 	FieldTags                types.Set    `tfsdk:"field_tags"`
 	AdditionalTags           types.Set    `tfsdk:"additional_tags"`
+	Fields                   types.Map    `tfsdk:"fields"`
 	NzbFolder                types.String `tfsdk:"nzb_folder"`
 	Category                 types.String `tfsdk:"category"`
 	Implementation           types.String `tfsdk:"implementation"`
@@ -101,6 +114,7 @@ func (d DownloadClient) getType() attr.Type {
 			"additional_tags":            types.SetType{}.WithElementType(types.Int64Type),
 			"post_import_tags":           types.SetType{}.WithElementType(types.StringType),
 			"field_tags":                 types.SetType{}.WithElementType(types.StringType),
+			"fields":                     types.MapType{}.WithElementType(types.StringType),
 			"nzb_folder":                 types.StringType,
 			"category":                   types.StringType,
 			"implementation":             types.StringType,
@@ -174,10 +188,16 @@ func (r *DownloadClientResource) Schema(_ context.Context, _ resource.SchemaRequ
 			"config_contract": schema.StringAttribute{
 				MarkdownDescription: "DownloadClient configuration template.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"implementation": schema.StringAttribute{
 				MarkdownDescription: "DownloadClient implementation name.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Download Client name.",
@@ -189,6 +209,21 @@ func (r *DownloadClientResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Validators: []validator.String{
 					stringvalidator.OneOf("usenet", "torrent"),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"test_on_create": schema.BoolAttribute{
+				MarkdownDescription: "Run the Lidarr download client test before creating it. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"test_on_update": schema.BoolAttribute{
+				MarkdownDescription: "Run the Lidarr download client test before updating it. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
 			},
 			"tags": schema.SetAttribute{
 				MarkdownDescription: "List of associated tags.",
@@ -196,6 +231,9 @@ func (r *DownloadClientResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels":          tagLabelsAttribute(),
+			"create_missing_tags": createMissingTagsAttribute(),
+			"skip_default_tags":   skipDefaultTagsAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -248,6 +286,9 @@ func (r *DownloadClientResource) Schema(_ context.Context, _ resource.SchemaRequ
 				MarkdownDescription: "Port.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Int64{
+					helpers.PortOrDefault(),
+				},
 			},
 			"recent_music_priority": schema.Int64Attribute{
 				MarkdownDescription: "Recent Music priority. `0` Last, `1` First.",
@@ -384,6 +425,15 @@ func (r *DownloadClientResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Computed:            true,
 				ElementType:         types.StringType,
 			},
+			"fields": schema.MapAttribute{
+				MarkdownDescription: "Raw field values for settings not covered by other attributes, keyed by API field name. Values are converted to the type of the Lidarr field, lists are written as JSON.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Map{
+					helpers.UnmanagedFields(),
+				},
+			},
 		},
 	}
 }
@@ -397,7 +447,7 @@ func (r *DownloadClientResource) Configure(ctx context.Context, req resource.Con
 
 func (r *DownloadClientResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var client *DownloadClient
+	var client *DownloadClientResourceData
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &client)...)
 
@@ -405,9 +455,30 @@ func (r *DownloadClientResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, client.TagLabels, &client.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new DownloadClient
 	request := client.read(ctx, &resp.Diagnostics)
 
+	if !typeDownloadClientFields(r.auth, r.client, request, downloadClientResourceName, &resp.Diagnostics, client.Fields) {
+		return
+	}
+
+	applyDefaultTags(r.auth, request, client.SkipDefaultTags)
+
+	if !resolver.validate(downloadClientResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	if client.TestOnCreate.ValueBool() && !testDownloadClient(r.auth, r.client, request, downloadClientResourceName, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientResourceName, err))
@@ -415,19 +486,25 @@ func (r *DownloadClientResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	response = helpers.ReadAfterCreate(ctx, response, downloadClientResourceName, r.client.DownloadClientAPI.GetDownloadClientById(r.auth, response.GetId()).Execute, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+downloadClientResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state DownloadClient
+	var state DownloadClientResourceData
 
 	state.writeSensitive(client)
+	removeDefaultTags(ctx, r.auth, response, client.Tags, client.SkipDefaultTags)
 	state.write(ctx, response, &resp.Diagnostics)
+	state.TagLabels = client.TagLabels
+	state.SkipDefaultTags = client.SkipDefaultTags
+	resolver.write(ctx, &state.TagLabels, state.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *DownloadClientResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var client *DownloadClient
+	var client *DownloadClientResourceData
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &client)...)
 
@@ -436,8 +513,15 @@ func (r *DownloadClientResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	// Get DownloadClient current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			resp.Diagnostics.AddWarning(helpers.ResourceNotFound, helpers.ParseResourceNotFound(downloadClientResourceName, client.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientResourceName, err))
 
 		return
@@ -446,16 +530,20 @@ func (r *DownloadClientResource) Read(ctx context.Context, req resource.ReadRequ
 	tflog.Trace(ctx, "read "+downloadClientResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state DownloadClient
+	var state DownloadClientResourceData
 
 	state.writeSensitive(client)
+	removeDefaultTags(ctx, r.auth, response, client.Tags, client.SkipDefaultTags)
 	state.write(ctx, response, &resp.Diagnostics)
+	state.TagLabels = client.TagLabels
+	state.SkipDefaultTags = client.SkipDefaultTags
+	newTagResolver(r.auth, r.client).write(ctx, &state.TagLabels, state.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *DownloadClientResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var client *DownloadClient
+	var client *DownloadClientResourceData
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &client)...)
 
@@ -463,9 +551,30 @@ func (r *DownloadClientResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, client.TagLabels, &client.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update DownloadClient
 	request := client.read(ctx, &resp.Diagnostics)
 
+	if !typeDownloadClientFields(r.auth, r.client, request, downloadClientResourceName, &resp.Diagnostics, client.Fields) {
+		return
+	}
+
+	applyDefaultTags(r.auth, request, client.SkipDefaultTags)
+
+	if !resolver.validate(downloadClientResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	if client.TestOnUpdate.ValueBool() && !testDownloadClient(r.auth, r.client, request, downloadClientResourceName, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientResourceName, err))
@@ -476,10 +585,14 @@ func (r *DownloadClientResource) Update(ctx context.Context, req resource.Update
 	tflog.Trace(ctx, "updated "+downloadClientResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state DownloadClient
+	var state DownloadClientResourceData
 
 	state.writeSensitive(client)
+	removeDefaultTags(ctx, r.auth, response, client.Tags, client.SkipDefaultTags)
 	state.write(ctx, response, &resp.Diagnostics)
+	state.TagLabels = client.TagLabels
+	state.SkipDefaultTags = client.SkipDefaultTags
+	resolver.write(ctx, &state.TagLabels, state.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -528,6 +641,7 @@ func (d *DownloadClient) write(ctx context.Context, downloadClient *lidarr.Downl
 	d.FieldTags = types.SetValueMust(types.StringType, nil)
 	d.PostImportTags = types.SetValueMust(types.StringType, nil)
 	helpers.WriteFields(ctx, d, downloadClient.GetFields(), downloadClientFields)
+	d.Fields = helpers.WriteMapFields(ctx, downloadClient.GetFields(), downloadClientFields, d.Fields)
 }
 
 func (d *DownloadClient) read(ctx context.Context, diags *diag.Diagnostics) *lidarr.DownloadClientResource {
@@ -542,13 +656,17 @@ func (d *DownloadClient) read(ctx context.Context, diags *diag.Diagnostics) *lid
 	client.SetName(d.Name.ValueString())
 	client.SetProtocol(lidarr.DownloadProtocol(d.Protocol.ValueString()))
 	diags.Append(d.Tags.ElementsAs(ctx, &client.Tags, true)...)
-	client.SetFields(helpers.ReadFields(ctx, d, downloadClientFields))
+	client.SetFields(helpers.MergeFields(helpers.ReadFields(ctx, d, downloadClientFields), helpers.ReadMapFields(ctx, d.Fields)))
 
 	return client
 }
 
-// writeSensitive copy sensitive data from another resource.
-func (d *DownloadClient) writeSensitive(client *DownloadClient) {
+// writeSensitive copy sensitive and test data from another resource.
+func (d *DownloadClientResourceData) writeSensitive(client *DownloadClientResourceData) {
+	// Test flags are not stored by Lidarr, keep the state value or use the default on import
+	d.TestOnCreate = types.BoolValue(client.TestOnCreate.ValueBool())
+	d.TestOnUpdate = types.BoolValue(client.TestOnUpdate.ValueBool())
+
 	if !client.Password.IsUnknown() {
 		d.Password = client.Password
 	}
@@ -560,4 +678,31 @@ func (d *DownloadClient) writeSensitive(client *DownloadClient) {
 	if !client.SecretToken.IsUnknown() {
 		d.SecretToken = client.SecretToken
 	}
+
+	if !client.Fields.IsUnknown() {
+		d.Fields = client.Fields
+	}
+}
+
+// preserveDownloadClientFields keeps the current download client settings not managed by the provider, so that typed resources do not reset them on update.
+func preserveDownloadClientFields(auth context.Context, client *lidarr.APIClient, request *lidarr.DownloadClientResource) {
+	current, _, err := client.DownloadClientAPI.GetDownloadClientById(auth, request.GetId()).Execute()
+	if err != nil {
+		// Errors are reported by the update itself
+		return
+	}
+
+	request.SetFields(helpers.PreserveFields(request.GetFields(), current.GetFields(), downloadClientFields))
+}
+
+// testDownloadClient runs the Lidarr download client test and reports any validation failure.
+func testDownloadClient(auth context.Context, client *lidarr.APIClient, request *lidarr.DownloadClientResource, name string, diags *diag.Diagnostics) bool {
+	_, err := client.DownloadClientAPI.TestDownloadClient(auth).DownloadClientResource(*request).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseValidationError(helpers.Validate, name, err))
+
+		return false
+	}
+
+	return true
 }
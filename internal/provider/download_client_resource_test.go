@@ -1,11 +1,17 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"regexp"
 	"testing"
 
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccDownloadClientResource(t *testing.T) {
@@ -25,6 +31,8 @@ func TestAccDownloadClientResource(t *testing.T) {
 				Config: testAccDownloadClientResourceConfig("resourceTest", "false"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("lidarr_download_client.test", "enable", "false"),
+					resource.TestCheckResourceAttr("lidarr_download_client.test", "remove_completed_downloads", "false"),
+					resource.TestCheckResourceAttr("lidarr_download_client.test", "remove_failed_downloads", "false"),
 					resource.TestCheckResourceAttr("lidarr_download_client.test", "url_base", "/transmission/"),
 					resource.TestCheckResourceAttrSet("lidarr_download_client.test", "id"),
 				),
@@ -39,6 +47,8 @@ func TestAccDownloadClientResource(t *testing.T) {
 				Config: testAccDownloadClientResourceConfig("resourceTest", "true"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("lidarr_download_client.test", "enable", "true"),
+					resource.TestCheckResourceAttr("lidarr_download_client.test", "remove_completed_downloads", "true"),
+					resource.TestCheckResourceAttr("lidarr_download_client.test", "remove_failed_downloads", "true"),
 				),
 			},
 			// ImportState testing
@@ -47,6 +57,15 @@ func TestAccDownloadClientResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Replace testing
+			{
+				Config: testAccDownloadClientResourceReplaceConfig("resourceTest"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("lidarr_download_client.test", plancheck.ResourceActionReplace),
+					},
+				},
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -55,9 +74,11 @@ func TestAccDownloadClientResource(t *testing.T) {
 func testAccDownloadClientResourceConfig(name, enable string) string {
 	return fmt.Sprintf(`
 	resource "lidarr_download_client" "test" {
-		enable = %s
+		enable = %[1]s
+		remove_completed_downloads = %[1]s
+		remove_failed_downloads = %[1]s
 		priority = 1
-		name = "%s"
+		name = "%[2]s"
 		implementation = "Transmission"
 		protocol = "torrent"
     	config_contract = "TransmissionSettings"
@@ -66,3 +87,107 @@ func testAccDownloadClientResourceConfig(name, enable string) string {
 		port = 9091
 	}`, enable, name)
 }
+
+func TestAccDownloadClientResourceFields(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Managed field testing
+			{
+				Config:      testAccDownloadClientResourceFieldsConfig("resourceFieldsTest", `"urlBase" = "/qbittorrent/"`),
+				ExpectError: regexp.MustCompile("Conflicting Extra Field"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccDownloadClientResourceFieldsConfig("resourceFieldsTest", `"contentLayout" = "1"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lidarr_download_client.test", "fields.%", "1"),
+					resource.TestCheckResourceAttr("lidarr_download_client.test", "fields.contentLayout", "1"),
+					resource.TestCheckResourceAttrSet("lidarr_download_client.test", "id"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccDownloadClientResourceFieldsConfig("resourceFieldsTest", `"contentLayout" = "2"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lidarr_download_client.test", "fields.contentLayout", "2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "lidarr_download_client.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccDownloadClientResourceFieldsConfig(name, fields string) string {
+	return fmt.Sprintf(`
+	resource "lidarr_download_client" "test" {
+		enable = false
+		priority = 1
+		name = "%s"
+		implementation = "QBittorrent"
+		protocol = "torrent"
+		config_contract = "QBittorrentSettings"
+		host = "qbittorrent"
+		url_base = "/qbittorrent/"
+		port = 9091
+		fields = {
+			%s
+		}
+	}`, name, fields)
+}
+
+func TestDownloadClientResourceFieldsMapping(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	data, transport := testFixtureProvider(t, map[string]string{
+		"GET /api/v1/downloadclient/1":      "download_client_qbittorrent.json",
+		"GET /api/v1/downloadclient/schema": "download_client_schema.json",
+	})
+	r := NewDownloadClientResource()
+
+	// Import only maps the fields not covered by other attributes
+	state := testResourceRead(t, r, data, 1)
+
+	var fields map[string]string
+
+	assert.False(t, state.GetAttribute(ctx, path.Root("fields"), &fields).HasError())
+	assert.Equal(t, map[string]string{"contentLayout": "1"}, fields)
+
+	// Raw values are sent with the type of the Lidarr field and read back as configured
+	assert.False(t, state.SetAttribute(ctx, path.Root("fields"), map[string]string{"contentLayout": "2"}).HasError())
+
+	state = testResourceUpdate(t, r, state)
+
+	var actual lidarr.DownloadClientResource
+
+	assert.NoError(t, json.Unmarshal(transport.request("PUT /api/v1/downloadclient/1"), &actual))
+	assert.Equal(t, float64(2), testFieldValues(actual.GetFields())["contentLayout"])
+	assert.Equal(t, "qbittorrent", testFieldValues(actual.GetFields())["host"])
+	assert.False(t, state.GetAttribute(ctx, path.Root("fields"), &fields).HasError())
+	assert.Equal(t, map[string]string{"contentLayout": "2"}, fields)
+}
+
+func testAccDownloadClientResourceReplaceConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "lidarr_download_client" "test" {
+		enable = true
+		priority = 1
+		name = "%s"
+		implementation = "Vuze"
+		protocol = "torrent"
+		config_contract = "TransmissionSettings"
+		host = "vuze"
+		url_base = "/transmission/"
+		port = 9091
+	}`, name)
+}
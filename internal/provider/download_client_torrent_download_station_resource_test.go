@@ -15,43 +15,51 @@ func TestAccDownloadClientTorrentDownloadStationResource(t *testing.T) {
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
+			// Conflicting folder settings
+			{
+				Config:      testAccDownloadClientTorrentDownloadStationResourceConfig("resourceTorrentDownloadStationTest", "false", "music_directory = \"/downloads/music\"\n\t\tmusic_category = \"lidarr\""),
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
 			// Unauthorized Create
 			{
-				Config:      testAccDownloadClientTorrentDownloadStationResourceConfig("resourceTorrentDownloadStationTest", "false") + testUnauthorizedProvider,
+				Config:      testAccDownloadClientTorrentDownloadStationResourceConfig("resourceTorrentDownloadStationTest", "false", `music_directory = "/downloads/music"`) + testUnauthorizedProvider,
 				ExpectError: regexp.MustCompile("Client Error"),
 			},
 			// Create and Read testing
 			{
-				Config: testAccDownloadClientTorrentDownloadStationResourceConfig("resourceTorrentDownloadStationTest", "false"),
+				Config: testAccDownloadClientTorrentDownloadStationResourceConfig("resourceTorrentDownloadStationTest", "false", `music_directory = "/downloads/music"`),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("lidarr_download_client_torrent_download_station.test", "use_ssl", "false"),
+					resource.TestCheckResourceAttr("lidarr_download_client_torrent_download_station.test", "music_directory", "/downloads/music"),
 					resource.TestCheckResourceAttrSet("lidarr_download_client_torrent_download_station.test", "id"),
 				),
 			},
 			// Unauthorized Read
 			{
-				Config:      testAccDownloadClientTorrentDownloadStationResourceConfig("resourceTorrentDownloadStationTest", "false") + testUnauthorizedProvider,
+				Config:      testAccDownloadClientTorrentDownloadStationResourceConfig("resourceTorrentDownloadStationTest", "false", `music_directory = "/downloads/music"`) + testUnauthorizedProvider,
 				ExpectError: regexp.MustCompile("Client Error"),
 			},
 			// Update and Read testing
 			{
-				Config: testAccDownloadClientTorrentDownloadStationResourceConfig("resourceTorrentDownloadStationTest", "true"),
+				Config: testAccDownloadClientTorrentDownloadStationResourceConfig("resourceTorrentDownloadStationTest", "true", `music_category = "lidarr"`),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("lidarr_download_client_torrent_download_station.test", "use_ssl", "true"),
+					resource.TestCheckResourceAttr("lidarr_download_client_torrent_download_station.test", "music_category", "lidarr"),
 				),
 			},
 			// ImportState testing
 			{
-				ResourceName:      "lidarr_download_client_torrent_download_station.test",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "lidarr_download_client_torrent_download_station.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
 			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
 }
 
-func testAccDownloadClientTorrentDownloadStationResourceConfig(name, ssl string) string {
+func testAccDownloadClientTorrentDownloadStationResourceConfig(name, ssl, folder string) string {
 	return fmt.Sprintf(`
 	resource "lidarr_download_client_torrent_download_station" "test" {
 		enable = false
@@ -60,5 +68,8 @@ func testAccDownloadClientTorrentDownloadStationResourceConfig(name, ssl string)
 		name = "%s"
 		host = "torrent-download-station"
 		port = 9091
-	}`, ssl, name)
+		username = "admin"
+		password = "pass"
+		%s
+	}`, ssl, name, folder)
 }
@@ -15,6 +15,11 @@ func TestAccDownloadClientTransmissionResource(t *testing.T) {
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
+			// Failing test on create
+			{
+				Config:      testAccDownloadClientTransmissionResourceTestConfig("resourceTransmissionTest"),
+				ExpectError: regexp.MustCompile("Unable to validate"),
+			},
 			// Unauthorized Create
 			{
 				Config:      testAccDownloadClientTransmissionResourceConfig("resourceTransmissionTest", "false") + testUnauthorizedProvider,
@@ -63,3 +68,16 @@ func testAccDownloadClientTransmissionResourceConfig(name, enable string) string
 		port = 9091
 	}`, enable, name)
 }
+
+func testAccDownloadClientTransmissionResourceTestConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "lidarr_download_client_transmission" "test" {
+		enable = true
+		priority = 1
+		name = "%s"
+		host = "unreachable-transmission"
+		url_base = "/transmission/"
+		port = 9091
+		test_on_create = true
+	}`, name)
+}
@@ -6,8 +6,10 @@ import (
 
 	"github.com/devopsarr/lidarr-go/lidarr"
 	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -30,7 +32,9 @@ type DownloadClientsDataSource struct {
 // DownloadClients describes the download clients data model.
 type DownloadClients struct {
 	DownloadClients types.Set    `tfsdk:"download_clients"`
+	Protocol        types.String `tfsdk:"protocol"`
 	ID              types.String `tfsdk:"id"`
+	Enable          types.Bool   `tfsdk:"enable"`
 }
 
 func (d *DownloadClientsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -46,6 +50,17 @@ func (d *DownloadClientsDataSource) Schema(_ context.Context, _ datasource.Schem
 			"id": schema.StringAttribute{
 				Computed: true,
 			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "Filter by protocol. Valid values are 'usenet' and 'torrent'.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("usenet", "torrent"),
+				},
+			},
+			"enable": schema.BoolAttribute{
+				MarkdownDescription: "Filter by enable flag.",
+				Optional:            true,
+			},
 			"download_clients": schema.SetNestedAttribute{
 				MarkdownDescription: "Download Client list..",
 				Computed:            true,
@@ -228,6 +243,11 @@ func (d *DownloadClientsDataSource) Schema(_ context.Context, _ datasource.Schem
 							Computed:            true,
 							ElementType:         types.StringType,
 						},
+						"fields": schema.MapAttribute{
+							MarkdownDescription: "Raw field values for settings not covered by other attributes, keyed by API field name.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
 					},
 				},
 			},
@@ -242,7 +262,15 @@ func (d *DownloadClientsDataSource) Configure(ctx context.Context, req datasourc
 	}
 }
 
-func (d *DownloadClientsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+func (d *DownloadClientsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *DownloadClients
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get download clients current value
 	response, _, err := d.client.DownloadClientAPI.ListDownloadClient(d.auth).Execute()
 	if err != nil {
@@ -253,12 +281,36 @@ func (d *DownloadClientsDataSource) Read(ctx context.Context, _ datasource.ReadR
 
 	tflog.Trace(ctx, "read "+downloadClientsDataSourceName)
 	// Map response body to resource schema attribute
-	clients := make([]DownloadClient, len(response))
-	for i, d := range response {
-		clients[i].write(ctx, &d, &resp.Diagnostics)
+	clients := make([]DownloadClient, 0, len(response))
+
+	for _, d := range response {
+		if !data.matches(&d) {
+			continue
+		}
+
+		var client DownloadClient
+
+		client.write(ctx, &d, &resp.Diagnostics)
+		clients = append(clients, client)
 	}
 
 	clientList, diags := types.SetValueFrom(ctx, DownloadClient{}.getType(), clients)
 	resp.Diagnostics.Append(diags...)
-	resp.Diagnostics.Append(resp.State.Set(ctx, DownloadClients{DownloadClients: clientList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
+
+	data.DownloadClients = clientList
+	data.ID = types.StringValue(strconv.Itoa(len(clients)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// matches checks if a download client satisfies the configured filters.
+func (d *DownloadClients) matches(client *lidarr.DownloadClientResource) bool {
+	if !d.Protocol.IsNull() && string(client.GetProtocol()) != d.Protocol.ValueString() {
+		return false
+	}
+
+	if !d.Enable.IsNull() && client.GetEnable() != d.Enable.ValueBool() {
+		return false
+	}
+
+	return true
 }
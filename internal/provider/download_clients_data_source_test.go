@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"fmt"
 	"regexp"
 	"testing"
 
@@ -30,6 +31,18 @@ func TestAccDownloadClientsDataSource(t *testing.T) {
 					resource.TestCheckTypeSetElemNestedAttrs("data.lidarr_download_clients.test", "download_clients.*", map[string]string{"port": "9091"}),
 				),
 			},
+			// Filter testing
+			{
+				Config: testAccDownloadClientsDataSourceFilterConfig("torrent"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.lidarr_download_clients.test", "download_clients.*", map[string]string{"port": "9091"}),
+				),
+			},
+			// Invalid protocol
+			{
+				Config:      testAccDownloadClientsDataSourceFilterConfig("ftp"),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
 		},
 	})
 }
@@ -38,3 +51,11 @@ const testAccDownloadClientsDataSourceConfig = `
 data "lidarr_download_clients" "test" {
 }
 `
+
+func testAccDownloadClientsDataSourceFilterConfig(protocol string) string {
+	return fmt.Sprintf(`
+	data "lidarr_download_clients" "test" {
+		protocol = "%s"
+		enable = false
+	}`, protocol)
+}
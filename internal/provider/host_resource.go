@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -189,6 +190,9 @@ func (r *HostResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			"port": schema.Int64Attribute{
 				MarkdownDescription: "TCP port.",
 				Required:            true,
+				Validators: []validator.Int64{
+					helpers.Port(),
+				},
 			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Host ID.",
@@ -332,6 +336,9 @@ func (r *HostResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 						MarkdownDescription: "SSL port.",
 						Optional:            true,
 						Computed:            true,
+						Validators: []validator.Int64{
+							helpers.PortOrDefault(),
+						},
 					},
 					"enabled": schema.BoolAttribute{
 						MarkdownDescription: "Enabled.",
@@ -373,6 +380,9 @@ func (r *HostResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 						MarkdownDescription: "Proxy port.",
 						Optional:            true,
 						Computed:            true,
+						Validators: []validator.Int64{
+							helpers.PortOrDefault(),
+						},
 					},
 					"bypass_local_addresses": schema.BoolAttribute{
 						MarkdownDescription: "Bypass for local addresses flag.",
@@ -15,7 +15,10 @@ import (
 const importListDataSourceName = "import_list"
 
 // Ensure provider defined types fully satisfy framework interfaces.
-var _ datasource.DataSource = &ImportListDataSource{}
+var (
+	_ datasource.DataSource                     = &ImportListDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &ImportListDataSource{}
+)
 
 func NewImportListDataSource() datasource.DataSource {
 	return &ImportListDataSource{}
@@ -86,7 +89,8 @@ func (d *ImportListDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Import List name.",
-				Required:            true,
+				Optional:            true,
+				Computed:            true,
 			},
 			"tags": schema.SetAttribute{
 				MarkdownDescription: "List of associated tags.",
@@ -95,6 +99,7 @@ func (d *ImportListDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Import List ID.",
+				Optional:            true,
 				Computed:            true,
 			},
 			// Field values
@@ -160,6 +165,12 @@ func (d *ImportListDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 	}
 }
 
+func (d *ImportListDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		helpers.IDOrName("name"),
+	}
+}
+
 func (d *ImportListDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
 		d.client = client
@@ -183,20 +194,20 @@ func (d *ImportListDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	data.find(ctx, data.Name.ValueString(), response, &resp.Diagnostics)
+	data.find(ctx, helpers.NewLookup(data.ID, "name", data.Name), response, &resp.Diagnostics)
 	tflog.Trace(ctx, "read "+importListDataSourceName)
 	// Map response body to resource schema attribute
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (i *ImportList) find(ctx context.Context, name string, importLists []lidarr.ImportListResource, diags *diag.Diagnostics) {
+func (i *ImportList) find(ctx context.Context, lookup helpers.Lookup, importLists []lidarr.ImportListResource, diags *diag.Diagnostics) {
 	for _, list := range importLists {
-		if list.GetName() == name {
+		if lookup.Matches(list.GetId(), list.GetName()) {
 			i.write(ctx, &list, diags)
 
 			return
 		}
 	}
 
-	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(importListDataSourceName, "name", name))
+	diags.AddError(helpers.DataSourceError, lookup.NotFound(importListDataSourceName, helpers.Values(importLists, (*lidarr.ImportListResource).GetName)))
 }
@@ -33,6 +33,18 @@ func TestAccImportListDataSource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.lidarr_import_list.test", "id"),
 					resource.TestCheckResourceAttr("data.lidarr_import_list.test", "should_monitor", "none")),
 			},
+			// Conflicting lookup testing
+			{
+				Config:      testAccImportListDataSourceConflictConfig,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			// Read by ID testing
+			{
+				PreConfig: rootFolderDSInit,
+				Config:    testAccImportListResourceConfig("importListDataTest", "none") + testAccImportListDataSourceIDConfig("lidarr_import_list.test.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lidarr_import_list.test", "name", "importListDataTest")),
+			},
 		},
 	})
 }
@@ -44,3 +56,18 @@ func testAccImportListDataSourceConfig(name string) string {
 	}
 	`, name)
 }
+
+const testAccImportListDataSourceConflictConfig = `
+data "lidarr_import_list" "test" {
+	id = 1
+	name = "Error"
+}
+`
+
+func testAccImportListDataSourceIDConfig(id string) string {
+	return fmt.Sprintf(`
+	data "lidarr_import_list" "test" {
+		id = %s
+	}
+	`, id)
+}
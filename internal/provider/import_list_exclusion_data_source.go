@@ -89,5 +89,5 @@ func (i *ImportListExclusion) find(foreignID string, importListExclusions []lida
 		}
 	}
 
-	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(importListExclusionDataSourceName, "foreign_id", foreignID))
+	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(importListExclusionDataSourceName, "foreign_id", foreignID, helpers.Values(importListExclusions, (*lidarr.ImportListExclusionResource).GetForeignId)))
 }
@@ -104,6 +104,8 @@ func (r *ImportListExclusionResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	response = helpers.ReadAfterCreate(ctx, response, importListExclusionResourceName, r.client.ImportListExclusionAPI.GetImportListExclusionById(r.auth, response.GetId()).Execute, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created importListExclusion: "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	importListExclusion.write(response)
@@ -121,8 +123,15 @@ func (r *ImportListExclusionResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get importListExclusion current value
-	response, _, err := r.client.ImportListExclusionAPI.GetImportListExclusionById(r.auth, int32(importListExclusion.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListExclusionAPI.GetImportListExclusionById(r.auth, int32(importListExclusion.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			resp.Diagnostics.AddWarning(helpers.ResourceNotFound, helpers.ParseResourceNotFound(importListExclusionResourceName, importListExclusion.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListExclusionResourceName, err))
 
 		return
@@ -15,6 +15,11 @@ func TestAccImportListHeadphonesResource(t *testing.T) {
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
+			// Invalid should_monitor
+			{
+				Config:      testAccImportListHeadphonesResourceConfig("resourceHeadphonesTest", "everything"),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
 			// Unauthorized Create
 			{
 				Config:      testAccImportListHeadphonesResourceConfig("resourceHeadphonesTest", "entireArtist") + testUnauthorizedProvider,
@@ -15,6 +15,11 @@ func TestAccImportListLastFMTagResource(t *testing.T) {
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
+			// Invalid should_monitor
+			{
+				Config:      testAccImportListLastFMTagResourceConfig("resourceLastFMTagTest", "everything"),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
 			// Unauthorized Create
 			{
 				Config:      testAccImportListLastFMTagResourceConfig("resourceLastFMTagTest", "entireArtist") + testUnauthorizedProvider,
@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/lidarr-go/lidarr"
 	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -43,20 +44,24 @@ type ImportListLastFMUserResource struct {
 
 // ImportListLastFMUser describes the import list data model.
 type ImportListLastFMUser struct {
-	Tags                  types.Set    `tfsdk:"tags"`
-	Name                  types.String `tfsdk:"name"`
-	MonitorNewItems       types.String `tfsdk:"monitor_new_items"`
-	ShouldMonitor         types.String `tfsdk:"should_monitor"`
-	RootFolderPath        types.String `tfsdk:"root_folder_path"`
-	UserID                types.String `tfsdk:"user_id"`
-	Count                 types.Int64  `tfsdk:"count_list"`
-	QualityProfileID      types.Int64  `tfsdk:"quality_profile_id"`
-	MetadataProfileID     types.Int64  `tfsdk:"metadata_profile_id"`
-	ListOrder             types.Int64  `tfsdk:"list_order"`
-	ID                    types.Int64  `tfsdk:"id"`
-	EnableAutomaticAdd    types.Bool   `tfsdk:"enable_automatic_add"`
-	ShouldMonitorExisting types.Bool   `tfsdk:"should_monitor_existing"`
-	ShouldSearch          types.Bool   `tfsdk:"should_search"`
+	TagLabels
+	Tags                  types.Set      `tfsdk:"tags"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
+	DeletionProtection    types.Bool     `tfsdk:"deletion_protection"`
+	Name                  types.String   `tfsdk:"name"`
+	MonitorNewItems       types.String   `tfsdk:"monitor_new_items"`
+	ShouldMonitor         types.String   `tfsdk:"should_monitor"`
+	RootFolderPath        types.String   `tfsdk:"root_folder_path"`
+	UserID                types.String   `tfsdk:"user_id"`
+	Count                 types.Int64    `tfsdk:"count_list"`
+	QualityProfileID      types.Int64    `tfsdk:"quality_profile_id"`
+	MetadataProfileID     types.Int64    `tfsdk:"metadata_profile_id"`
+	ListOrder             types.Int64    `tfsdk:"list_order"`
+	ID                    types.Int64    `tfsdk:"id"`
+	EnableAutomaticAdd    types.Bool     `tfsdk:"enable_automatic_add"`
+	ShouldMonitorExisting types.Bool     `tfsdk:"should_monitor_existing"`
+	ShouldSearch          types.Bool     `tfsdk:"should_search"`
+	SkipDefaultTags       types.Bool     `tfsdk:"skip_default_tags"`
 }
 
 func (i ImportListLastFMUser) toImportList() *ImportList {
@@ -102,10 +107,11 @@ func (r *ImportListLastFMUserResource) Metadata(_ context.Context, req resource.
 	resp.TypeName = req.ProviderTypeName + "_" + importListLastFMUserResourceName
 }
 
-func (r *ImportListLastFMUserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *ImportListLastFMUserResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->\nImport List Last.fm User resource.\nFor more information refer to [Import List](https://wiki.servarr.com/lidarr/settings#import-lists) and [Last.fm User](https://wiki.servarr.com/lidarr/supported#lastfmuser).",
 		Attributes: map[string]schema.Attribute{
+			"deletion_protection": helpers.DeletionProtectionAttribute(),
 			"enable_automatic_add": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic add flag.",
 				Optional:            true,
@@ -142,7 +148,7 @@ func (r *ImportListLastFMUserResource) Schema(_ context.Context, _ resource.Sche
 				Computed:            true,
 			},
 			"should_monitor": schema.StringAttribute{
-				MarkdownDescription: "Should monitor.",
+				MarkdownDescription: "Should monitor. Valid values are 'none', 'specificAlbum' and 'entireArtist'.",
 				Optional:            true,
 				Computed:            true,
 				Validators: []validator.String{
@@ -150,7 +156,7 @@ func (r *ImportListLastFMUserResource) Schema(_ context.Context, _ resource.Sche
 				},
 			},
 			"monitor_new_items": schema.StringAttribute{
-				MarkdownDescription: "Monitor new items.",
+				MarkdownDescription: "Monitor new items. Valid values are 'none', 'all' and 'new'.",
 				Optional:            true,
 				Computed:            true,
 				Validators: []validator.String{
@@ -167,6 +173,9 @@ func (r *ImportListLastFMUserResource) Schema(_ context.Context, _ resource.Sche
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels":          tagLabelsAttribute(),
+			"create_missing_tags": createMissingTagsAttribute(),
+			"skip_default_tags":   skipDefaultTagsAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Import List ID.",
 				Computed:            true,
@@ -184,6 +193,14 @@ func (r *ImportListLastFMUserResource) Schema(_ context.Context, _ resource.Sche
 				Required:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -204,19 +221,42 @@ func (r *ImportListLastFMUserResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	auth, cancel := helpers.WithTimeout(r.auth, importList.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	resolver := newTagResolver(auth, r.client)
+	resolver.resolve(ctx, importList.TagLabels, &importList.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new ImportListLastFMUser
 	request := importList.read(ctx, &resp.Diagnostics)
+	applyDefaultTags(auth, request, importList.SkipDefaultTags)
 
-	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
+	if !resolver.validate(importListLastFMUserResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	if !validateImportListReferences(auth, r.client, request, importListLastFMUserResourceName, &resp.Diagnostics) {
+		return
+	}
+
+	response, _, err := r.client.ImportListAPI.CreateImportList(auth).ImportListResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListLastFMUserResourceName, err))
 
 		return
 	}
 
+	response = helpers.ReadAfterCreate(ctx, response, importListLastFMUserResourceName, r.client.ImportListAPI.GetImportListById(auth, response.GetId()).Execute, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+importListLastFMUserResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, auth, response, importList.Tags, importList.SkipDefaultTags)
 	importList.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &importList.TagLabels, importList.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -230,9 +270,19 @@ func (r *ImportListLastFMUserResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
+	auth, cancel := helpers.WithTimeout(r.auth, importList.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get ImportListLastFMUser current value
-	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListAPI.GetImportListById(auth, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			resp.Diagnostics.AddWarning(helpers.ResourceNotFound, helpers.ParseResourceNotFound(importListLastFMUserResourceName, importList.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListLastFMUserResourceName, err))
 
 		return
@@ -240,7 +290,9 @@ func (r *ImportListLastFMUserResource) Read(ctx context.Context, req resource.Re
 
 	tflog.Trace(ctx, "read "+importListLastFMUserResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	removeDefaultTags(ctx, auth, response, importList.Tags, importList.SkipDefaultTags)
 	importList.write(ctx, response, &resp.Diagnostics)
+	newTagResolver(auth, r.client).write(ctx, &importList.TagLabels, importList.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -254,10 +306,29 @@ func (r *ImportListLastFMUserResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	auth, cancel := helpers.WithTimeout(r.auth, importList.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	resolver := newTagResolver(auth, r.client)
+	resolver.resolve(ctx, importList.TagLabels, &importList.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update ImportListLastFMUser
 	request := importList.read(ctx, &resp.Diagnostics)
+	applyDefaultTags(auth, request, importList.SkipDefaultTags)
+
+	if !resolver.validate(importListLastFMUserResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	if !validateImportListReferences(auth, r.client, request, importListLastFMUserResourceName, &resp.Diagnostics) {
+		return
+	}
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.UpdateImportList(auth, request.GetId()).ImportListResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListLastFMUserResourceName, err))
 
@@ -266,21 +337,34 @@ func (r *ImportListLastFMUserResource) Update(ctx context.Context, req resource.
 
 	tflog.Trace(ctx, "updated "+importListLastFMUserResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, auth, response, importList.Tags, importList.SkipDefaultTags)
 	importList.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &importList.TagLabels, importList.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
 func (r *ImportListLastFMUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID            int64
+		stateTimeouts timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &stateTimeouts)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if helpers.DeletionProtected(ctx, req.State, importListLastFMUserResourceName, &resp.Diagnostics) {
+		return
+	}
+
+	auth, cancel := helpers.WithTimeout(r.auth, stateTimeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete ImportListLastFMUser current value
-	_, err := r.client.ImportListAPI.DeleteImportList(r.auth, int32(ID)).Execute()
+	_, err := r.client.ImportListAPI.DeleteImportList(auth, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListLastFMUserResourceName, err))
 
@@ -293,6 +377,7 @@ func (r *ImportListLastFMUserResource) Delete(ctx context.Context, req resource.
 
 func (r *ImportListLastFMUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deletion_protection"), false)...)
 	tflog.Trace(ctx, "imported "+importListLastFMUserResourceName+": "+req.ID)
 }
 
@@ -15,6 +15,11 @@ func TestAccImportListLastFMUserResource(t *testing.T) {
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
+			// Invalid should_monitor
+			{
+				Config:      testAccImportListLastFMUserResourceConfig("resourceLastFMUserTest", "everything"),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
 			// Unauthorized Create
 			{
 				Config:      testAccImportListLastFMUserResourceConfig("resourceLastFMUserTest", "entireArtist") + testUnauthorizedProvider,
@@ -15,6 +15,11 @@ func TestAccImportListLidarrListResource(t *testing.T) {
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
+			// Invalid should_monitor
+			{
+				Config:      testAccImportListLidarrListResourceConfig("resourceLidarrListTest", "everything"),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
 			// Unauthorized Create
 			{
 				Config:      testAccImportListLidarrListResourceConfig("resourceLidarrListTest", "entireArtist") + testUnauthorizedProvider,
@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/lidarr-go/lidarr"
 	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -43,22 +44,26 @@ type ImportListLidarrResource struct {
 
 // ImportListLidarr describes the import list data model.
 type ImportListLidarr struct {
-	ProfileIDs            types.Set    `tfsdk:"profile_ids"`
-	TagIDs                types.Set    `tfsdk:"tag_ids"`
-	Tags                  types.Set    `tfsdk:"tags"`
-	Name                  types.String `tfsdk:"name"`
-	MonitorNewItems       types.String `tfsdk:"monitor_new_items"`
-	ShouldMonitor         types.String `tfsdk:"should_monitor"`
-	RootFolderPath        types.String `tfsdk:"root_folder_path"`
-	BaseURL               types.String `tfsdk:"base_url"`
-	APIKey                types.String `tfsdk:"api_key"`
-	QualityProfileID      types.Int64  `tfsdk:"quality_profile_id"`
-	MetadataProfileID     types.Int64  `tfsdk:"metadata_profile_id"`
-	ListOrder             types.Int64  `tfsdk:"list_order"`
-	ID                    types.Int64  `tfsdk:"id"`
-	EnableAutomaticAdd    types.Bool   `tfsdk:"enable_automatic_add"`
-	ShouldMonitorExisting types.Bool   `tfsdk:"should_monitor_existing"`
-	ShouldSearch          types.Bool   `tfsdk:"should_search"`
+	TagLabels
+	ProfileIDs            types.Set      `tfsdk:"profile_ids"`
+	TagIDs                types.Set      `tfsdk:"tag_ids"`
+	Tags                  types.Set      `tfsdk:"tags"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
+	DeletionProtection    types.Bool     `tfsdk:"deletion_protection"`
+	Name                  types.String   `tfsdk:"name"`
+	MonitorNewItems       types.String   `tfsdk:"monitor_new_items"`
+	ShouldMonitor         types.String   `tfsdk:"should_monitor"`
+	RootFolderPath        types.String   `tfsdk:"root_folder_path"`
+	BaseURL               types.String   `tfsdk:"base_url"`
+	APIKey                types.String   `tfsdk:"api_key"`
+	QualityProfileID      types.Int64    `tfsdk:"quality_profile_id"`
+	MetadataProfileID     types.Int64    `tfsdk:"metadata_profile_id"`
+	ListOrder             types.Int64    `tfsdk:"list_order"`
+	ID                    types.Int64    `tfsdk:"id"`
+	EnableAutomaticAdd    types.Bool     `tfsdk:"enable_automatic_add"`
+	ShouldMonitorExisting types.Bool     `tfsdk:"should_monitor_existing"`
+	ShouldSearch          types.Bool     `tfsdk:"should_search"`
+	SkipDefaultTags       types.Bool     `tfsdk:"skip_default_tags"`
 }
 
 func (i ImportListLidarr) toImportList() *ImportList {
@@ -108,10 +113,11 @@ func (r *ImportListLidarrResource) Metadata(_ context.Context, req resource.Meta
 	resp.TypeName = req.ProviderTypeName + "_" + importListLidarrResourceName
 }
 
-func (r *ImportListLidarrResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *ImportListLidarrResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->\nImport List Lidarr resource.\nFor more information refer to [Import List](https://wiki.servarr.com/lidarr/settings#import-lists) and [Lidarr](https://wiki.servarr.com/lidarr/supported#lidarrimport).",
 		Attributes: map[string]schema.Attribute{
+			"deletion_protection": helpers.DeletionProtectionAttribute(),
 			"enable_automatic_add": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic add flag.",
 				Optional:            true,
@@ -148,7 +154,7 @@ func (r *ImportListLidarrResource) Schema(_ context.Context, _ resource.SchemaRe
 				Computed:            true,
 			},
 			"should_monitor": schema.StringAttribute{
-				MarkdownDescription: "Should monitor.",
+				MarkdownDescription: "Should monitor. Valid values are 'none', 'specificAlbum' and 'entireArtist'.",
 				Optional:            true,
 				Computed:            true,
 				Validators: []validator.String{
@@ -156,7 +162,7 @@ func (r *ImportListLidarrResource) Schema(_ context.Context, _ resource.SchemaRe
 				},
 			},
 			"monitor_new_items": schema.StringAttribute{
-				MarkdownDescription: "Monitor new items.",
+				MarkdownDescription: "Monitor new items. Valid values are 'none', 'all' and 'new'.",
 				Optional:            true,
 				Computed:            true,
 				Validators: []validator.String{
@@ -173,6 +179,9 @@ func (r *ImportListLidarrResource) Schema(_ context.Context, _ resource.SchemaRe
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels":          tagLabelsAttribute(),
+			"create_missing_tags": createMissingTagsAttribute(),
+			"skip_default_tags":   skipDefaultTagsAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Import List ID.",
 				Computed:            true,
@@ -203,6 +212,14 @@ func (r *ImportListLidarrResource) Schema(_ context.Context, _ resource.SchemaRe
 				ElementType:         types.Int64Type,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -223,19 +240,42 @@ func (r *ImportListLidarrResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	auth, cancel := helpers.WithTimeout(r.auth, importList.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	resolver := newTagResolver(auth, r.client)
+	resolver.resolve(ctx, importList.TagLabels, &importList.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new ImportListLidarr
 	request := importList.read(ctx, &resp.Diagnostics)
+	applyDefaultTags(auth, request, importList.SkipDefaultTags)
 
-	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
+	if !resolver.validate(importListLidarrResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	if !validateImportListReferences(auth, r.client, request, importListLidarrResourceName, &resp.Diagnostics) {
+		return
+	}
+
+	response, _, err := r.client.ImportListAPI.CreateImportList(auth).ImportListResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListLidarrResourceName, err))
 
 		return
 	}
 
+	response = helpers.ReadAfterCreate(ctx, response, importListLidarrResourceName, r.client.ImportListAPI.GetImportListById(auth, response.GetId()).Execute, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+importListLidarrResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, auth, response, importList.Tags, importList.SkipDefaultTags)
 	importList.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &importList.TagLabels, importList.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -249,9 +289,19 @@ func (r *ImportListLidarrResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
+	auth, cancel := helpers.WithTimeout(r.auth, importList.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get ImportListLidarr current value
-	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListAPI.GetImportListById(auth, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			resp.Diagnostics.AddWarning(helpers.ResourceNotFound, helpers.ParseResourceNotFound(importListLidarrResourceName, importList.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListLidarrResourceName, err))
 
 		return
@@ -259,7 +309,9 @@ func (r *ImportListLidarrResource) Read(ctx context.Context, req resource.ReadRe
 
 	tflog.Trace(ctx, "read "+importListLidarrResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	removeDefaultTags(ctx, auth, response, importList.Tags, importList.SkipDefaultTags)
 	importList.write(ctx, response, &resp.Diagnostics)
+	newTagResolver(auth, r.client).write(ctx, &importList.TagLabels, importList.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
@@ -273,10 +325,29 @@ func (r *ImportListLidarrResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	auth, cancel := helpers.WithTimeout(r.auth, importList.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	resolver := newTagResolver(auth, r.client)
+	resolver.resolve(ctx, importList.TagLabels, &importList.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update ImportListLidarr
 	request := importList.read(ctx, &resp.Diagnostics)
+	applyDefaultTags(auth, request, importList.SkipDefaultTags)
+
+	if !resolver.validate(importListLidarrResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	if !validateImportListReferences(auth, r.client, request, importListLidarrResourceName, &resp.Diagnostics) {
+		return
+	}
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.UpdateImportList(auth, request.GetId()).ImportListResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListLidarrResourceName, err))
 
@@ -285,21 +356,34 @@ func (r *ImportListLidarrResource) Update(ctx context.Context, req resource.Upda
 
 	tflog.Trace(ctx, "updated "+importListLidarrResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, auth, response, importList.Tags, importList.SkipDefaultTags)
 	importList.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &importList.TagLabels, importList.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
 }
 
 func (r *ImportListLidarrResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID            int64
+		stateTimeouts timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &stateTimeouts)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if helpers.DeletionProtected(ctx, req.State, importListLidarrResourceName, &resp.Diagnostics) {
+		return
+	}
+
+	auth, cancel := helpers.WithTimeout(r.auth, stateTimeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete ImportListLidarr current value
-	_, err := r.client.ImportListAPI.DeleteImportList(r.auth, int32(ID)).Execute()
+	_, err := r.client.ImportListAPI.DeleteImportList(auth, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListLidarrResourceName, err))
 
@@ -312,6 +396,7 @@ func (r *ImportListLidarrResource) Delete(ctx context.Context, req resource.Dele
 
 func (r *ImportListLidarrResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deletion_protection"), false)...)
 	tflog.Trace(ctx, "imported "+importListLidarrResourceName+": "+req.ID)
 }
 
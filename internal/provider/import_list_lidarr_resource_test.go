@@ -15,30 +15,38 @@ func TestAccImportListLidarrResource(t *testing.T) {
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
+			// Invalid should_monitor
+			{
+				Config:      testAccImportListLidarrResourceConfig("resourceLidarrTest", "everything", "[1]"),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
 			// Unauthorized Create
 			{
-				Config:      testAccImportListLidarrResourceConfig("resourceLidarrTest", "entireArtist") + testUnauthorizedProvider,
+				Config:      testAccImportListLidarrResourceConfig("resourceLidarrTest", "entireArtist", "[1]") + testUnauthorizedProvider,
 				ExpectError: regexp.MustCompile("Client Error"),
 			},
 			// Create and Read testing
 			{
 				PreConfig: rootFolderDSInit,
-				Config:    testAccImportListLidarrResourceConfig("resourceLidarrTest", "entireArtist"),
+				Config:    testAccImportListLidarrResourceConfig("resourceLidarrTest", "entireArtist", "[1]"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("lidarr_import_list_lidarr.test", "should_monitor", "entireArtist"),
+					resource.TestCheckResourceAttr("lidarr_import_list_lidarr.test", "profile_ids.#", "1"),
 					resource.TestCheckResourceAttrSet("lidarr_import_list_lidarr.test", "id"),
 				),
 			},
 			// Unauthorized Read
 			{
-				Config:      testAccImportListLidarrResourceConfig("resourceLidarrTest", "entireArtist") + testUnauthorizedProvider,
+				Config:      testAccImportListLidarrResourceConfig("resourceLidarrTest", "entireArtist", "[1]") + testUnauthorizedProvider,
 				ExpectError: regexp.MustCompile("Client Error"),
 			},
 			// Update and Read testing
 			{
-				Config: testAccImportListLidarrResourceConfig("resourceLidarrTest", "specificAlbum"),
+				Config: testAccImportListLidarrResourceConfig("resourceLidarrTest", "specificAlbum", "[1, 2]"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("lidarr_import_list_lidarr.test", "should_monitor", "specificAlbum"),
+					resource.TestCheckResourceAttr("lidarr_import_list_lidarr.test", "profile_ids.#", "2"),
+					resource.TestCheckResourceAttr("lidarr_import_list_lidarr.test", "tag_ids.#", "1"),
 				),
 			},
 			// ImportState testing
@@ -53,8 +61,12 @@ func TestAccImportListLidarrResource(t *testing.T) {
 	})
 }
 
-func testAccImportListLidarrResourceConfig(name, folder string) string {
+func testAccImportListLidarrResourceConfig(name, folder, profiles string) string {
 	return fmt.Sprintf(`
+	resource "lidarr_tag" "import_list_lidarr" {
+		label = "importlistlidarr"
+	}
+
 	resource "lidarr_import_list_lidarr" "test" {
 		enable_automatic_add = false
 		should_monitor = "%s"
@@ -66,5 +78,7 @@ func testAccImportListLidarrResourceConfig(name, folder string) string {
 		name = "%s"
 		base_url = "http://127.0.0.1:8686"
 		api_key = "testAPIKey"
-	}`, folder, name)
+		profile_ids = %s
+		tag_ids = [lidarr_tag.import_list_lidarr.id]
+	}`, folder, name, profiles)
 }
@@ -15,6 +15,11 @@ func TestAccImportListMusicBrainzResource(t *testing.T) {
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
+			// Invalid should_monitor
+			{
+				Config:      testAccImportListMusicBrainzResourceConfig("resourceMusicBrainzTest", "everything"),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
 			// Unauthorized Create
 			{
 				Config:      testAccImportListMusicBrainzResourceConfig("resourceMusicBrainzTest", "entireArtist") + testUnauthorizedProvider,
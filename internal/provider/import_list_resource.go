@@ -2,10 +2,15 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"slices"
 	"strconv"
+	"strings"
+	"sync"
 
 	"github.com/devopsarr/lidarr-go/lidarr"
 	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -14,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -44,6 +50,16 @@ type ImportListResource struct {
 	auth   context.Context
 }
 
+// ImportListResourceData describes the import list resource data model.
+// It extends the import list data model with attributes not returned by Lidarr.
+type ImportListResourceData struct {
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	ImportList
+	TagLabels
+	DeletionProtection types.Bool `tfsdk:"deletion_protection"`
+	SkipDefaultTags    types.Bool `tfsdk:"skip_default_tags"`
+}
+
 // ImportList describes the download client data model.
 type ImportList struct {
 	ProfileIDs            types.Set    `tfsdk:"profile_ids"`
@@ -114,10 +130,11 @@ func (r *ImportListResource) Metadata(_ context.Context, req resource.MetadataRe
 	resp.TypeName = req.ProviderTypeName + "_" + importListResourceName
 }
 
-func (r *ImportListResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *ImportListResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->\nGeneric Import List resource. When possible use a specific resource instead.\nFor more information refer to [Import List](https://wiki.servarr.com/lidarr/settings#import-lists).",
 		Attributes: map[string]schema.Attribute{
+			"deletion_protection": helpers.DeletionProtectionAttribute(),
 			"enable_automatic_add": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic add flag.",
 				Optional:            true,
@@ -154,7 +171,7 @@ func (r *ImportListResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Computed:            true,
 			},
 			"should_monitor": schema.StringAttribute{
-				MarkdownDescription: "Should monitor.",
+				MarkdownDescription: "Should monitor. Valid values are 'none', 'specificAlbum' and 'entireArtist'.",
 				Optional:            true,
 				Computed:            true,
 				Validators: []validator.String{
@@ -162,7 +179,7 @@ func (r *ImportListResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 			"monitor_new_items": schema.StringAttribute{
-				MarkdownDescription: "Monitor new items.",
+				MarkdownDescription: "Monitor new items. Valid values are 'none', 'all' and 'new'.",
 				Optional:            true,
 				Computed:            true,
 				Validators: []validator.String{
@@ -173,13 +190,19 @@ func (r *ImportListResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				MarkdownDescription: "ImportList implementation name.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
 			},
 			"config_contract": schema.StringAttribute{
 				MarkdownDescription: "ImportList configuration template.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"list_type": schema.StringAttribute{
-				MarkdownDescription: "List type.",
+				MarkdownDescription: "List type. Valid values are 'program', 'spotify', 'lastFm' and 'other'.",
 				Required:            true,
 				Validators: []validator.String{
 					stringvalidator.OneOf("program", "spotify", "lastFm", "other"),
@@ -195,6 +218,9 @@ func (r *ImportListResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels":          tagLabelsAttribute(),
+			"create_missing_tags": createMissingTagsAttribute(),
+			"skip_default_tags":   skipDefaultTagsAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Import List ID.",
 				Computed:            true,
@@ -275,6 +301,14 @@ func (r *ImportListResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				ElementType:         types.StringType,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -287,7 +321,7 @@ func (r *ImportListResource) Configure(ctx context.Context, req resource.Configu
 
 func (r *ImportListResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var importList *ImportList
+	var importList *ImportListResourceData
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &importList)...)
 
@@ -295,29 +329,56 @@ func (r *ImportListResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	auth, cancel := helpers.WithTimeout(r.auth, importList.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	resolver := newTagResolver(auth, r.client)
+	resolver.resolve(ctx, importList.TagLabels, &importList.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new ImportList
 	request := importList.read(ctx, &resp.Diagnostics)
+	applyDefaultTags(auth, request, importList.SkipDefaultTags)
 
-	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
+	if !resolver.validate(importListResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	if !validateImportListReferences(auth, r.client, request, importListResourceName, &resp.Diagnostics) {
+		return
+	}
+
+	response, _, err := r.client.ImportListAPI.CreateImportList(auth).ImportListResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListResourceName, err))
 
 		return
 	}
 
+	response = helpers.ReadAfterCreate(ctx, response, importListResourceName, r.client.ImportListAPI.GetImportListById(auth, response.GetId()).Execute, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+importListResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state ImportList
+	var state ImportListResourceData
 
-	state.writeSensitive(importList)
+	state.Timeouts = importList.Timeouts
+	state.DeletionProtection = importList.DeletionProtection
+	state.writeSensitive(&importList.ImportList)
+	removeDefaultTags(ctx, auth, response, importList.Tags, importList.SkipDefaultTags)
 	state.write(ctx, response, &resp.Diagnostics)
+	state.TagLabels = importList.TagLabels
+	state.SkipDefaultTags = importList.SkipDefaultTags
+	resolver.write(ctx, &state.TagLabels, state.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *ImportListResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var importList *ImportList
+	var importList *ImportListResourceData
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &importList)...)
 
@@ -325,9 +386,19 @@ func (r *ImportListResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	auth, cancel := helpers.WithTimeout(r.auth, importList.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get ImportList current value
-	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListAPI.GetImportListById(auth, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			resp.Diagnostics.AddWarning(helpers.ResourceNotFound, helpers.ParseResourceNotFound(importListResourceName, importList.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListResourceName, err))
 
 		return
@@ -336,16 +407,22 @@ func (r *ImportListResource) Read(ctx context.Context, req resource.ReadRequest,
 	tflog.Trace(ctx, "read "+importListResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state ImportList
+	var state ImportListResourceData
 
-	state.writeSensitive(importList)
+	state.Timeouts = importList.Timeouts
+	state.DeletionProtection = importList.DeletionProtection
+	state.writeSensitive(&importList.ImportList)
+	removeDefaultTags(ctx, auth, response, importList.Tags, importList.SkipDefaultTags)
 	state.write(ctx, response, &resp.Diagnostics)
+	state.TagLabels = importList.TagLabels
+	state.SkipDefaultTags = importList.SkipDefaultTags
+	newTagResolver(auth, r.client).write(ctx, &state.TagLabels, state.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *ImportListResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var importList *ImportList
+	var importList *ImportListResourceData
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &importList)...)
 
@@ -353,10 +430,29 @@ func (r *ImportListResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	auth, cancel := helpers.WithTimeout(r.auth, importList.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	resolver := newTagResolver(auth, r.client)
+	resolver.resolve(ctx, importList.TagLabels, &importList.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update ImportList
 	request := importList.read(ctx, &resp.Diagnostics)
+	applyDefaultTags(auth, request, importList.SkipDefaultTags)
+
+	if !resolver.validate(importListResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	if !validateImportListReferences(auth, r.client, request, importListResourceName, &resp.Diagnostics) {
+		return
+	}
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.UpdateImportList(auth, request.GetId()).ImportListResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListResourceName, err))
 
@@ -366,24 +462,41 @@ func (r *ImportListResource) Update(ctx context.Context, req resource.UpdateRequ
 	tflog.Trace(ctx, "updated "+importListResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state ImportList
+	var state ImportListResourceData
 
-	state.writeSensitive(importList)
+	state.Timeouts = importList.Timeouts
+	state.DeletionProtection = importList.DeletionProtection
+	state.writeSensitive(&importList.ImportList)
+	removeDefaultTags(ctx, auth, response, importList.Tags, importList.SkipDefaultTags)
 	state.write(ctx, response, &resp.Diagnostics)
+	state.TagLabels = importList.TagLabels
+	state.SkipDefaultTags = importList.SkipDefaultTags
+	resolver.write(ctx, &state.TagLabels, state.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *ImportListResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID            int64
+		stateTimeouts timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &stateTimeouts)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if helpers.DeletionProtected(ctx, req.State, importListResourceName, &resp.Diagnostics) {
+		return
+	}
+
+	auth, cancel := helpers.WithTimeout(r.auth, stateTimeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete ImportList current value
-	_, err := r.client.ImportListAPI.DeleteImportList(r.auth, int32(ID)).Execute()
+	_, err := r.client.ImportListAPI.DeleteImportList(auth, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListResourceName, err))
 
@@ -396,6 +509,7 @@ func (r *ImportListResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 func (r *ImportListResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deletion_protection"), false)...)
 	tflog.Trace(ctx, "imported "+importListResourceName+": "+req.ID)
 }
 
@@ -452,4 +566,137 @@ func (i *ImportList) writeSensitive(importList *ImportList) {
 	if !importList.APIKey.IsUnknown() {
 		i.APIKey = importList.APIKey
 	}
+
+	if !importList.AccessToken.IsUnknown() {
+		i.AccessToken = importList.AccessToken
+	}
+
+	if !importList.RefreshToken.IsUnknown() {
+		i.RefreshToken = importList.RefreshToken
+	}
+}
+
+// spotifyOAuthValue keeps a Spotify OAuth value from state, since Lidarr rotates it on its own when refreshing the session.
+// The remote value is used only when nothing is known yet, e.g. on import.
+func spotifyOAuthValue(state, remote types.String) types.String {
+	if state.IsNull() || state.IsUnknown() {
+		return remote
+	}
+
+	return state
+}
+
+// importListReferences caches the profiles and root folders used to validate import list references,
+// so that applying many lists does not fetch them again for each one.
+type importListReferences struct {
+	qualityProfiles  []int32
+	metadataProfiles []int32
+	rootFolders      []string
+	mu               sync.Mutex
+	loaded           bool
+}
+
+// importListReferencesCache holds an importListReferences for each configured client.
+var importListReferencesCache sync.Map
+
+// load fetches quality profiles, metadata profiles and root folders from Lidarr.
+func (r *importListReferences) load(auth context.Context, client *lidarr.APIClient) error {
+	qualityProfiles, _, err := client.QualityProfileAPI.ListQualityProfile(auth).Execute()
+	if err != nil {
+		return err
+	}
+
+	metadataProfiles, _, err := client.MetadataProfileAPI.ListMetadataProfile(auth).Execute()
+	if err != nil {
+		return err
+	}
+
+	rootFolders, _, err := client.RootFolderAPI.ListRootFolder(auth).Execute()
+	if err != nil {
+		return err
+	}
+
+	r.qualityProfiles = make([]int32, len(qualityProfiles))
+	for i, p := range qualityProfiles {
+		r.qualityProfiles[i] = p.GetId()
+	}
+
+	r.metadataProfiles = make([]int32, len(metadataProfiles))
+	for i, p := range metadataProfiles {
+		r.metadataProfiles[i] = p.GetId()
+	}
+
+	r.rootFolders = make([]string, len(rootFolders))
+	for i, f := range rootFolders {
+		r.rootFolders[i] = strings.TrimRight(f.GetPath(), "/")
+	}
+
+	r.loaded = true
+
+	return nil
+}
+
+// missing returns a diagnostic for each reference of the import list not found in Lidarr.
+func (r *importListReferences) missing(importList *lidarr.ImportListResource) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if id := importList.GetQualityProfileId(); id != 0 && !slices.Contains(r.qualityProfiles, id) {
+		diags.AddAttributeError(path.Root("quality_profile_id"), helpers.ResourceError,
+			fmt.Sprintf("Quality profile %d does not exist. Available quality profiles: %s", id, joinInt32(r.qualityProfiles)))
+	}
+
+	if id := importList.GetMetadataProfileId(); id != 0 && !slices.Contains(r.metadataProfiles, id) {
+		diags.AddAttributeError(path.Root("metadata_profile_id"), helpers.ResourceError,
+			fmt.Sprintf("Metadata profile %d does not exist. Available metadata profiles: %s", id, joinInt32(r.metadataProfiles)))
+	}
+
+	if folder := strings.TrimRight(importList.GetRootFolderPath(), "/"); folder != "" && !slices.Contains(r.rootFolders, folder) {
+		diags.AddAttributeError(path.Root("root_folder_path"), helpers.ResourceError,
+			fmt.Sprintf("Root folder '%s' does not exist. Available root folders: %s", importList.GetRootFolderPath(), strings.Join(r.rootFolders, ", ")))
+	}
+
+	return diags
+}
+
+// validateImportListReferences checks that the profiles and root folder referenced by an import list exist.
+// Lookups are cached per client and refreshed once when a reference is missing, to catch objects created in the same apply.
+func validateImportListReferences(auth context.Context, client *lidarr.APIClient, importList *lidarr.ImportListResource, name string, diags *diag.Diagnostics) bool {
+	cached, _ := importListReferencesCache.LoadOrStore(client, &importListReferences{})
+	references, _ := cached.(*importListReferences)
+
+	references.mu.Lock()
+	defer references.mu.Unlock()
+
+	refreshed := !references.loaded
+	if refreshed {
+		if err := references.load(auth, client); err != nil {
+			diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Validate, name, err))
+
+			return false
+		}
+	}
+
+	missing := references.missing(importList)
+	if missing.HasError() && !refreshed {
+		if err := references.load(auth, client); err != nil {
+			diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Validate, name, err))
+
+			return false
+		}
+
+		missing = references.missing(importList)
+	}
+
+	diags.Append(missing...)
+
+	return !missing.HasError()
+}
+
+func joinInt32(values []int32) string {
+	s := make([]string, len(values))
+	for i, v := range values {
+		s[i] = strconv.Itoa(int(v))
+	}
+
+	return strings.Join(s, ", ")
 }
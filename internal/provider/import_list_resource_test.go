@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 func TestAccImportListResource(t *testing.T) {
@@ -15,11 +16,22 @@ func TestAccImportListResource(t *testing.T) {
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
+			// Invalid should_monitor
+			{
+				Config:      testAccImportListResourceConfig("importListResourceTest", "everything"),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
 			// Unauthorized Create
 			{
 				Config:      testAccImportListResourceConfig("importListResourceTest", "entireArtist") + testUnauthorizedProvider,
 				ExpectError: regexp.MustCompile("Client Error"),
 			},
+			// Missing references
+			{
+				PreConfig:   rootFolderDSInit,
+				Config:      testAccImportListResourceReferencesConfig("importListResourceTest", "/missing"),
+				ExpectError: regexp.MustCompile("Root folder '/missing' does not exist"),
+			},
 			// Create and Read testing
 			{
 				PreConfig: rootFolderDSInit,
@@ -43,11 +55,21 @@ func TestAccImportListResource(t *testing.T) {
 			},
 			// ImportState testing
 			{
-				ResourceName:            "lidarr_import_list.test",
-				ImportState:             true,
-				ImportStateVerify:       true,
+				ResourceName:      "lidarr_import_list.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Lidarr returns secrets masked, so on import they cannot match the configured value
 				ImportStateVerifyIgnore: []string{"api_key"},
 			},
+			// Replace testing
+			{
+				Config: testAccImportListResourceReplaceConfig("resourceTest"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("lidarr_import_list.test", plancheck.ResourceActionReplace),
+					},
+				},
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -74,3 +96,41 @@ func testAccImportListResourceConfig(name, monitor string) string {
 		tags = []
 	}`, monitor, name)
 }
+
+func testAccImportListResourceReferencesConfig(name, folder string) string {
+	return fmt.Sprintf(`
+	resource "lidarr_import_list" "test" {
+		enable_automatic_add = false
+		should_monitor = "entireArtist"
+		should_search = false
+		list_type = "program"
+		root_folder_path = "%s"
+		monitor_new_items = "all"
+		quality_profile_id = 1
+		metadata_profile_id = 1
+		name = "%s"
+		implementation = "LidarrImport"
+		config_contract = "LidarrSettings"
+		base_url = "http://127.0.0.1:8686"
+		api_key = "testAPIKey"
+	}`, folder, name)
+}
+
+func testAccImportListResourceReplaceConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "lidarr_import_list" "test" {
+		enable_automatic_add = false
+		should_monitor = "entireArtist"
+		should_search = false
+		list_type = "program"
+		root_folder_path = "/config"
+		monitor_new_items = "all"
+		quality_profile_id = 1
+		metadata_profile_id = 1
+		name = "%s"
+		implementation = "HeadphonesImport"
+		config_contract = "HeadphonesImportSettings"
+		base_url = "http://127.0.0.1:8181"
+		api_key = "testAPIKey"
+	}`, name)
+}
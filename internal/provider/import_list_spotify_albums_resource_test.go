@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 func TestAccImportListSpotifyAlbumsResource(t *testing.T) {
@@ -15,6 +16,11 @@ func TestAccImportListSpotifyAlbumsResource(t *testing.T) {
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
+			// Invalid should_monitor
+			{
+				Config:      testAccImportListSpotifyAlbumsResourceConfig("resourceSpotifyAlbumTest", "everything"),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
 			// Unauthorized Create
 			{
 				Config:      testAccImportListSpotifyAlbumsResourceConfig("resourceSpotifyAlbumTest", "entireArtist") + testUnauthorizedProvider,
@@ -41,6 +47,18 @@ func TestAccImportListSpotifyAlbumsResource(t *testing.T) {
 					resource.TestCheckResourceAttr("lidarr_import_list_spotify_albums.test", "should_monitor", "specificAlbum"),
 				),
 			},
+			// Omitted OAuth values keep state
+			{
+				Config: testAccImportListSpotifyAlbumsResourceRefreshConfig("resourceSpotifyAlbumTest", "specificAlbum"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lidarr_import_list_spotify_albums.test", "access_token", "accessToken"),
+				),
+			},
 			// ImportState testing
 			{
 				ResourceName:      "lidarr_import_list_spotify_albums.test",
@@ -68,3 +86,18 @@ func testAccImportListSpotifyAlbumsResourceConfig(name, folder string) string {
 		expires = "0001-01-01T00:01:00Z"
 	}`, folder, name)
 }
+
+func testAccImportListSpotifyAlbumsResourceRefreshConfig(name, folder string) string {
+	return fmt.Sprintf(`
+	resource "lidarr_import_list_spotify_albums" "test" {
+		enable_automatic_add = false
+		should_monitor = "%s"
+		should_search = false
+		root_folder_path = "/config"
+		monitor_new_items = "all"
+		quality_profile_id = 1
+		metadata_profile_id = 1
+		name = "%s"
+		refresh_token = "refreshToken"
+	}`, folder, name)
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 func TestAccImportListSpotifyArtistsResource(t *testing.T) {
@@ -15,15 +16,20 @@ func TestAccImportListSpotifyArtistsResource(t *testing.T) {
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
+			// Invalid should_monitor
+			{
+				Config:      testAccImportListSpotifyArtistsResourceConfig("resourceSpotifyArtistsTest", "everything", "accessToken"),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
 			// Unauthorized Create
 			{
-				Config:      testAccImportListSpotifyArtistsResourceConfig("resourceSpotifyArtistsTest", "entireArtist") + testUnauthorizedProvider,
+				Config:      testAccImportListSpotifyArtistsResourceConfig("resourceSpotifyArtistsTest", "entireArtist", "accessToken") + testUnauthorizedProvider,
 				ExpectError: regexp.MustCompile("Client Error"),
 			},
 			// Create and Read testing
 			{
 				PreConfig: rootFolderDSInit,
-				Config:    testAccImportListSpotifyArtistsResourceConfig("resourceSpotifyArtistsTest", "entireArtist"),
+				Config:    testAccImportListSpotifyArtistsResourceConfig("resourceSpotifyArtistsTest", "entireArtist", "accessToken"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("lidarr_import_list_spotify_artists.test", "should_monitor", "entireArtist"),
 					resource.TestCheckResourceAttrSet("lidarr_import_list_spotify_artists.test", "id"),
@@ -31,16 +37,28 @@ func TestAccImportListSpotifyArtistsResource(t *testing.T) {
 			},
 			// Unauthorized Read
 			{
-				Config:      testAccImportListSpotifyArtistsResourceConfig("resourceSpotifyArtistsTest", "entireArtist") + testUnauthorizedProvider,
+				Config:      testAccImportListSpotifyArtistsResourceConfig("resourceSpotifyArtistsTest", "entireArtist", "accessToken") + testUnauthorizedProvider,
 				ExpectError: regexp.MustCompile("Client Error"),
 			},
 			// Update and Read testing
 			{
-				Config: testAccImportListSpotifyArtistsResourceConfig("resourceSpotifyArtistsTest", "specificAlbum"),
+				Config: testAccImportListSpotifyArtistsResourceConfig("resourceSpotifyArtistsTest", "specificAlbum", "accessToken"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("lidarr_import_list_spotify_artists.test", "should_monitor", "specificAlbum"),
 				),
 			},
+			// Update token in place
+			{
+				Config: testAccImportListSpotifyArtistsResourceConfig("resourceSpotifyArtistsTest", "specificAlbum", "newAccessToken"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("lidarr_import_list_spotify_artists.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lidarr_import_list_spotify_artists.test", "access_token", "newAccessToken"),
+				),
+			},
 			// ImportState testing
 			{
 				ResourceName:      "lidarr_import_list_spotify_artists.test",
@@ -52,7 +70,7 @@ func TestAccImportListSpotifyArtistsResource(t *testing.T) {
 	})
 }
 
-func testAccImportListSpotifyArtistsResourceConfig(name, folder string) string {
+func testAccImportListSpotifyArtistsResourceConfig(name, folder, token string) string {
 	return fmt.Sprintf(`
 	resource "lidarr_import_list_spotify_artists" "test" {
 		enable_automatic_add = false
@@ -63,8 +81,8 @@ func testAccImportListSpotifyArtistsResourceConfig(name, folder string) string {
 		quality_profile_id = 1
 		metadata_profile_id = 1
 		name = "%s"
-		access_token = "accessToken"
+		access_token = "%s"
 		refresh_token = "refreshToken"
 		expires = "0001-01-01T00:01:00Z"
-	}`, folder, name)
+	}`, folder, name, token)
 }
@@ -15,30 +15,38 @@ func TestAccImportListSpotifyPlaylistsResource(t *testing.T) {
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
+			// Invalid should_monitor
+			{
+				Config:      testAccImportListSpotifyPlaylistsResourceConfig("resourceSpotifyPlaylistTest", "everything", `"play1"`),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
 			// Unauthorized Create
 			{
-				Config:      testAccImportListSpotifyPlaylistsResourceConfig("resourceSpotifyPlaylistTest", "entireArtist") + testUnauthorizedProvider,
+				Config:      testAccImportListSpotifyPlaylistsResourceConfig("resourceSpotifyPlaylistTest", "entireArtist", `"play1"`) + testUnauthorizedProvider,
 				ExpectError: regexp.MustCompile("Client Error"),
 			},
 			// Create and Read testing
 			{
 				PreConfig: rootFolderDSInit,
-				Config:    testAccImportListSpotifyPlaylistsResourceConfig("resourceSpotifyPlaylistTest", "entireArtist"),
+				Config:    testAccImportListSpotifyPlaylistsResourceConfig("resourceSpotifyPlaylistTest", "entireArtist", `"play1"`),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("lidarr_import_list_spotify_playlists.test", "should_monitor", "entireArtist"),
+					resource.TestCheckResourceAttr("lidarr_import_list_spotify_playlists.test", "playlist_ids.#", "1"),
 					resource.TestCheckResourceAttrSet("lidarr_import_list_spotify_playlists.test", "id"),
 				),
 			},
 			// Unauthorized Read
 			{
-				Config:      testAccImportListSpotifyPlaylistsResourceConfig("resourceSpotifyPlaylistTest", "entireArtist") + testUnauthorizedProvider,
+				Config:      testAccImportListSpotifyPlaylistsResourceConfig("resourceSpotifyPlaylistTest", "entireArtist", `"play1"`) + testUnauthorizedProvider,
 				ExpectError: regexp.MustCompile("Client Error"),
 			},
 			// Update and Read testing
 			{
-				Config: testAccImportListSpotifyPlaylistsResourceConfig("resourceSpotifyPlaylistTest", "specificAlbum"),
+				Config: testAccImportListSpotifyPlaylistsResourceConfig("resourceSpotifyPlaylistTest", "specificAlbum", `"play1", "play2"`),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("lidarr_import_list_spotify_playlists.test", "should_monitor", "specificAlbum"),
+					resource.TestCheckResourceAttr("lidarr_import_list_spotify_playlists.test", "playlist_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttr("lidarr_import_list_spotify_playlists.test", "playlist_ids.*", "play2"),
 				),
 			},
 			// ImportState testing
@@ -52,7 +60,7 @@ func TestAccImportListSpotifyPlaylistsResource(t *testing.T) {
 	})
 }
 
-func testAccImportListSpotifyPlaylistsResourceConfig(name, folder string) string {
+func testAccImportListSpotifyPlaylistsResourceConfig(name, folder, playlists string) string {
 	return fmt.Sprintf(`
 	resource "lidarr_import_list_spotify_playlists" "test" {
 		enable_automatic_add = false
@@ -66,6 +74,6 @@ func testAccImportListSpotifyPlaylistsResourceConfig(name, folder string) string
 		access_token = "accessToken"
 		refresh_token = "refreshToken"
 		expires = "0001-01-01T00:01:00Z"
-		playlist_ids = ["play1", "play2"]
-	}`, folder, name)
+		playlist_ids = [%s]
+	}`, folder, name, playlists)
 }
@@ -15,7 +15,10 @@ import (
 const indexerDataSourceName = "indexer"
 
 // Ensure provider defined types fully satisfy framework interfaces.
-var _ datasource.DataSource = &IndexerDataSource{}
+var (
+	_ datasource.DataSource                     = &IndexerDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &IndexerDataSource{}
+)
 
 func NewIndexerDataSource() datasource.DataSource {
 	return &IndexerDataSource{}
@@ -62,7 +65,8 @@ func (d *IndexerDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Indexer name.",
-				Required:            true,
+				Optional:            true,
+				Computed:            true,
 			},
 			"protocol": schema.StringAttribute{
 				MarkdownDescription: "Protocol. Valid values are 'usenet' and 'torrent'.",
@@ -75,6 +79,7 @@ func (d *IndexerDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Indexer ID.",
+				Optional:            true,
 				Computed:            true,
 			},
 			// Field values
@@ -86,6 +91,11 @@ func (d *IndexerDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				MarkdownDescription: "Allow ranked only.",
 				Computed:            true,
 			},
+			"fields": schema.MapAttribute{
+				MarkdownDescription: "Raw field values for settings not covered by other attributes, keyed by API field name.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
 			"use_freeleech_token": schema.BoolAttribute{
 				MarkdownDescription: "Use freeleech token flag.",
 				Computed:            true,
@@ -173,6 +183,12 @@ func (d *IndexerDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 	}
 }
 
+func (d *IndexerDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		helpers.IDOrName("name"),
+	}
+}
+
 func (d *IndexerDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
 		d.client = client
@@ -196,20 +212,20 @@ func (d *IndexerDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	data.find(ctx, data.Name.ValueString(), response, &resp.Diagnostics)
+	data.find(ctx, helpers.NewLookup(data.ID, "name", data.Name), response, &resp.Diagnostics)
 	tflog.Trace(ctx, "read "+indexerDataSourceName)
 	// Map response body to resource schema attribute
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (i *Indexer) find(ctx context.Context, name string, indexers []lidarr.IndexerResource, diags *diag.Diagnostics) {
+func (i *Indexer) find(ctx context.Context, lookup helpers.Lookup, indexers []lidarr.IndexerResource, diags *diag.Diagnostics) {
 	for _, indexer := range indexers {
-		if indexer.GetName() == name {
+		if lookup.Matches(indexer.GetId(), indexer.GetName()) {
 			i.write(ctx, &indexer, diags)
 
 			return
 		}
 	}
 
-	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(indexerDataSourceName, "name", name))
+	diags.AddError(helpers.DataSourceError, lookup.NotFound(indexerDataSourceName, helpers.Values(indexers, (*lidarr.IndexerResource).GetName)))
 }
@@ -32,6 +32,17 @@ func TestAccIndexerDataSource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.lidarr_indexer.test", "id"),
 					resource.TestCheckResourceAttr("data.lidarr_indexer.test", "protocol", "usenet")),
 			},
+			// Conflicting lookup testing
+			{
+				Config:      testAccIndexerDataSourceConflictConfig,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			// Read by ID testing
+			{
+				Config: testAccIndexerResourceConfig("indexerdata", 20) + testAccIndexerDataSourceIDConfig("lidarr_indexer.test.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lidarr_indexer.test", "name", "indexerdata")),
+			},
 		},
 	})
 }
@@ -43,3 +54,18 @@ func testAccIndexerDataSourceConfig(name string) string {
 	}
 	`, name)
 }
+
+const testAccIndexerDataSourceConflictConfig = `
+data "lidarr_indexer" "test" {
+	id = 1
+	name = "Error"
+}
+`
+
+func testAccIndexerDataSourceIDConfig(id string) string {
+	return fmt.Sprintf(`
+	data "lidarr_indexer" "test" {
+		id = %s
+	}
+	`, id)
+}
@@ -10,8 +10,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -41,6 +43,8 @@ type IndexerFilelistResource struct {
 
 // IndexerFilelist describes the Filelist indexer data model.
 type IndexerFilelist struct {
+	TagLabels
+	ExtraFields             types.Map     `tfsdk:"extra_fields"`
 	SeedRatio               types.Float64 `tfsdk:"seed_ratio"`
 	Categories              types.Set     `tfsdk:"categories"`
 	Tags                    types.Set     `tfsdk:"tags"`
@@ -51,14 +55,19 @@ type IndexerFilelist struct {
 	Priority                types.Int64   `tfsdk:"priority"`
 	MinimumSeeders          types.Int64   `tfsdk:"minimum_seeders"`
 	SeedTime                types.Int64   `tfsdk:"seed_time"`
+	DiscographySeedTime     types.Int64   `tfsdk:"discography_seed_time"`
+	EarlyReleaseLimit       types.Int64   `tfsdk:"early_release_limit"`
 	ID                      types.Int64   `tfsdk:"id"`
 	EnableAutomaticSearch   types.Bool    `tfsdk:"enable_automatic_search"`
 	EnableRss               types.Bool    `tfsdk:"enable_rss"`
+	TestOnCreate            types.Bool    `tfsdk:"test_on_create"`
 	EnableInteractiveSearch types.Bool    `tfsdk:"enable_interactive_search"`
+	SkipDefaultTags         types.Bool    `tfsdk:"skip_default_tags"`
 }
 
 func (i IndexerFilelist) toIndexer() *Indexer {
 	return &Indexer{
+		Fields:                  i.ExtraFields,
 		EnableAutomaticSearch:   i.EnableAutomaticSearch,
 		EnableInteractiveSearch: i.EnableInteractiveSearch,
 		EnableRss:               i.EnableRss,
@@ -67,6 +76,8 @@ func (i IndexerFilelist) toIndexer() *Indexer {
 		Name:                    i.Name,
 		MinimumSeeders:          i.MinimumSeeders,
 		SeedTime:                i.SeedTime,
+		DiscographySeedTime:     i.DiscographySeedTime,
+		EarlyReleaseLimit:       i.EarlyReleaseLimit,
 		SeedRatio:               i.SeedRatio,
 		Username:                i.Username,
 		Passkey:                 i.Passkey,
@@ -88,12 +99,18 @@ func (i *IndexerFilelist) fromIndexer(indexer *Indexer) {
 	i.Name = indexer.Name
 	i.MinimumSeeders = indexer.MinimumSeeders
 	i.SeedTime = indexer.SeedTime
+	i.DiscographySeedTime = indexer.DiscographySeedTime
+	i.EarlyReleaseLimit = indexer.EarlyReleaseLimit
 	i.SeedRatio = indexer.SeedRatio
 	i.Username = indexer.Username
 	i.Passkey = indexer.Passkey
 	i.BaseURL = indexer.BaseURL
 	i.Tags = indexer.Tags
 	i.Categories = indexer.Categories
+
+	if !i.ExtraFields.IsNull() {
+		i.ExtraFields = indexer.Fields
+	}
 }
 
 func (r *IndexerFilelistResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -104,6 +121,7 @@ func (r *IndexerFilelistResource) Schema(_ context.Context, _ resource.SchemaReq
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->\nIndexer FileList resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/lidarr/settings#indexers) and [FileList](https://wiki.servarr.com/lidarr/supported#filelist).",
 		Attributes: map[string]schema.Attribute{
+			"extra_fields": helpers.ExtraFieldsAttribute(),
 			"enable_automatic_search": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic search flag.",
 				Optional:            true,
@@ -128,12 +146,21 @@ func (r *IndexerFilelistResource) Schema(_ context.Context, _ resource.SchemaReq
 				MarkdownDescription: "IndexerFilelist name.",
 				Required:            true,
 			},
+			"test_on_create": schema.BoolAttribute{
+				MarkdownDescription: "Run the Lidarr indexer test before creating it. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"tags": schema.SetAttribute{
 				MarkdownDescription: "List of associated tags.",
 				Optional:            true,
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels":          tagLabelsAttribute(),
+			"create_missing_tags": createMissingTagsAttribute(),
+			"skip_default_tags":   skipDefaultTagsAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "IndexerFilelist ID.",
 				Computed:            true,
@@ -152,6 +179,16 @@ func (r *IndexerFilelistResource) Schema(_ context.Context, _ resource.SchemaReq
 				Optional:            true,
 				Computed:            true,
 			},
+			"discography_seed_time": schema.Int64Attribute{
+				MarkdownDescription: "Discography seed time.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"early_release_limit": schema.Int64Attribute{
+				MarkdownDescription: "Early release limit.",
+				Optional:            true,
+				Computed:            true,
+			},
 			"seed_ratio": schema.Float64Attribute{
 				MarkdownDescription: "Seed ratio.",
 				Optional:            true,
@@ -161,6 +198,9 @@ func (r *IndexerFilelistResource) Schema(_ context.Context, _ resource.SchemaReq
 				MarkdownDescription: "Base URL.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
 			},
 			"passkey": schema.StringAttribute{
 				MarkdownDescription: "Passkey.",
@@ -198,9 +238,30 @@ func (r *IndexerFilelistResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, indexer.TagLabels, &indexer.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new IndexerFilelist
 	request := indexer.read(ctx, &resp.Diagnostics)
 
+	if !typeIndexerFields(r.auth, r.client, request, indexerFilelistResourceName, &resp.Diagnostics, indexer.ExtraFields) {
+		return
+	}
+
+	applyDefaultTags(r.auth, request, indexer.SkipDefaultTags)
+
+	if !resolver.validate(indexerFilelistResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	if indexer.TestOnCreate.ValueBool() && !testIndexer(r.auth, r.client, request, indexerFilelistResourceName, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerFilelistResourceName, err))
@@ -208,9 +269,13 @@ func (r *IndexerFilelistResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	response = helpers.ReadAfterCreate(ctx, response, indexerFilelistResourceName, r.client.IndexerAPI.GetIndexerById(r.auth, response.GetId()).Execute, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+indexerFilelistResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, indexer.Tags, indexer.SkipDefaultTags)
 	indexer.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &indexer.TagLabels, indexer.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -225,8 +290,15 @@ func (r *IndexerFilelistResource) Read(ctx context.Context, req resource.ReadReq
 	}
 
 	// Get IndexerFilelist current value
-	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			resp.Diagnostics.AddWarning(helpers.ResourceNotFound, helpers.ParseResourceNotFound(indexerFilelistResourceName, indexer.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerFilelistResourceName, err))
 
 		return
@@ -234,7 +306,11 @@ func (r *IndexerFilelistResource) Read(ctx context.Context, req resource.ReadReq
 
 	tflog.Trace(ctx, "read "+indexerFilelistResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	removeDefaultTags(ctx, r.auth, response, indexer.Tags, indexer.SkipDefaultTags)
 	indexer.write(ctx, response, &resp.Diagnostics)
+	newTagResolver(r.auth, r.client).write(ctx, &indexer.TagLabels, indexer.Tags, &resp.Diagnostics)
+	// Test flag is not stored by Lidarr, keep the state value or use the default on import
+	indexer.TestOnCreate = types.BoolValue(indexer.TestOnCreate.ValueBool())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -248,9 +324,27 @@ func (r *IndexerFilelistResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, indexer.TagLabels, &indexer.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update IndexerFilelist
 	request := indexer.read(ctx, &resp.Diagnostics)
 
+	if !typeIndexerFields(r.auth, r.client, request, indexerFilelistResourceName, &resp.Diagnostics, indexer.ExtraFields) {
+		return
+	}
+
+	preserveIndexerFields(r.auth, r.client, request)
+	applyDefaultTags(r.auth, request, indexer.SkipDefaultTags)
+
+	if !resolver.validate(indexerFilelistResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, request.GetId()).IndexerResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerFilelistResourceName, err))
@@ -260,7 +354,9 @@ func (r *IndexerFilelistResource) Update(ctx context.Context, req resource.Updat
 
 	tflog.Trace(ctx, "updated "+indexerFilelistResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, indexer.Tags, indexer.SkipDefaultTags)
 	indexer.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &indexer.TagLabels, indexer.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -286,7 +382,7 @@ func (r *IndexerFilelistResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *IndexerFilelistResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	importIndexerState(ctx, r.auth, r.client, indexerFilelistImplementation, req, resp)
 	tflog.Trace(ctx, "imported "+indexerFilelistResourceName+": "+req.ID)
 }
 
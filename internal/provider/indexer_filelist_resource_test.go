@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 func TestAccIndexerFilelistResource(t *testing.T) {
@@ -25,6 +26,7 @@ func TestAccIndexerFilelistResource(t *testing.T) {
 				Config: testAccIndexerFilelistResourceConfig("filelistResourceTest", "user"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("lidarr_indexer_filelist.test", "username", "user"),
+					resource.TestCheckResourceAttr("lidarr_indexer_filelist.test", "seed_ratio", "0.5"),
 					resource.TestCheckResourceAttrSet("lidarr_indexer_filelist.test", "id"),
 				),
 			},
@@ -47,6 +49,23 @@ func TestAccIndexerFilelistResource(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"passkey"},
 			},
+			// ImportState by name testing
+			{
+				ResourceName:            "lidarr_indexer_filelist.test",
+				ImportState:             true,
+				ImportStateId:           "name:filelistResourceTest",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"passkey"},
+			},
+			// Replace testing
+			{
+				Config: testAccIndexerFilelistResourceReplaceConfig("filelistResourceTest"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("lidarr_indexer_filelist.test", plancheck.ResourceActionReplace),
+					},
+				},
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -62,5 +81,19 @@ func testAccIndexerFilelistResourceConfig(name, username string) string {
 		passkey = "Pass"
 		categories = [4,6,1]
 		minimum_seeders = 1
+		seed_ratio = 0.5
 	}`, name, username)
 }
+
+func testAccIndexerFilelistResourceReplaceConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "lidarr_indexer_filelist" "test" {
+		enable_automatic_search = false
+		name = "%s"
+		base_url = "https://filelist.ro"
+		username = "Username"
+		passkey = "Pass"
+		categories = [4,6,1]
+		minimum_seeders = 1
+	}`, name)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -41,6 +42,8 @@ type IndexerGazelleResource struct {
 
 // IndexerGazelle describes the Gazelle indexer data model.
 type IndexerGazelle struct {
+	TagLabels
+	ExtraFields             types.Map     `tfsdk:"extra_fields"`
 	SeedRatio               types.Float64 `tfsdk:"seed_ratio"`
 	Tags                    types.Set     `tfsdk:"tags"`
 	Name                    types.String  `tfsdk:"name"`
@@ -56,11 +59,14 @@ type IndexerGazelle struct {
 	EnableAutomaticSearch   types.Bool    `tfsdk:"enable_automatic_search"`
 	UseFreeleechToken       types.Bool    `tfsdk:"use_freeleech_token"`
 	EnableRss               types.Bool    `tfsdk:"enable_rss"`
+	TestOnCreate            types.Bool    `tfsdk:"test_on_create"`
 	EnableInteractiveSearch types.Bool    `tfsdk:"enable_interactive_search"`
+	SkipDefaultTags         types.Bool    `tfsdk:"skip_default_tags"`
 }
 
 func (i IndexerGazelle) toIndexer() *Indexer {
 	return &Indexer{
+		Fields:                  i.ExtraFields,
 		EnableAutomaticSearch:   i.EnableAutomaticSearch,
 		EnableInteractiveSearch: i.EnableInteractiveSearch,
 		EnableRss:               i.EnableRss,
@@ -100,6 +106,10 @@ func (i *IndexerGazelle) fromIndexer(indexer *Indexer) {
 	i.Password = indexer.Password
 	i.BaseURL = indexer.BaseURL
 	i.Tags = indexer.Tags
+
+	if !i.ExtraFields.IsNull() {
+		i.ExtraFields = indexer.Fields
+	}
 }
 
 func (r *IndexerGazelleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -110,6 +120,7 @@ func (r *IndexerGazelleResource) Schema(_ context.Context, _ resource.SchemaRequ
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->\nIndexer Gazelle resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/lidarr/settings#indexers) and [Gazelle](https://wiki.servarr.com/lidarr/supported#gazelle).",
 		Attributes: map[string]schema.Attribute{
+			"extra_fields": helpers.ExtraFieldsAttribute(),
 			"enable_automatic_search": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic search flag.",
 				Optional:            true,
@@ -134,12 +145,21 @@ func (r *IndexerGazelleResource) Schema(_ context.Context, _ resource.SchemaRequ
 				MarkdownDescription: "IndexerGazelle name.",
 				Required:            true,
 			},
+			"test_on_create": schema.BoolAttribute{
+				MarkdownDescription: "Run the Lidarr indexer test before creating it. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"tags": schema.SetAttribute{
 				MarkdownDescription: "List of associated tags.",
 				Optional:            true,
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels":          tagLabelsAttribute(),
+			"create_missing_tags": createMissingTagsAttribute(),
+			"skip_default_tags":   skipDefaultTagsAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "IndexerGazelle ID.",
 				Computed:            true,
@@ -213,9 +233,30 @@ func (r *IndexerGazelleResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, indexer.TagLabels, &indexer.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new IndexerGazelle
 	request := indexer.read(ctx, &resp.Diagnostics)
 
+	if !typeIndexerFields(r.auth, r.client, request, indexerGazelleResourceName, &resp.Diagnostics, indexer.ExtraFields) {
+		return
+	}
+
+	applyDefaultTags(r.auth, request, indexer.SkipDefaultTags)
+
+	if !resolver.validate(indexerGazelleResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	if indexer.TestOnCreate.ValueBool() && !testIndexer(r.auth, r.client, request, indexerGazelleResourceName, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerGazelleResourceName, err))
@@ -223,9 +264,13 @@ func (r *IndexerGazelleResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	response = helpers.ReadAfterCreate(ctx, response, indexerGazelleResourceName, r.client.IndexerAPI.GetIndexerById(r.auth, response.GetId()).Execute, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+indexerGazelleResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, indexer.Tags, indexer.SkipDefaultTags)
 	indexer.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &indexer.TagLabels, indexer.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -240,8 +285,15 @@ func (r *IndexerGazelleResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	// Get IndexerGazelle current value
-	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			resp.Diagnostics.AddWarning(helpers.ResourceNotFound, helpers.ParseResourceNotFound(indexerGazelleResourceName, indexer.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerGazelleResourceName, err))
 
 		return
@@ -249,7 +301,11 @@ func (r *IndexerGazelleResource) Read(ctx context.Context, req resource.ReadRequ
 
 	tflog.Trace(ctx, "read "+indexerGazelleResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	removeDefaultTags(ctx, r.auth, response, indexer.Tags, indexer.SkipDefaultTags)
 	indexer.write(ctx, response, &resp.Diagnostics)
+	newTagResolver(r.auth, r.client).write(ctx, &indexer.TagLabels, indexer.Tags, &resp.Diagnostics)
+	// Test flag is not stored by Lidarr, keep the state value or use the default on import
+	indexer.TestOnCreate = types.BoolValue(indexer.TestOnCreate.ValueBool())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -263,9 +319,27 @@ func (r *IndexerGazelleResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, indexer.TagLabels, &indexer.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update IndexerGazelle
 	request := indexer.read(ctx, &resp.Diagnostics)
 
+	if !typeIndexerFields(r.auth, r.client, request, indexerGazelleResourceName, &resp.Diagnostics, indexer.ExtraFields) {
+		return
+	}
+
+	preserveIndexerFields(r.auth, r.client, request)
+	applyDefaultTags(r.auth, request, indexer.SkipDefaultTags)
+
+	if !resolver.validate(indexerGazelleResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, request.GetId()).IndexerResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerGazelleResourceName, err))
@@ -275,7 +349,9 @@ func (r *IndexerGazelleResource) Update(ctx context.Context, req resource.Update
 
 	tflog.Trace(ctx, "updated "+indexerGazelleResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, indexer.Tags, indexer.SkipDefaultTags)
 	indexer.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &indexer.TagLabels, indexer.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -301,7 +377,7 @@ func (r *IndexerGazelleResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *IndexerGazelleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	importIndexerState(ctx, r.auth, r.client, indexerGazelleImplementation, req, resp)
 	tflog.Trace(ctx, "imported "+indexerGazelleResourceName+": "+req.ID)
 }
 
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -41,6 +42,8 @@ type IndexerHeadphonesResource struct {
 
 // IndexerHeadphones describes the Headphones indexer data model.
 type IndexerHeadphones struct {
+	TagLabels
+	ExtraFields             types.Map    `tfsdk:"extra_fields"`
 	Tags                    types.Set    `tfsdk:"tags"`
 	Categories              types.Set    `tfsdk:"categories"`
 	Name                    types.String `tfsdk:"name"`
@@ -51,11 +54,14 @@ type IndexerHeadphones struct {
 	EarlyReleaseLimit       types.Int64  `tfsdk:"early_release_limit"`
 	EnableAutomaticSearch   types.Bool   `tfsdk:"enable_automatic_search"`
 	EnableRss               types.Bool   `tfsdk:"enable_rss"`
+	TestOnCreate            types.Bool   `tfsdk:"test_on_create"`
 	EnableInteractiveSearch types.Bool   `tfsdk:"enable_interactive_search"`
+	SkipDefaultTags         types.Bool   `tfsdk:"skip_default_tags"`
 }
 
 func (i IndexerHeadphones) toIndexer() *Indexer {
 	return &Indexer{
+		Fields:                  i.ExtraFields,
 		EnableAutomaticSearch:   i.EnableAutomaticSearch,
 		EnableInteractiveSearch: i.EnableInteractiveSearch,
 		EnableRss:               i.EnableRss,
@@ -85,6 +91,10 @@ func (i *IndexerHeadphones) fromIndexer(indexer *Indexer) {
 	i.Password = indexer.Password
 	i.Categories = indexer.Categories
 	i.Tags = indexer.Tags
+
+	if !i.ExtraFields.IsNull() {
+		i.ExtraFields = indexer.Fields
+	}
 }
 
 func (r *IndexerHeadphonesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -95,6 +105,7 @@ func (r *IndexerHeadphonesResource) Schema(_ context.Context, _ resource.SchemaR
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->\nIndexer Headphones resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/lidarr/settings#indexers) and [Headphones](https://wiki.servarr.com/lidarr/supported#headphones).",
 		Attributes: map[string]schema.Attribute{
+			"extra_fields": helpers.ExtraFieldsAttribute(),
 			"enable_automatic_search": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic search flag.",
 				Optional:            true,
@@ -119,12 +130,21 @@ func (r *IndexerHeadphonesResource) Schema(_ context.Context, _ resource.SchemaR
 				MarkdownDescription: "IndexerHeadphones name.",
 				Required:            true,
 			},
+			"test_on_create": schema.BoolAttribute{
+				MarkdownDescription: "Run the Lidarr indexer test before creating it. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"tags": schema.SetAttribute{
 				MarkdownDescription: "List of associated tags.",
 				Optional:            true,
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels":          tagLabelsAttribute(),
+			"create_missing_tags": createMissingTagsAttribute(),
+			"skip_default_tags":   skipDefaultTagsAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "IndexerHeadphones ID.",
 				Computed:            true,
@@ -173,9 +193,30 @@ func (r *IndexerHeadphonesResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, indexer.TagLabels, &indexer.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new IndexerHeadphones
 	request := indexer.read(ctx, &resp.Diagnostics)
 
+	if !typeIndexerFields(r.auth, r.client, request, indexerHeadphonesResourceName, &resp.Diagnostics, indexer.ExtraFields) {
+		return
+	}
+
+	applyDefaultTags(r.auth, request, indexer.SkipDefaultTags)
+
+	if !resolver.validate(indexerHeadphonesResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	if indexer.TestOnCreate.ValueBool() && !testIndexer(r.auth, r.client, request, indexerHeadphonesResourceName, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerHeadphonesResourceName, err))
@@ -183,9 +224,13 @@ func (r *IndexerHeadphonesResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	response = helpers.ReadAfterCreate(ctx, response, indexerHeadphonesResourceName, r.client.IndexerAPI.GetIndexerById(r.auth, response.GetId()).Execute, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+indexerHeadphonesResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, indexer.Tags, indexer.SkipDefaultTags)
 	indexer.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &indexer.TagLabels, indexer.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -200,8 +245,15 @@ func (r *IndexerHeadphonesResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	// Get IndexerHeadphones current value
-	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			resp.Diagnostics.AddWarning(helpers.ResourceNotFound, helpers.ParseResourceNotFound(indexerHeadphonesResourceName, indexer.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerHeadphonesResourceName, err))
 
 		return
@@ -209,7 +261,11 @@ func (r *IndexerHeadphonesResource) Read(ctx context.Context, req resource.ReadR
 
 	tflog.Trace(ctx, "read "+indexerHeadphonesResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	removeDefaultTags(ctx, r.auth, response, indexer.Tags, indexer.SkipDefaultTags)
 	indexer.write(ctx, response, &resp.Diagnostics)
+	newTagResolver(r.auth, r.client).write(ctx, &indexer.TagLabels, indexer.Tags, &resp.Diagnostics)
+	// Test flag is not stored by Lidarr, keep the state value or use the default on import
+	indexer.TestOnCreate = types.BoolValue(indexer.TestOnCreate.ValueBool())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -223,9 +279,27 @@ func (r *IndexerHeadphonesResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, indexer.TagLabels, &indexer.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update IndexerHeadphones
 	request := indexer.read(ctx, &resp.Diagnostics)
 
+	if !typeIndexerFields(r.auth, r.client, request, indexerHeadphonesResourceName, &resp.Diagnostics, indexer.ExtraFields) {
+		return
+	}
+
+	preserveIndexerFields(r.auth, r.client, request)
+	applyDefaultTags(r.auth, request, indexer.SkipDefaultTags)
+
+	if !resolver.validate(indexerHeadphonesResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, request.GetId()).IndexerResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerHeadphonesResourceName, err))
@@ -235,7 +309,9 @@ func (r *IndexerHeadphonesResource) Update(ctx context.Context, req resource.Upd
 
 	tflog.Trace(ctx, "updated "+indexerHeadphonesResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, indexer.Tags, indexer.SkipDefaultTags)
 	indexer.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &indexer.TagLabels, indexer.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -261,7 +337,7 @@ func (r *IndexerHeadphonesResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *IndexerHeadphonesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	importIndexerState(ctx, r.auth, r.client, indexerHeadphonesImplementation, req, resp)
 	tflog.Trace(ctx, "imported "+indexerHeadphonesResourceName+": "+req.ID)
 }
 
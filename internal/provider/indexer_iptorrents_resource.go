@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -41,19 +42,24 @@ type IndexerIptorrentsResource struct {
 
 // IndexerIptorrents describes the Iptorrents indexer data model.
 type IndexerIptorrents struct {
-	SeedRatio      types.Float64 `tfsdk:"seed_ratio"`
-	Tags           types.Set     `tfsdk:"tags"`
-	Name           types.String  `tfsdk:"name"`
-	BaseURL        types.String  `tfsdk:"base_url"`
-	Priority       types.Int64   `tfsdk:"priority"`
-	ID             types.Int64   `tfsdk:"id"`
-	MinimumSeeders types.Int64   `tfsdk:"minimum_seeders"`
-	SeedTime       types.Int64   `tfsdk:"seed_time"`
-	EnableRss      types.Bool    `tfsdk:"enable_rss"`
+	TagLabels
+	ExtraFields     types.Map     `tfsdk:"extra_fields"`
+	SeedRatio       types.Float64 `tfsdk:"seed_ratio"`
+	Tags            types.Set     `tfsdk:"tags"`
+	Name            types.String  `tfsdk:"name"`
+	BaseURL         types.String  `tfsdk:"base_url"`
+	Priority        types.Int64   `tfsdk:"priority"`
+	ID              types.Int64   `tfsdk:"id"`
+	MinimumSeeders  types.Int64   `tfsdk:"minimum_seeders"`
+	SeedTime        types.Int64   `tfsdk:"seed_time"`
+	EnableRss       types.Bool    `tfsdk:"enable_rss"`
+	TestOnCreate    types.Bool    `tfsdk:"test_on_create"`
+	SkipDefaultTags types.Bool    `tfsdk:"skip_default_tags"`
 }
 
 func (i IndexerIptorrents) toIndexer() *Indexer {
 	return &Indexer{
+		Fields:         i.ExtraFields,
 		EnableRss:      i.EnableRss,
 		Priority:       i.Priority,
 		ID:             i.ID,
@@ -79,6 +85,10 @@ func (i *IndexerIptorrents) fromIndexer(indexer *Indexer) {
 	i.SeedRatio = indexer.SeedRatio
 	i.BaseURL = indexer.BaseURL
 	i.Tags = indexer.Tags
+
+	if !i.ExtraFields.IsNull() {
+		i.ExtraFields = indexer.Fields
+	}
 }
 
 func (r *IndexerIptorrentsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -89,6 +99,7 @@ func (r *IndexerIptorrentsResource) Schema(_ context.Context, _ resource.SchemaR
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->\nIndexer IP Torrents resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/lidarr/settings#indexers) and [IP Torrents](https://wiki.servarr.com/lidarr/supported#iptorrents).",
 		Attributes: map[string]schema.Attribute{
+			"extra_fields": helpers.ExtraFieldsAttribute(),
 			"enable_rss": schema.BoolAttribute{
 				MarkdownDescription: "Enable RSS flag.",
 				Optional:            true,
@@ -103,12 +114,21 @@ func (r *IndexerIptorrentsResource) Schema(_ context.Context, _ resource.SchemaR
 				MarkdownDescription: "IndexerIptorrents name.",
 				Required:            true,
 			},
+			"test_on_create": schema.BoolAttribute{
+				MarkdownDescription: "Run the Lidarr indexer test before creating it. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"tags": schema.SetAttribute{
 				MarkdownDescription: "List of associated tags.",
 				Optional:            true,
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels":          tagLabelsAttribute(),
+			"create_missing_tags": createMissingTagsAttribute(),
+			"skip_default_tags":   skipDefaultTagsAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "IndexerIptorrents ID.",
 				Computed:            true,
@@ -157,9 +177,30 @@ func (r *IndexerIptorrentsResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, indexer.TagLabels, &indexer.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new IndexerIptorrents
 	request := indexer.read(ctx, &resp.Diagnostics)
 
+	if !typeIndexerFields(r.auth, r.client, request, indexerIptorrentsResourceName, &resp.Diagnostics, indexer.ExtraFields) {
+		return
+	}
+
+	applyDefaultTags(r.auth, request, indexer.SkipDefaultTags)
+
+	if !resolver.validate(indexerIptorrentsResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	if indexer.TestOnCreate.ValueBool() && !testIndexer(r.auth, r.client, request, indexerIptorrentsResourceName, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerIptorrentsResourceName, err))
@@ -167,9 +208,13 @@ func (r *IndexerIptorrentsResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	response = helpers.ReadAfterCreate(ctx, response, indexerIptorrentsResourceName, r.client.IndexerAPI.GetIndexerById(r.auth, response.GetId()).Execute, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+indexerIptorrentsResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, indexer.Tags, indexer.SkipDefaultTags)
 	indexer.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &indexer.TagLabels, indexer.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -184,8 +229,15 @@ func (r *IndexerIptorrentsResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	// Get IndexerIptorrents current value
-	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			resp.Diagnostics.AddWarning(helpers.ResourceNotFound, helpers.ParseResourceNotFound(indexerIptorrentsResourceName, indexer.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerIptorrentsResourceName, err))
 
 		return
@@ -193,7 +245,11 @@ func (r *IndexerIptorrentsResource) Read(ctx context.Context, req resource.ReadR
 
 	tflog.Trace(ctx, "read "+indexerIptorrentsResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	removeDefaultTags(ctx, r.auth, response, indexer.Tags, indexer.SkipDefaultTags)
 	indexer.write(ctx, response, &resp.Diagnostics)
+	newTagResolver(r.auth, r.client).write(ctx, &indexer.TagLabels, indexer.Tags, &resp.Diagnostics)
+	// Test flag is not stored by Lidarr, keep the state value or use the default on import
+	indexer.TestOnCreate = types.BoolValue(indexer.TestOnCreate.ValueBool())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -207,9 +263,27 @@ func (r *IndexerIptorrentsResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, indexer.TagLabels, &indexer.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update IndexerIptorrents
 	request := indexer.read(ctx, &resp.Diagnostics)
 
+	if !typeIndexerFields(r.auth, r.client, request, indexerIptorrentsResourceName, &resp.Diagnostics, indexer.ExtraFields) {
+		return
+	}
+
+	preserveIndexerFields(r.auth, r.client, request)
+	applyDefaultTags(r.auth, request, indexer.SkipDefaultTags)
+
+	if !resolver.validate(indexerIptorrentsResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, request.GetId()).IndexerResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerIptorrentsResourceName, err))
@@ -219,7 +293,9 @@ func (r *IndexerIptorrentsResource) Update(ctx context.Context, req resource.Upd
 
 	tflog.Trace(ctx, "updated "+indexerIptorrentsResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, indexer.Tags, indexer.SkipDefaultTags)
 	indexer.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &indexer.TagLabels, indexer.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -245,7 +321,7 @@ func (r *IndexerIptorrentsResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *IndexerIptorrentsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	importIndexerState(ctx, r.auth, r.client, indexerIptorrentsImplementation, req, resp)
 	tflog.Trace(ctx, "imported "+indexerIptorrentsResourceName+": "+req.ID)
 }
 
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -41,6 +42,8 @@ type IndexerNewznabResource struct {
 
 // IndexerNewznab describes the Newznab indexer data model.
 type IndexerNewznab struct {
+	TagLabels
+	ExtraFields             types.Map    `tfsdk:"extra_fields"`
 	Tags                    types.Set    `tfsdk:"tags"`
 	Categories              types.Set    `tfsdk:"categories"`
 	AdditionalParameters    types.String `tfsdk:"additional_parameters"`
@@ -51,12 +54,16 @@ type IndexerNewznab struct {
 	ID                      types.Int64  `tfsdk:"id"`
 	Priority                types.Int64  `tfsdk:"priority"`
 	EnableRss               types.Bool   `tfsdk:"enable_rss"`
+	TestOnCreate            types.Bool   `tfsdk:"test_on_create"`
+	ValidateCategories      types.Bool   `tfsdk:"validate_categories"`
 	EnableInteractiveSearch types.Bool   `tfsdk:"enable_interactive_search"`
 	EnableAutomaticSearch   types.Bool   `tfsdk:"enable_automatic_search"`
+	SkipDefaultTags         types.Bool   `tfsdk:"skip_default_tags"`
 }
 
 func (i IndexerNewznab) toIndexer() *Indexer {
 	return &Indexer{
+		Fields:                  i.ExtraFields,
 		EnableAutomaticSearch:   i.EnableAutomaticSearch,
 		EnableInteractiveSearch: i.EnableInteractiveSearch,
 		EnableRss:               i.EnableRss,
@@ -65,7 +72,7 @@ func (i IndexerNewznab) toIndexer() *Indexer {
 		Name:                    i.Name,
 		AdditionalParameters:    i.AdditionalParameters,
 		APIKey:                  i.APIKey,
-		APIPath:                 i.APIKey,
+		APIPath:                 i.APIPath,
 		BaseURL:                 i.BaseURL,
 		Categories:              i.Categories,
 		Tags:                    i.Tags,
@@ -88,6 +95,10 @@ func (i *IndexerNewznab) fromIndexer(indexer *Indexer) {
 	i.BaseURL = indexer.BaseURL
 	i.Categories = indexer.Categories
 	i.Tags = indexer.Tags
+
+	if !i.ExtraFields.IsNull() {
+		i.ExtraFields = indexer.Fields
+	}
 }
 
 func (r *IndexerNewznabResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -98,6 +109,7 @@ func (r *IndexerNewznabResource) Schema(_ context.Context, _ resource.SchemaRequ
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->\nIndexer Newznab resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/lidarr/settings#indexers) and [Newznab](https://wiki.servarr.com/lidarr/supported#newznab).",
 		Attributes: map[string]schema.Attribute{
+			"extra_fields": helpers.ExtraFieldsAttribute(),
 			"enable_automatic_search": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic search flag.",
 				Optional:            true,
@@ -122,12 +134,27 @@ func (r *IndexerNewznabResource) Schema(_ context.Context, _ resource.SchemaRequ
 				MarkdownDescription: "IndexerNewznab name.",
 				Required:            true,
 			},
+			"test_on_create": schema.BoolAttribute{
+				MarkdownDescription: "Run the Lidarr indexer test before creating it. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"validate_categories": schema.BoolAttribute{
+				MarkdownDescription: "Check the categories against the ones advertised by the indexer before creating or updating it. Requires Lidarr to reach the indexer. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"tags": schema.SetAttribute{
 				MarkdownDescription: "List of associated tags.",
 				Optional:            true,
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels":          tagLabelsAttribute(),
+			"create_missing_tags": createMissingTagsAttribute(),
+			"skip_default_tags":   skipDefaultTagsAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "IndexerNewznab ID.",
 				Computed:            true,
@@ -157,7 +184,7 @@ func (r *IndexerNewznabResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Computed:            true,
 			},
 			"categories": schema.SetAttribute{
-				MarkdownDescription: "Series list.",
+				MarkdownDescription: "Categories list.",
 				Optional:            true,
 				Computed:            true,
 				ElementType:         types.Int64Type,
@@ -183,9 +210,34 @@ func (r *IndexerNewznabResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, indexer.TagLabels, &indexer.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new IndexerNewznab
 	request := indexer.read(ctx, &resp.Diagnostics)
 
+	if !typeIndexerFields(r.auth, r.client, request, indexerNewznabResourceName, &resp.Diagnostics, indexer.ExtraFields) {
+		return
+	}
+
+	applyDefaultTags(r.auth, request, indexer.SkipDefaultTags)
+
+	if !resolver.validate(indexerNewznabResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	if indexer.ValidateCategories.ValueBool() && !validateIndexerCategories(ctx, r.auth, r.client, request, indexer.Categories, indexerNewznabResourceName, &resp.Diagnostics) {
+		return
+	}
+
+	if indexer.TestOnCreate.ValueBool() && !testIndexer(r.auth, r.client, request, indexerNewznabResourceName, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerNewznabResourceName, err))
@@ -193,9 +245,13 @@ func (r *IndexerNewznabResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	response = helpers.ReadAfterCreate(ctx, response, indexerNewznabResourceName, r.client.IndexerAPI.GetIndexerById(r.auth, response.GetId()).Execute, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+indexerNewznabResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, indexer.Tags, indexer.SkipDefaultTags)
 	indexer.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &indexer.TagLabels, indexer.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -210,8 +266,15 @@ func (r *IndexerNewznabResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	// Get IndexerNewznab current value
-	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			resp.Diagnostics.AddWarning(helpers.ResourceNotFound, helpers.ParseResourceNotFound(indexerNewznabResourceName, indexer.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerNewznabResourceName, err))
 
 		return
@@ -219,7 +282,12 @@ func (r *IndexerNewznabResource) Read(ctx context.Context, req resource.ReadRequ
 
 	tflog.Trace(ctx, "read "+indexerNewznabResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	removeDefaultTags(ctx, r.auth, response, indexer.Tags, indexer.SkipDefaultTags)
 	indexer.write(ctx, response, &resp.Diagnostics)
+	newTagResolver(r.auth, r.client).write(ctx, &indexer.TagLabels, indexer.Tags, &resp.Diagnostics)
+	// Test flags are not stored by Lidarr, keep the state value or use the default on import
+	indexer.TestOnCreate = types.BoolValue(indexer.TestOnCreate.ValueBool())
+	indexer.ValidateCategories = types.BoolValue(indexer.ValidateCategories.ValueBool())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -233,9 +301,31 @@ func (r *IndexerNewznabResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, indexer.TagLabels, &indexer.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update IndexerNewznab
 	request := indexer.read(ctx, &resp.Diagnostics)
 
+	if !typeIndexerFields(r.auth, r.client, request, indexerNewznabResourceName, &resp.Diagnostics, indexer.ExtraFields) {
+		return
+	}
+
+	preserveIndexerFields(r.auth, r.client, request)
+	applyDefaultTags(r.auth, request, indexer.SkipDefaultTags)
+
+	if !resolver.validate(indexerNewznabResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	if indexer.ValidateCategories.ValueBool() && !validateIndexerCategories(ctx, r.auth, r.client, request, indexer.Categories, indexerNewznabResourceName, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, request.GetId()).IndexerResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerNewznabResourceName, err))
@@ -245,7 +335,9 @@ func (r *IndexerNewznabResource) Update(ctx context.Context, req resource.Update
 
 	tflog.Trace(ctx, "updated "+indexerNewznabResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, indexer.Tags, indexer.SkipDefaultTags)
 	indexer.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &indexer.TagLabels, indexer.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -271,7 +363,7 @@ func (r *IndexerNewznabResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *IndexerNewznabResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	importIndexerState(ctx, r.auth, r.client, indexerNewznabImplementation, req, resp)
 	tflog.Trace(ctx, "imported "+indexerNewznabResourceName+": "+req.ID)
 }
 
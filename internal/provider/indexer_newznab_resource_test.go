@@ -15,6 +15,16 @@ func TestAccIndexerNewznabResource(t *testing.T) {
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
+			// Failing test on create
+			{
+				Config:      testAccIndexerNewznabResourceTestConfig("newzabResourceTest"),
+				ExpectError: regexp.MustCompile("Unable to validate"),
+			},
+			// Invalid categories
+			{
+				Config:      testAccIndexerNewznabResourceCategoriesConfig("newzabResourceTest"),
+				ExpectError: regexp.MustCompile("not advertised by the indexer"),
+			},
 			// Unauthorized Create
 			{
 				Config:      testAccIndexerNewznabResourceConfig("newzabResourceTest", "25") + testUnauthorizedProvider,
@@ -62,3 +72,26 @@ func testAccIndexerNewznabResourceConfig(name, aSearch string) string {
 		categories = [5030, 5040]
 	}`, aSearch, name)
 }
+
+func testAccIndexerNewznabResourceTestConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "lidarr_indexer_newznab" "test" {
+		enable_rss = true
+		name = "%s"
+		base_url = "http://unreachable-newznab"
+		api_path = "/api"
+		categories = [5030, 5040]
+		test_on_create = true
+	}`, name)
+}
+
+func testAccIndexerNewznabResourceCategoriesConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "lidarr_indexer_newznab" "test" {
+		name = "%s"
+		base_url = "https://lolo.sickbeard.com"
+		api_path = "/api"
+		categories = [3000, 99999]
+		validate_categories = true
+	}`, name)
+}
@@ -25,6 +25,8 @@ func TestAccIndexerNyaaResource(t *testing.T) {
 				Config: testAccIndexerNyaaResourceConfig("nyaaResourceTest", "https://nyaa.org"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("lidarr_indexer_nyaa.test", "base_url", "https://nyaa.org"),
+					resource.TestCheckResourceAttr("lidarr_indexer_nyaa.test", "additional_parameters", "&cats=3_0"),
+					resource.TestCheckResourceAttr("lidarr_indexer_nyaa.test", "discography_seed_time", "10"),
 					resource.TestCheckResourceAttrSet("lidarr_indexer_nyaa.test", "id"),
 				),
 			},
@@ -57,6 +59,9 @@ func testAccIndexerNyaaResourceConfig(name, url string) string {
 		enable_automatic_search = false
 		name = "%s"
 		base_url = "%s"
+		additional_parameters = "&cats=3_0"
 		minimum_seeders = 1
+		seed_time = 5
+		discography_seed_time = 10
 	}`, name, url)
 }
@@ -0,0 +1,377 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	indexerOrpheusResourceName   = "indexer_orpheus"
+	indexerOrpheusImplementation = "Orpheus"
+	indexerOrpheusConfigContract = "OrpheusSettings"
+	indexerOrpheusProtocol       = "torrent"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &IndexerOrpheusResource{}
+	_ resource.ResourceWithImportState = &IndexerOrpheusResource{}
+)
+
+func NewIndexerOrpheusResource() resource.Resource {
+	return &IndexerOrpheusResource{}
+}
+
+// IndexerOrpheusResource defines the Orpheus indexer implementation.
+type IndexerOrpheusResource struct {
+	client *lidarr.APIClient
+	auth   context.Context
+}
+
+// IndexerOrpheus describes the Orpheus indexer data model.
+type IndexerOrpheus struct {
+	TagLabels
+	ExtraFields             types.Map     `tfsdk:"extra_fields"`
+	SeedRatio               types.Float64 `tfsdk:"seed_ratio"`
+	Tags                    types.Set     `tfsdk:"tags"`
+	Name                    types.String  `tfsdk:"name"`
+	APIKey                  types.String  `tfsdk:"api_key"`
+	Priority                types.Int64   `tfsdk:"priority"`
+	MinimumSeeders          types.Int64   `tfsdk:"minimum_seeders"`
+	EarlyReleaseLimit       types.Int64   `tfsdk:"early_release_limit"`
+	SeedTime                types.Int64   `tfsdk:"seed_time"`
+	DiscographySeedTime     types.Int64   `tfsdk:"discography_seed_time"`
+	ID                      types.Int64   `tfsdk:"id"`
+	EnableAutomaticSearch   types.Bool    `tfsdk:"enable_automatic_search"`
+	UseFreeleechToken       types.Bool    `tfsdk:"use_freeleech_token"`
+	EnableRss               types.Bool    `tfsdk:"enable_rss"`
+	TestOnCreate            types.Bool    `tfsdk:"test_on_create"`
+	EnableInteractiveSearch types.Bool    `tfsdk:"enable_interactive_search"`
+	SkipDefaultTags         types.Bool    `tfsdk:"skip_default_tags"`
+}
+
+func (i IndexerOrpheus) toIndexer() *Indexer {
+	return &Indexer{
+		Fields:                  i.ExtraFields,
+		EnableAutomaticSearch:   i.EnableAutomaticSearch,
+		EnableInteractiveSearch: i.EnableInteractiveSearch,
+		EnableRss:               i.EnableRss,
+		Priority:                i.Priority,
+		ID:                      i.ID,
+		Name:                    i.Name,
+		UseFreeleechToken:       i.UseFreeleechToken,
+		MinimumSeeders:          i.MinimumSeeders,
+		EarlyReleaseLimit:       i.EarlyReleaseLimit,
+		SeedTime:                i.SeedTime,
+		DiscographySeedTime:     i.DiscographySeedTime,
+		SeedRatio:               i.SeedRatio,
+		APIKey:                  i.APIKey,
+		Tags:                    i.Tags,
+		Implementation:          types.StringValue(indexerOrpheusImplementation),
+		ConfigContract:          types.StringValue(indexerOrpheusConfigContract),
+		Protocol:                types.StringValue(indexerOrpheusProtocol),
+	}
+}
+
+func (i *IndexerOrpheus) fromIndexer(indexer *Indexer) {
+	i.EnableAutomaticSearch = indexer.EnableAutomaticSearch
+	i.EnableInteractiveSearch = indexer.EnableInteractiveSearch
+	i.EnableRss = indexer.EnableRss
+	i.Priority = indexer.Priority
+	i.ID = indexer.ID
+	i.Name = indexer.Name
+	i.UseFreeleechToken = indexer.UseFreeleechToken
+	i.EarlyReleaseLimit = indexer.EarlyReleaseLimit
+	i.MinimumSeeders = indexer.MinimumSeeders
+	i.SeedTime = indexer.SeedTime
+	i.DiscographySeedTime = indexer.DiscographySeedTime
+	i.SeedRatio = indexer.SeedRatio
+	i.APIKey = indexer.APIKey
+	i.Tags = indexer.Tags
+
+	if !i.ExtraFields.IsNull() {
+		i.ExtraFields = indexer.Fields
+	}
+}
+
+func (r *IndexerOrpheusResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + indexerOrpheusResourceName
+}
+
+func (r *IndexerOrpheusResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Indexers -->\nIndexer Orpheus resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/lidarr/settings#indexers) and [Orpheus](https://wiki.servarr.com/lidarr/supported#orpheus).",
+		Attributes: map[string]schema.Attribute{
+			"extra_fields": helpers.ExtraFieldsAttribute(),
+			"enable_automatic_search": schema.BoolAttribute{
+				MarkdownDescription: "Enable automatic search flag.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"enable_interactive_search": schema.BoolAttribute{
+				MarkdownDescription: "Enable interactive search flag.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"enable_rss": schema.BoolAttribute{
+				MarkdownDescription: "Enable RSS flag.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"priority": schema.Int64Attribute{
+				MarkdownDescription: "Priority.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "IndexerOrpheus name.",
+				Required:            true,
+			},
+			"test_on_create": schema.BoolAttribute{
+				MarkdownDescription: "Run the Lidarr indexer test before creating it. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "List of associated tags.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"tag_labels":          tagLabelsAttribute(),
+			"create_missing_tags": createMissingTagsAttribute(),
+			"skip_default_tags":   skipDefaultTagsAttribute(),
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "IndexerOrpheus ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			// Field values
+			"use_freeleech_token": schema.BoolAttribute{
+				MarkdownDescription: "Use freeleech token flag.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"minimum_seeders": schema.Int64Attribute{
+				MarkdownDescription: "Minimum seeders.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"early_release_limit": schema.Int64Attribute{
+				MarkdownDescription: "Early release limit.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"seed_time": schema.Int64Attribute{
+				MarkdownDescription: "Seed time.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"discography_seed_time": schema.Int64Attribute{
+				MarkdownDescription: "Discography seed time.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"seed_ratio": schema.Float64Attribute{
+				MarkdownDescription: "Seed ratio.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"api_key": schema.StringAttribute{
+				MarkdownDescription: "API key.",
+				Required:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *IndexerOrpheusResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+		r.auth = auth
+	}
+}
+
+func (r *IndexerOrpheusResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var indexer *IndexerOrpheus
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &indexer)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, indexer.TagLabels, &indexer.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new IndexerOrpheus
+	request := indexer.read(ctx, &resp.Diagnostics)
+
+	if !typeIndexerFields(r.auth, r.client, request, indexerOrpheusResourceName, &resp.Diagnostics, indexer.ExtraFields) {
+		return
+	}
+
+	applyDefaultTags(r.auth, request, indexer.SkipDefaultTags)
+
+	if !resolver.validate(indexerOrpheusResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	if indexer.TestOnCreate.ValueBool() && !testIndexer(r.auth, r.client, request, indexerOrpheusResourceName, &resp.Diagnostics) {
+		return
+	}
+
+	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerOrpheusResourceName, err))
+
+		return
+	}
+
+	response = helpers.ReadAfterCreate(ctx, response, indexerOrpheusResourceName, r.client.IndexerAPI.GetIndexerById(r.auth, response.GetId()).Execute, &resp.Diagnostics)
+
+	tflog.Trace(ctx, "created "+indexerOrpheusResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, indexer.Tags, indexer.SkipDefaultTags)
+	indexer.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &indexer.TagLabels, indexer.Tags, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+}
+
+func (r *IndexerOrpheusResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var indexer *IndexerOrpheus
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &indexer)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get IndexerOrpheus current value
+	response, httpResp, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
+	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			resp.Diagnostics.AddWarning(helpers.ResourceNotFound, helpers.ParseResourceNotFound(indexerOrpheusResourceName, indexer.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerOrpheusResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+indexerOrpheusResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Map response body to resource schema attribute
+	removeDefaultTags(ctx, r.auth, response, indexer.Tags, indexer.SkipDefaultTags)
+	indexer.write(ctx, response, &resp.Diagnostics)
+	newTagResolver(r.auth, r.client).write(ctx, &indexer.TagLabels, indexer.Tags, &resp.Diagnostics)
+	// Test flag is not stored by Lidarr, keep the state value or use the default on import
+	indexer.TestOnCreate = types.BoolValue(indexer.TestOnCreate.ValueBool())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+}
+
+func (r *IndexerOrpheusResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var indexer *IndexerOrpheus
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &indexer)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, indexer.TagLabels, &indexer.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update IndexerOrpheus
+	request := indexer.read(ctx, &resp.Diagnostics)
+
+	if !typeIndexerFields(r.auth, r.client, request, indexerOrpheusResourceName, &resp.Diagnostics, indexer.ExtraFields) {
+		return
+	}
+
+	preserveIndexerFields(r.auth, r.client, request)
+	applyDefaultTags(r.auth, request, indexer.SkipDefaultTags)
+
+	if !resolver.validate(indexerOrpheusResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, request.GetId()).IndexerResource(*request).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerOrpheusResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+indexerOrpheusResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, indexer.Tags, indexer.SkipDefaultTags)
+	indexer.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &indexer.TagLabels, indexer.Tags, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+}
+
+func (r *IndexerOrpheusResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var ID int64
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete IndexerOrpheus current value
+	_, err := r.client.IndexerAPI.DeleteIndexer(r.auth, int32(ID)).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, indexerOrpheusResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "deleted "+indexerOrpheusResourceName+": "+strconv.Itoa(int(ID)))
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *IndexerOrpheusResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importIndexerState(ctx, r.auth, r.client, indexerOrpheusImplementation, req, resp)
+	tflog.Trace(ctx, "imported "+indexerOrpheusResourceName+": "+req.ID)
+}
+
+func (i *IndexerOrpheus) write(ctx context.Context, indexer *lidarr.IndexerResource, diags *diag.Diagnostics) {
+	genericIndexer := i.toIndexer()
+	genericIndexer.write(ctx, indexer, diags)
+	i.fromIndexer(genericIndexer)
+}
+
+func (i *IndexerOrpheus) read(ctx context.Context, diags *diag.Diagnostics) *lidarr.IndexerResource {
+	return i.toIndexer().read(ctx, diags)
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIndexerOrpheusResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccIndexerOrpheusResourceConfig("orpheusResourceTest", "Key1", "false") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccIndexerOrpheusResourceConfig("orpheusResourceTest", "Key1", "false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lidarr_indexer_orpheus.test", "api_key", "Key1"),
+					resource.TestCheckResourceAttr("lidarr_indexer_orpheus.test", "use_freeleech_token", "false"),
+					resource.TestCheckResourceAttrSet("lidarr_indexer_orpheus.test", "id"),
+				),
+			},
+			// Unauthorized Read
+			{
+				Config:      testAccIndexerOrpheusResourceConfig("orpheusResourceTest", "Key1", "false") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccIndexerOrpheusResourceConfig("orpheusResourceTest", "Key2", "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lidarr_indexer_orpheus.test", "api_key", "Key2"),
+					resource.TestCheckResourceAttr("lidarr_indexer_orpheus.test", "use_freeleech_token", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "lidarr_indexer_orpheus.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"api_key"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccIndexerOrpheusResourceConfig(name, user, freeleech string) string {
+	return fmt.Sprintf(`
+	resource "lidarr_indexer_orpheus" "test" {
+		enable_automatic_search = false
+		name = "%s"
+		api_key = "%s"
+		use_freeleech_token = %s
+		minimum_seeders = 1
+	}`, name, user, freeleech)
+}
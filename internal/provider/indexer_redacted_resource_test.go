@@ -17,27 +17,29 @@ func TestAccIndexerRedactedResource(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Unauthorized Create
 			{
-				Config:      testAccIndexerRedactedResourceConfig("redactedResourceTest", "Key1") + testUnauthorizedProvider,
+				Config:      testAccIndexerRedactedResourceConfig("redactedResourceTest", "Key1", "false") + testUnauthorizedProvider,
 				ExpectError: regexp.MustCompile("Client Error"),
 			},
 			// Create and Read testing
 			{
-				Config: testAccIndexerRedactedResourceConfig("redactedResourceTest", "Key1"),
+				Config: testAccIndexerRedactedResourceConfig("redactedResourceTest", "Key1", "false"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("lidarr_indexer_redacted.test", "api_key", "Key1"),
+					resource.TestCheckResourceAttr("lidarr_indexer_redacted.test", "use_freeleech_token", "false"),
 					resource.TestCheckResourceAttrSet("lidarr_indexer_redacted.test", "id"),
 				),
 			},
 			// Unauthorized Read
 			{
-				Config:      testAccIndexerRedactedResourceConfig("redactedResourceTest", "Key1") + testUnauthorizedProvider,
+				Config:      testAccIndexerRedactedResourceConfig("redactedResourceTest", "Key1", "false") + testUnauthorizedProvider,
 				ExpectError: regexp.MustCompile("Client Error"),
 			},
 			// Update and Read testing
 			{
-				Config: testAccIndexerRedactedResourceConfig("redactedResourceTest", "Key2"),
+				Config: testAccIndexerRedactedResourceConfig("redactedResourceTest", "Key2", "true"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("lidarr_indexer_redacted.test", "api_key", "Key2"),
+					resource.TestCheckResourceAttr("lidarr_indexer_redacted.test", "use_freeleech_token", "true"),
 				),
 			},
 			// ImportState testing
@@ -52,13 +54,13 @@ func TestAccIndexerRedactedResource(t *testing.T) {
 	})
 }
 
-func testAccIndexerRedactedResourceConfig(name, user string) string {
+func testAccIndexerRedactedResourceConfig(name, user, freeleech string) string {
 	return fmt.Sprintf(`
 	resource "lidarr_indexer_redacted" "test" {
 		enable_automatic_search = false
 		name = "%s"
 		api_key = "%s"
-		use_freeleech_token = false
+		use_freeleech_token = %s
 		minimum_seeders = 1
-	}`, name, user)
+	}`, name, user, freeleech)
 }
@@ -2,7 +2,11 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/devopsarr/lidarr-go/lidarr"
 	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
@@ -12,8 +16,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -47,11 +53,21 @@ type IndexerResource struct {
 	auth   context.Context
 }
 
+// IndexerResourceData describes the indexer resource data model.
+// It extends the indexer data model with attributes not stored by Lidarr.
+type IndexerResourceData struct {
+	Indexer
+	TagLabels
+	TestOnCreate    types.Bool `tfsdk:"test_on_create"`
+	SkipDefaultTags types.Bool `tfsdk:"skip_default_tags"`
+}
+
 // Indexer describes the indexer data model.
 type Indexer struct {
 	SeedRatio               types.Float64 `tfsdk:"seed_ratio"`
 	Tags                    types.Set     `tfsdk:"tags"`
 	Categories              types.Set     `tfsdk:"categories"`
+	Fields                  types.Map     `tfsdk:"fields"`
 	Password                types.String  `tfsdk:"password"`
 	UserID                  types.String  `tfsdk:"user_id"`
 	Passkey                 types.String  `tfsdk:"passkey"`
@@ -88,6 +104,7 @@ func (i Indexer) getType() attr.Type {
 		map[string]attr.Type{
 			"tags":                      types.SetType{}.WithElementType(types.Int64Type),
 			"categories":                types.SetType{}.WithElementType(types.Int64Type),
+			"fields":                    types.MapType{}.WithElementType(types.StringType),
 			"api_user":                  types.StringType,
 			"additional_parameters":     types.StringType,
 			"name":                      types.StringType,
@@ -152,10 +169,16 @@ func (r *IndexerResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 			"config_contract": schema.StringAttribute{
 				MarkdownDescription: "Indexer configuration template.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"implementation": schema.StringAttribute{
 				MarkdownDescription: "Indexer implementation name.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Indexer name.",
@@ -167,6 +190,15 @@ func (r *IndexerResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Validators: []validator.String{
 					stringvalidator.OneOf("usenet", "torrent"),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"test_on_create": schema.BoolAttribute{
+				MarkdownDescription: "Run the Lidarr indexer test before creating it. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
 			},
 			"tags": schema.SetAttribute{
 				MarkdownDescription: "List of associated tags.",
@@ -174,6 +206,9 @@ func (r *IndexerResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels":          tagLabelsAttribute(),
+			"create_missing_tags": createMissingTagsAttribute(),
+			"skip_default_tags":   skipDefaultTagsAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Indexer ID.",
 				Computed:            true,
@@ -192,6 +227,15 @@ func (r *IndexerResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Optional:            true,
 				Computed:            true,
 			},
+			"fields": schema.MapAttribute{
+				MarkdownDescription: "Raw field values for settings not covered by other attributes, keyed by API field name. Values are converted to the type of the Lidarr field, lists are written as JSON.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Map{
+					helpers.UnmanagedFields(),
+				},
+			},
 			"use_freeleech_token": schema.BoolAttribute{
 				MarkdownDescription: "Use freeleech token flag.",
 				Optional:            true,
@@ -309,7 +353,7 @@ func (r *IndexerResource) Configure(ctx context.Context, req resource.ConfigureR
 
 func (r *IndexerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var indexer *Indexer
+	var indexer *IndexerResourceData
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &indexer)...)
 
@@ -317,9 +361,30 @@ func (r *IndexerResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, indexer.TagLabels, &indexer.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new Indexer
 	request := indexer.read(ctx, &resp.Diagnostics)
 
+	if !typeIndexerFields(r.auth, r.client, request, indexerResourceName, &resp.Diagnostics, indexer.Fields) {
+		return
+	}
+
+	applyDefaultTags(r.auth, request, indexer.SkipDefaultTags)
+
+	if !resolver.validate(indexerResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	if indexer.TestOnCreate.ValueBool() && !testIndexer(r.auth, r.client, request, indexerResourceName, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerResourceName, err))
@@ -327,19 +392,25 @@ func (r *IndexerResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	response = helpers.ReadAfterCreate(ctx, response, indexerResourceName, r.client.IndexerAPI.GetIndexerById(r.auth, response.GetId()).Execute, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+indexerResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct.
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Indexer
+	var state IndexerResourceData
 
 	state.writeSensitive(indexer)
+	removeDefaultTags(ctx, r.auth, response, indexer.Tags, indexer.SkipDefaultTags)
 	state.write(ctx, response, &resp.Diagnostics)
+	state.TagLabels = indexer.TagLabels
+	state.SkipDefaultTags = indexer.SkipDefaultTags
+	resolver.write(ctx, &state.TagLabels, state.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *IndexerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var indexer *Indexer
+	var indexer *IndexerResourceData
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &indexer)...)
 
@@ -348,8 +419,15 @@ func (r *IndexerResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	// Get Indexer current value
-	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			resp.Diagnostics.AddWarning(helpers.ResourceNotFound, helpers.ParseResourceNotFound(indexerResourceName, indexer.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerResourceName, err))
 
 		return
@@ -358,16 +436,20 @@ func (r *IndexerResource) Read(ctx context.Context, req resource.ReadRequest, re
 	tflog.Trace(ctx, "read "+indexerResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct.
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Indexer
+	var state IndexerResourceData
 
 	state.writeSensitive(indexer)
+	removeDefaultTags(ctx, r.auth, response, indexer.Tags, indexer.SkipDefaultTags)
 	state.write(ctx, response, &resp.Diagnostics)
+	state.TagLabels = indexer.TagLabels
+	state.SkipDefaultTags = indexer.SkipDefaultTags
+	newTagResolver(r.auth, r.client).write(ctx, &state.TagLabels, state.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *IndexerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var indexer *Indexer
+	var indexer *IndexerResourceData
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &indexer)...)
 
@@ -375,9 +457,26 @@ func (r *IndexerResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, indexer.TagLabels, &indexer.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update Indexer
 	request := indexer.read(ctx, &resp.Diagnostics)
 
+	if !typeIndexerFields(r.auth, r.client, request, indexerResourceName, &resp.Diagnostics, indexer.Fields) {
+		return
+	}
+
+	applyDefaultTags(r.auth, request, indexer.SkipDefaultTags)
+
+	if !resolver.validate(indexerResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, request.GetId()).IndexerResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerResourceName, err))
@@ -388,10 +487,14 @@ func (r *IndexerResource) Update(ctx context.Context, req resource.UpdateRequest
 	tflog.Trace(ctx, "updated "+indexerResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct.
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Indexer
+	var state IndexerResourceData
 
 	state.writeSensitive(indexer)
+	removeDefaultTags(ctx, r.auth, response, indexer.Tags, indexer.SkipDefaultTags)
 	state.write(ctx, response, &resp.Diagnostics)
+	state.TagLabels = indexer.TagLabels
+	state.SkipDefaultTags = indexer.SkipDefaultTags
+	resolver.write(ctx, &state.TagLabels, state.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -417,7 +520,7 @@ func (r *IndexerResource) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *IndexerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	importIndexerState(ctx, r.auth, r.client, "", req, resp)
 	tflog.Trace(ctx, "imported "+indexerResourceName+": "+req.ID)
 }
 
@@ -438,6 +541,7 @@ func (i *Indexer) write(ctx context.Context, indexer *lidarr.IndexerResource, di
 	i.Protocol = types.StringValue(string(indexer.GetProtocol()))
 	i.Categories = types.SetValueMust(types.Int64Type, nil)
 	helpers.WriteFields(ctx, i, indexer.GetFields(), indexerFields)
+	i.Fields = helpers.WriteMapFields(ctx, indexer.GetFields(), indexerFields, i.Fields)
 }
 
 func (i *Indexer) read(ctx context.Context, diags *diag.Diagnostics) *lidarr.IndexerResource {
@@ -452,13 +556,16 @@ func (i *Indexer) read(ctx context.Context, diags *diag.Diagnostics) *lidarr.Ind
 	indexer.SetName(i.Name.ValueString())
 	indexer.SetProtocol(lidarr.DownloadProtocol(i.Protocol.ValueString()))
 	diags.Append(i.Tags.ElementsAs(ctx, &indexer.Tags, true)...)
-	indexer.SetFields(helpers.ReadFields(ctx, i, indexerFields))
+	indexer.SetFields(helpers.MergeFields(helpers.ReadFields(ctx, i, indexerFields), helpers.ReadMapFields(ctx, i.Fields)))
 
 	return indexer
 }
 
-// writeSensitive copy sensitive data from another resource.
-func (i *Indexer) writeSensitive(indexer *Indexer) {
+// writeSensitive copy sensitive and test data from another resource.
+func (i *IndexerResourceData) writeSensitive(indexer *IndexerResourceData) {
+	// Test flag is not stored by Lidarr, keep the state value or use the default on import
+	i.TestOnCreate = types.BoolValue(indexer.TestOnCreate.ValueBool())
+
 	if !indexer.Passkey.IsUnknown() {
 		i.Passkey = indexer.Passkey
 	}
@@ -470,4 +577,144 @@ func (i *Indexer) writeSensitive(indexer *Indexer) {
 	if !indexer.APIKey.IsUnknown() {
 		i.APIKey = indexer.APIKey
 	}
+
+	if !indexer.Fields.IsUnknown() {
+		i.Fields = indexer.Fields
+	}
+}
+
+// importIndexerState imports an indexer by ID or, with format name:<name>, by its unique name.
+// When implementation is set, only indexers of that implementation are matched.
+func importIndexerState(ctx context.Context, auth context.Context, client *lidarr.APIClient, implementation string, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	name, found := strings.CutPrefix(req.ID, "name:")
+	if !found {
+		helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+
+		return
+	}
+
+	response, _, err := client.IndexerAPI.ListIndexer(auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, indexerResourceName, err))
+
+		return
+	}
+
+	var ids []int32
+
+	for _, i := range response {
+		if i.GetName() != name {
+			continue
+		}
+
+		if implementation != "" && i.GetImplementation() != implementation {
+			resp.Diagnostics.AddError(helpers.UnexpectedImportIdentifier,
+				fmt.Sprintf("Indexer '%s' has implementation '%s', expected '%s'", name, i.GetImplementation(), implementation))
+
+			return
+		}
+
+		ids = append(ids, i.GetId())
+	}
+
+	helpers.ImportStateByName(ctx, path.Root("id"), indexerResourceName, name, ids, resp)
+}
+
+// preserveIndexerFields keeps the current indexer settings not managed by the provider, so that typed resources do not reset them on update.
+func preserveIndexerFields(auth context.Context, client *lidarr.APIClient, request *lidarr.IndexerResource) {
+	current, _, err := client.IndexerAPI.GetIndexerById(auth, request.GetId()).Execute()
+	if err != nil {
+		// Errors are reported by the update itself
+		return
+	}
+
+	request.SetFields(helpers.PreserveFields(request.GetFields(), current.GetFields(), indexerFields))
+}
+
+// testIndexer runs the Lidarr indexer test and reports any validation failure.
+func testIndexer(auth context.Context, client *lidarr.APIClient, request *lidarr.IndexerResource, name string, diags *diag.Diagnostics) bool {
+	_, err := client.IndexerAPI.TestIndexer(auth).IndexerResource(*request).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseValidationError(helpers.Validate, name, err))
+
+		return false
+	}
+
+	return true
+}
+
+// indexerCategory describes a category advertised by a newznab compatible indexer.
+type indexerCategory struct {
+	Name          string            `json:"name"`
+	SubCategories []indexerCategory `json:"subCategories"`
+	Value         int64             `json:"value"`
+}
+
+// validateIndexerCategories checks the configured categories against the ones advertised by a newznab compatible indexer.
+// Categories left to Lidarr, unset or unknown until apply, are not checked.
+func validateIndexerCategories(ctx context.Context, auth context.Context, client *lidarr.APIClient, request *lidarr.IndexerResource, categories types.Set, name string, diags *diag.Diagnostics) bool {
+	if categories.IsNull() || categories.IsUnknown() {
+		return true
+	}
+
+	response, err := client.IndexerAPI.CreateIndexerActionByName(auth, "newznabCategories").IndexerResource(*request).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Validate, name, err))
+
+		return false
+	}
+
+	defer response.Body.Close()
+
+	var body struct {
+		Options []indexerCategory `json:"options"`
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&body); err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Validate, name, err))
+
+		return false
+	}
+
+	// Flatten the advertised categories.
+	available := make(map[int64]string)
+	options := body.Options
+
+	for len(options) > 0 {
+		option := options[0]
+		options = append(options[1:], option.SubCategories...)
+		available[option.Value] = option.Name
+	}
+
+	configured := make([]int64, 0, len(categories.Elements()))
+	diags.Append(categories.ElementsAs(ctx, &configured, false)...)
+
+	invalid := make([]string, 0)
+
+	for _, c := range configured {
+		if _, ok := available[c]; !ok {
+			invalid = append(invalid, strconv.Itoa(int(c)))
+		}
+	}
+
+	if len(invalid) == 0 {
+		return true
+	}
+
+	music := make([]string, 0)
+
+	for value, categoryName := range available {
+		if value >= 3000 && value < 4000 {
+			music = append(music, fmt.Sprintf("%d (%s)", value, categoryName))
+		}
+	}
+
+	slices.Sort(music)
+	diags.AddAttributeError(
+		path.Root("categories"),
+		helpers.ResourceError,
+		fmt.Sprintf("Categories %s are not advertised by the indexer. Valid music categories: %s", strings.Join(invalid, ", "), strings.Join(music, ", ")),
+	)
+
+	return false
 }
@@ -1,16 +1,26 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
 
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccIndexerResource(t *testing.T) {
 	t.Parallel()
 
+	var id int32
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -39,6 +49,25 @@ func TestAccIndexerResource(t *testing.T) {
 				Config: testAccIndexerResourceConfig("resourceTest", 30),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("lidarr_indexer.test", "priority", "30"),
+					testAccResourceID("lidarr_indexer.test", &id),
+				),
+			},
+			// Out of band delete testing
+			{
+				PreConfig: func() {
+					if _, err := testAccAPIClient().IndexerAPI.DeleteIndexer(context.Background(), id).Execute(); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:             testAccIndexerResourceConfig("resourceTest", 30),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Recreate testing
+			{
+				Config: testAccIndexerResourceConfig("resourceTest", 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("lidarr_indexer.test", "id"),
 				),
 			},
 			// ImportState testing
@@ -47,6 +76,29 @@ func TestAccIndexerResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// ImportState by name testing
+			{
+				ResourceName:      "lidarr_indexer.test",
+				ImportState:       true,
+				ImportStateId:     "name:resourceTest",
+				ImportStateVerify: true,
+			},
+			// ImportState by missing name testing
+			{
+				ResourceName:  "lidarr_indexer.test",
+				ImportState:   true,
+				ImportStateId: "name:missingResourceTest",
+				ExpectError:   regexp.MustCompile("No indexer found"),
+			},
+			// Replace testing
+			{
+				Config: testAccIndexerResourceReplaceConfig("resourceTest"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("lidarr_indexer.test", plancheck.ResourceActionReplace),
+					},
+				},
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -65,3 +117,153 @@ func testAccIndexerResourceConfig(name string, priority int) string {
 		categories = [8000, 5000]
 	}`, priority, name)
 }
+
+func TestAccIndexerResourceFields(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Managed field testing
+			{
+				Config:      testAccIndexerResourceFieldsConfig("resourceFieldsTest", "/api", `fields = { "apiPath" = "/api" }`),
+				ExpectError: regexp.MustCompile("Conflicting Extra Field"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccIndexerResourceFieldsConfig("resourceFieldsTest", "/api", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("lidarr_indexer.test", "fields.apiPath"),
+					resource.TestCheckResourceAttr("lidarr_indexer.test", "api_path", "/api"),
+					resource.TestCheckResourceAttr("lidarr_indexer.test", "categories.#", "1"),
+					resource.TestCheckResourceAttrSet("lidarr_indexer.test", "id"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccIndexerResourceFieldsConfig("resourceFieldsTest", "/newznab", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lidarr_indexer.test", "api_path", "/newznab"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "lidarr_indexer.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccIndexerResourceFieldsConfig(name, path, fields string) string {
+	return fmt.Sprintf(`
+	resource "lidarr_indexer" "test" {
+		enable_automatic_search = false
+		name = "%s"
+		implementation = "Newznab"
+		protocol = "usenet"
+		config_contract = "NewznabSettings"
+		base_url = "https://lolo.sickbeard.com"
+		api_path = "%s"
+		categories = [5000]
+		%s
+	}`, name, path, fields)
+}
+
+func testAccIndexerResourceReplaceConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "lidarr_indexer" "test" {
+		priority = 1
+		name = "%s"
+		implementation = "Torznab"
+		protocol = "torrent"
+		config_contract = "TorznabSettings"
+		base_url = "https://lolo.sickbeard.com"
+		api_path = "/api"
+		categories = [8000, 5000]
+		minimum_seeders = 1
+	}`, name)
+}
+
+func TestValidateIndexerCategoriesUnset(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]types.Set{
+		"null":    types.SetNull(types.Int64Type),
+		"unknown": types.SetUnknown(types.Int64Type),
+	}
+	for name, categories := range tests {
+		categories := categories
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+
+			// no client, Lidarr must not be queried
+			ok := validateIndexerCategories(context.Background(), context.Background(), nil, lidarr.NewIndexerResource(), categories, indexerNewznabResourceName, &diags)
+			assert.True(t, ok)
+			assert.False(t, diags.HasError())
+		})
+	}
+}
+
+func TestImportIndexerStateByName(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		resource fwresource.Resource
+		id       string
+		err      string
+	}{
+		"matching implementation": {
+			resource: NewIndexerNewznabResource(),
+			id:       "name:Newznab Indexer",
+		},
+		"generic resource": {
+			resource: NewIndexerResource(),
+			id:       "name:Newznab Indexer",
+		},
+		"other implementation": {
+			resource: NewIndexerTorznabResource(),
+			id:       "name:Newznab Indexer",
+			err:      "has implementation 'Newznab', expected 'Torznab'",
+		},
+		"missing name": {
+			resource: NewIndexerNewznabResource(),
+			id:       "name:Missing Indexer",
+			err:      "No indexer found",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data, _ := testFixtureProvider(t, map[string]string{
+				"GET /api/v1/indexer": "indexers.json",
+			})
+
+			state := testResourceState(t, test.resource, data)
+			resp := fwresource.ImportStateResponse{State: state}
+			test.resource.(fwresource.ResourceWithImportState).ImportState(context.Background(), fwresource.ImportStateRequest{ID: test.id}, &resp)
+
+			if test.err != "" {
+				assert.True(t, resp.Diagnostics.HasError())
+				assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), test.err)
+
+				return
+			}
+
+			var id types.Int64
+
+			assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			assert.False(t, resp.State.GetAttribute(context.Background(), path.Root("id"), &id).HasError())
+			assert.Equal(t, int64(1), id.ValueInt64())
+		})
+	}
+}
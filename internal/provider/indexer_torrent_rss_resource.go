@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -41,35 +42,42 @@ type IndexerTorrentRssResource struct {
 
 // IndexerTorrentRss describes the TorrentRss indexer data model.
 type IndexerTorrentRss struct {
-	SeedRatio      types.Float64 `tfsdk:"seed_ratio"`
-	Tags           types.Set     `tfsdk:"tags"`
-	Name           types.String  `tfsdk:"name"`
-	BaseURL        types.String  `tfsdk:"base_url"`
-	Cookie         types.String  `tfsdk:"cookie"`
-	Priority       types.Int64   `tfsdk:"priority"`
-	ID             types.Int64   `tfsdk:"id"`
-	MinimumSeeders types.Int64   `tfsdk:"minimum_seeders"`
-	SeedTime       types.Int64   `tfsdk:"seed_time"`
-	AllowZeroSize  types.Bool    `tfsdk:"allow_zero_size"`
-	EnableRss      types.Bool    `tfsdk:"enable_rss"`
+	TagLabels
+	ExtraFields         types.Map     `tfsdk:"extra_fields"`
+	SeedRatio           types.Float64 `tfsdk:"seed_ratio"`
+	Tags                types.Set     `tfsdk:"tags"`
+	Name                types.String  `tfsdk:"name"`
+	BaseURL             types.String  `tfsdk:"base_url"`
+	Cookie              types.String  `tfsdk:"cookie"`
+	Priority            types.Int64   `tfsdk:"priority"`
+	ID                  types.Int64   `tfsdk:"id"`
+	MinimumSeeders      types.Int64   `tfsdk:"minimum_seeders"`
+	SeedTime            types.Int64   `tfsdk:"seed_time"`
+	DiscographySeedTime types.Int64   `tfsdk:"discography_seed_time"`
+	AllowZeroSize       types.Bool    `tfsdk:"allow_zero_size"`
+	EnableRss           types.Bool    `tfsdk:"enable_rss"`
+	TestOnCreate        types.Bool    `tfsdk:"test_on_create"`
+	SkipDefaultTags     types.Bool    `tfsdk:"skip_default_tags"`
 }
 
 func (i IndexerTorrentRss) toIndexer() *Indexer {
 	return &Indexer{
-		EnableRss:      i.EnableRss,
-		AllowZeroSize:  i.AllowZeroSize,
-		Priority:       i.Priority,
-		ID:             i.ID,
-		Name:           i.Name,
-		Cookie:         i.Cookie,
-		MinimumSeeders: i.MinimumSeeders,
-		SeedTime:       i.SeedTime,
-		SeedRatio:      i.SeedRatio,
-		BaseURL:        i.BaseURL,
-		Tags:           i.Tags,
-		Implementation: types.StringValue(indexerTorrentRssImplementation),
-		ConfigContract: types.StringValue(indexerTorrentRssConfigContract),
-		Protocol:       types.StringValue(indexerTorrentRssProtocol),
+		Fields:              i.ExtraFields,
+		EnableRss:           i.EnableRss,
+		AllowZeroSize:       i.AllowZeroSize,
+		Priority:            i.Priority,
+		ID:                  i.ID,
+		Name:                i.Name,
+		Cookie:              i.Cookie,
+		MinimumSeeders:      i.MinimumSeeders,
+		SeedTime:            i.SeedTime,
+		DiscographySeedTime: i.DiscographySeedTime,
+		SeedRatio:           i.SeedRatio,
+		BaseURL:             i.BaseURL,
+		Tags:                i.Tags,
+		Implementation:      types.StringValue(indexerTorrentRssImplementation),
+		ConfigContract:      types.StringValue(indexerTorrentRssConfigContract),
+		Protocol:            types.StringValue(indexerTorrentRssProtocol),
 	}
 }
 
@@ -82,9 +90,14 @@ func (i *IndexerTorrentRss) fromIndexer(indexer *Indexer) {
 	i.Cookie = indexer.Cookie
 	i.MinimumSeeders = indexer.MinimumSeeders
 	i.SeedTime = indexer.SeedTime
+	i.DiscographySeedTime = indexer.DiscographySeedTime
 	i.SeedRatio = indexer.SeedRatio
 	i.BaseURL = indexer.BaseURL
 	i.Tags = indexer.Tags
+
+	if !i.ExtraFields.IsNull() {
+		i.ExtraFields = indexer.Fields
+	}
 }
 
 func (r *IndexerTorrentRssResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -95,6 +108,7 @@ func (r *IndexerTorrentRssResource) Schema(_ context.Context, _ resource.SchemaR
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->\nIndexer Torrent RSS resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/lidarr/settings#indexers) and [Torrent RSS](https://wiki.servarr.com/lidarr/supported#torrentrssindexer).",
 		Attributes: map[string]schema.Attribute{
+			"extra_fields": helpers.ExtraFieldsAttribute(),
 			"enable_rss": schema.BoolAttribute{
 				MarkdownDescription: "Enable RSS flag.",
 				Optional:            true,
@@ -109,12 +123,21 @@ func (r *IndexerTorrentRssResource) Schema(_ context.Context, _ resource.SchemaR
 				MarkdownDescription: "IndexerTorrentRss name.",
 				Required:            true,
 			},
+			"test_on_create": schema.BoolAttribute{
+				MarkdownDescription: "Run the Lidarr indexer test before creating it. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"tags": schema.SetAttribute{
 				MarkdownDescription: "List of associated tags.",
 				Optional:            true,
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels":          tagLabelsAttribute(),
+			"create_missing_tags": createMissingTagsAttribute(),
+			"skip_default_tags":   skipDefaultTagsAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "IndexerTorrentRss ID.",
 				Computed:            true,
@@ -138,6 +161,11 @@ func (r *IndexerTorrentRssResource) Schema(_ context.Context, _ resource.SchemaR
 				Optional:            true,
 				Computed:            true,
 			},
+			"discography_seed_time": schema.Int64Attribute{
+				MarkdownDescription: "Discography seed time.",
+				Optional:            true,
+				Computed:            true,
+			},
 			"seed_ratio": schema.Float64Attribute{
 				MarkdownDescription: "Seed ratio.",
 				Optional:            true,
@@ -151,6 +179,7 @@ func (r *IndexerTorrentRssResource) Schema(_ context.Context, _ resource.SchemaR
 				MarkdownDescription: "Cookie.",
 				Optional:            true,
 				Computed:            true,
+				Sensitive:           true,
 			},
 		},
 	}
@@ -173,9 +202,30 @@ func (r *IndexerTorrentRssResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, indexer.TagLabels, &indexer.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new IndexerTorrentRss
 	request := indexer.read(ctx, &resp.Diagnostics)
 
+	if !typeIndexerFields(r.auth, r.client, request, indexerTorrentRssResourceName, &resp.Diagnostics, indexer.ExtraFields) {
+		return
+	}
+
+	applyDefaultTags(r.auth, request, indexer.SkipDefaultTags)
+
+	if !resolver.validate(indexerTorrentRssResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	if indexer.TestOnCreate.ValueBool() && !testIndexer(r.auth, r.client, request, indexerTorrentRssResourceName, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerTorrentRssResourceName, err))
@@ -183,9 +233,13 @@ func (r *IndexerTorrentRssResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	response = helpers.ReadAfterCreate(ctx, response, indexerTorrentRssResourceName, r.client.IndexerAPI.GetIndexerById(r.auth, response.GetId()).Execute, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+indexerTorrentRssResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, indexer.Tags, indexer.SkipDefaultTags)
 	indexer.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &indexer.TagLabels, indexer.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -200,8 +254,15 @@ func (r *IndexerTorrentRssResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	// Get IndexerTorrentRss current value
-	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			resp.Diagnostics.AddWarning(helpers.ResourceNotFound, helpers.ParseResourceNotFound(indexerTorrentRssResourceName, indexer.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerTorrentRssResourceName, err))
 
 		return
@@ -209,7 +270,11 @@ func (r *IndexerTorrentRssResource) Read(ctx context.Context, req resource.ReadR
 
 	tflog.Trace(ctx, "read "+indexerTorrentRssResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	removeDefaultTags(ctx, r.auth, response, indexer.Tags, indexer.SkipDefaultTags)
 	indexer.write(ctx, response, &resp.Diagnostics)
+	newTagResolver(r.auth, r.client).write(ctx, &indexer.TagLabels, indexer.Tags, &resp.Diagnostics)
+	// Test flag is not stored by Lidarr, keep the state value or use the default on import
+	indexer.TestOnCreate = types.BoolValue(indexer.TestOnCreate.ValueBool())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -223,9 +288,27 @@ func (r *IndexerTorrentRssResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, indexer.TagLabels, &indexer.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update IndexerTorrentRss
 	request := indexer.read(ctx, &resp.Diagnostics)
 
+	if !typeIndexerFields(r.auth, r.client, request, indexerTorrentRssResourceName, &resp.Diagnostics, indexer.ExtraFields) {
+		return
+	}
+
+	preserveIndexerFields(r.auth, r.client, request)
+	applyDefaultTags(r.auth, request, indexer.SkipDefaultTags)
+
+	if !resolver.validate(indexerTorrentRssResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, request.GetId()).IndexerResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerTorrentRssResourceName, err))
@@ -235,7 +318,9 @@ func (r *IndexerTorrentRssResource) Update(ctx context.Context, req resource.Upd
 
 	tflog.Trace(ctx, "updated "+indexerTorrentRssResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, indexer.Tags, indexer.SkipDefaultTags)
 	indexer.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &indexer.TagLabels, indexer.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
 }
 
@@ -261,7 +346,7 @@ func (r *IndexerTorrentRssResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *IndexerTorrentRssResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	importIndexerState(ctx, r.auth, r.client, indexerTorrentRssImplementation, req, resp)
 	tflog.Trace(ctx, "imported "+indexerTorrentRssResourceName+": "+req.ID)
 }
 
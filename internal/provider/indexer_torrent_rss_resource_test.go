@@ -17,27 +17,30 @@ func TestAccIndexerTorrentRssResource(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Unauthorized Create
 			{
-				Config:      testAccIndexerTorrentRssResourceConfig("rssResourceTest", "https://rss.org") + testUnauthorizedProvider,
+				Config:      testAccIndexerTorrentRssResourceConfig("rssResourceTest", "https://rss.org", "session=1") + testUnauthorizedProvider,
 				ExpectError: regexp.MustCompile("Client Error"),
 			},
 			// Create and Read testing
 			{
-				Config: testAccIndexerTorrentRssResourceConfig("rssResourceTest", "https://rss.org"),
+				Config: testAccIndexerTorrentRssResourceConfig("rssResourceTest", "https://rss.org", "session=1"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("lidarr_indexer_torrent_rss.test", "base_url", "https://rss.org"),
+					resource.TestCheckResourceAttr("lidarr_indexer_torrent_rss.test", "cookie", "session=1"),
+					resource.TestCheckResourceAttr("lidarr_indexer_torrent_rss.test", "discography_seed_time", "10"),
 					resource.TestCheckResourceAttrSet("lidarr_indexer_torrent_rss.test", "id"),
 				),
 			},
 			// Unauthorized Read
 			{
-				Config:      testAccIndexerTorrentRssResourceConfig("rssResourceTest", "https://rss.org") + testUnauthorizedProvider,
+				Config:      testAccIndexerTorrentRssResourceConfig("rssResourceTest", "https://rss.org", "session=1") + testUnauthorizedProvider,
 				ExpectError: regexp.MustCompile("Client Error"),
 			},
 			// Update and Read testing
 			{
-				Config: testAccIndexerTorrentRssResourceConfig("rssResourceTest", "https://rss.net"),
+				Config: testAccIndexerTorrentRssResourceConfig("rssResourceTest", "https://rss.net", "session=2"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("lidarr_indexer_torrent_rss.test", "base_url", "https://rss.net"),
+					resource.TestCheckResourceAttr("lidarr_indexer_torrent_rss.test", "cookie", "session=2"),
 				),
 			},
 			// ImportState testing
@@ -51,7 +54,7 @@ func TestAccIndexerTorrentRssResource(t *testing.T) {
 	})
 }
 
-func testAccIndexerTorrentRssResourceConfig(name, url string) string {
+func testAccIndexerTorrentRssResourceConfig(name, url, cookie string) string {
 	return fmt.Sprintf(`
 	resource "lidarr_indexer_torrent_rss" "test" {
 		enable_rss = false
@@ -59,5 +62,7 @@ func testAccIndexerTorrentRssResourceConfig(name, url string) string {
 		base_url = "%s"
 		allow_zero_size = true
 		minimum_seeders = 1
-	}`, name, url)
+		discography_seed_time = 10
+		cookie = "%s"
+	}`, name, url, cookie)
 }
@@ -6,8 +6,10 @@ import (
 
 	"github.com/devopsarr/lidarr-go/lidarr"
 	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -29,8 +31,11 @@ type IndexersDataSource struct {
 
 // Indexers describes the indexers data model.
 type Indexers struct {
-	Indexers types.Set    `tfsdk:"indexers"`
-	ID       types.String `tfsdk:"id"`
+	Indexers              types.Set    `tfsdk:"indexers"`
+	Protocol              types.String `tfsdk:"protocol"`
+	ID                    types.String `tfsdk:"id"`
+	EnableRss             types.Bool   `tfsdk:"enable_rss"`
+	EnableAutomaticSearch types.Bool   `tfsdk:"enable_automatic_search"`
 }
 
 func (d *IndexersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -46,6 +51,21 @@ func (d *IndexersDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 			"id": schema.StringAttribute{
 				Computed: true,
 			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "Filter by protocol. Valid values are 'usenet' and 'torrent'.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("usenet", "torrent"),
+				},
+			},
+			"enable_rss": schema.BoolAttribute{
+				MarkdownDescription: "Filter by enable RSS flag.",
+				Optional:            true,
+			},
+			"enable_automatic_search": schema.BoolAttribute{
+				MarkdownDescription: "Filter by enable automatic search flag.",
+				Optional:            true,
+			},
 			"indexers": schema.SetNestedAttribute{
 				MarkdownDescription: "Indexer list.",
 				Computed:            true,
@@ -101,6 +121,11 @@ func (d *IndexersDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 							MarkdownDescription: "Allow ranked only.",
 							Computed:            true,
 						},
+						"fields": schema.MapAttribute{
+							MarkdownDescription: "Raw field values for settings not covered by other attributes, keyed by API field name.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
 						"use_freeleech_token": schema.BoolAttribute{
 							MarkdownDescription: "Use freeleech token flag.",
 							Computed:            true,
@@ -136,6 +161,7 @@ func (d *IndexersDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 						"api_key": schema.StringAttribute{
 							MarkdownDescription: "API key.",
 							Computed:            true,
+							Sensitive:           true,
 						},
 						"api_user": schema.StringAttribute{
 							MarkdownDescription: "API User.",
@@ -152,6 +178,7 @@ func (d *IndexersDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 						"rss_passkey": schema.StringAttribute{
 							MarkdownDescription: "RSS passkey.",
 							Computed:            true,
+							Sensitive:           true,
 						},
 						"base_url": schema.StringAttribute{
 							MarkdownDescription: "Base URL.",
@@ -164,6 +191,7 @@ func (d *IndexersDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 						"cookie": schema.StringAttribute{
 							MarkdownDescription: "Cookie.",
 							Computed:            true,
+							Sensitive:           true,
 						},
 						"passkey": schema.StringAttribute{
 							MarkdownDescription: "Passkey.",
@@ -180,7 +208,7 @@ func (d *IndexersDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 							Sensitive:           true,
 						},
 						"categories": schema.SetAttribute{
-							MarkdownDescription: "Series list.",
+							MarkdownDescription: "Categories list.",
 							Computed:            true,
 							ElementType:         types.Int64Type,
 						},
@@ -198,7 +226,15 @@ func (d *IndexersDataSource) Configure(ctx context.Context, req datasource.Confi
 	}
 }
 
-func (d *IndexersDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+func (d *IndexersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *Indexers
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get indexers current value
 	response, _, err := d.client.IndexerAPI.ListIndexer(d.auth).Execute()
 	if err != nil {
@@ -209,12 +245,40 @@ func (d *IndexersDataSource) Read(ctx context.Context, _ datasource.ReadRequest,
 
 	tflog.Trace(ctx, "read "+indexersDataSourceName)
 	// Map response body to resource schema attribute
-	indexers := make([]Indexer, len(response))
-	for i, p := range response {
-		indexers[i].write(ctx, &p, &resp.Diagnostics)
+	indexers := make([]Indexer, 0, len(response))
+
+	for _, i := range response {
+		if !data.matches(&i) {
+			continue
+		}
+
+		var indexer Indexer
+
+		indexer.write(ctx, &i, &resp.Diagnostics)
+		indexers = append(indexers, indexer)
 	}
 
 	indexerList, diags := types.SetValueFrom(ctx, Indexer{}.getType(), indexers)
 	resp.Diagnostics.Append(diags...)
-	resp.Diagnostics.Append(resp.State.Set(ctx, Indexers{Indexers: indexerList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
+
+	data.Indexers = indexerList
+	data.ID = types.StringValue(strconv.Itoa(len(indexers)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// matches checks if an indexer satisfies the configured filters.
+func (d *Indexers) matches(indexer *lidarr.IndexerResource) bool {
+	if !d.Protocol.IsNull() && string(indexer.GetProtocol()) != d.Protocol.ValueString() {
+		return false
+	}
+
+	if !d.EnableRss.IsNull() && indexer.GetEnableRss() != d.EnableRss.ValueBool() {
+		return false
+	}
+
+	if !d.EnableAutomaticSearch.IsNull() && indexer.GetEnableAutomaticSearch() != d.EnableAutomaticSearch.ValueBool() {
+		return false
+	}
+
+	return true
 }
@@ -1,6 +1,8 @@
 package provider
 
 import (
+	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -24,6 +26,18 @@ func TestAccIndexersDataSource(t *testing.T) {
 					resource.TestCheckTypeSetElemNestedAttrs("data.lidarr_indexers.test", "indexers.*", map[string]string{"protocol": "usenet"}),
 				),
 			},
+			// Filter testing
+			{
+				Config: testAccIndexersDataSourceFilterConfig("usenet"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.lidarr_indexers.test", "indexers.*", map[string]string{"name": "datasourceTest"}),
+				),
+			},
+			// Invalid protocol
+			{
+				Config:      testAccIndexersDataSourceFilterConfig("ftp"),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
 		},
 	})
 }
@@ -32,3 +46,10 @@ const testAccIndexersDataSourceConfig = `
 data "lidarr_indexers" "test" {
 }
 `
+
+func testAccIndexersDataSourceFilterConfig(protocol string) string {
+	return fmt.Sprintf(`
+	data "lidarr_indexers" "test" {
+		protocol = "%s"
+	}`, protocol)
+}
@@ -0,0 +1,220 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	logDataSourceName = "log"
+	defaultLogLimit   = 100
+	logPageSize       = 100
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &LogDataSource{}
+
+func NewLogDataSource() datasource.DataSource {
+	return &LogDataSource{}
+}
+
+// LogDataSource defines the log implementation.
+type LogDataSource struct {
+	client *lidarr.APIClient
+	auth   context.Context
+}
+
+// Log describes the log data model.
+type Log struct {
+	Entries types.List   `tfsdk:"entries"`
+	Level   types.String `tfsdk:"level"`
+	ID      types.String `tfsdk:"id"`
+	Limit   types.Int64  `tfsdk:"limit"`
+}
+
+// LogEntry describes a single log entry.
+type LogEntry struct {
+	Time          types.String `tfsdk:"time"`
+	Level         types.String `tfsdk:"level"`
+	Logger        types.String `tfsdk:"logger"`
+	Message       types.String `tfsdk:"message"`
+	Exception     types.String `tfsdk:"exception"`
+	ExceptionType types.String `tfsdk:"exception_type"`
+	ID            types.Int64  `tfsdk:"id"`
+}
+
+func (e LogEntry) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"time":           types.StringType,
+			"level":          types.StringType,
+			"logger":         types.StringType,
+			"message":        types.StringType,
+			"exception":      types.StringType,
+			"exception_type": types.StringType,
+			"id":             types.Int64Type,
+		})
+}
+
+func (d *LogDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + logDataSourceName
+}
+
+func (d *LogDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the delay server.
+		MarkdownDescription: "<!-- subcategory:System -->\nList the most recent Lidarr log entries, newest first.\nFor more information refer to [Logs](https://wiki.servarr.com/lidarr/system#logs) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"level": schema.StringAttribute{
+				MarkdownDescription: "Minimum level of the entries. Valid values are 'trace', 'debug', 'info', 'warn', 'error' and 'fatal'.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("trace", "debug", "info", "warn", "error", "fatal"),
+				},
+			},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of entries. Defaults to `" + strconv.Itoa(defaultLogLimit) + "`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"entries": schema.ListNestedAttribute{
+				MarkdownDescription: "Log entry list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Log entry ID.",
+							Computed:            true,
+						},
+						"time": schema.StringAttribute{
+							MarkdownDescription: "Time.",
+							Computed:            true,
+						},
+						"level": schema.StringAttribute{
+							MarkdownDescription: "Level.",
+							Computed:            true,
+						},
+						"logger": schema.StringAttribute{
+							MarkdownDescription: "Logger.",
+							Computed:            true,
+						},
+						"message": schema.StringAttribute{
+							MarkdownDescription: "Message.",
+							Computed:            true,
+						},
+						"exception": schema.StringAttribute{
+							MarkdownDescription: "Exception.",
+							Computed:            true,
+						},
+						"exception_type": schema.StringAttribute{
+							MarkdownDescription: "Exception type.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *LogDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *LogDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *Log
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	limit := int(valueOrDefault(data.Limit, defaultLogLimit))
+
+	// Get log current value
+	response, err := d.listLog(data.Level.ValueString(), limit)
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, logDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+logDataSourceName)
+	// Map response body to resource schema attribute
+	entries := make([]LogEntry, len(response))
+	for i, l := range response {
+		entries[i].write(&l)
+	}
+
+	entryList, diags := types.ListValueFrom(ctx, LogEntry{}.getType(), entries)
+	resp.Diagnostics.Append(diags...)
+
+	data.Entries = entryList
+	data.ID = types.StringValue(strconv.Itoa(len(response)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// listLog returns the newest log entries up to the limit, going through the pages as needed.
+func (d *LogDataSource) listLog(level string, limit int) ([]lidarr.LogResource, error) {
+	entries := make([]lidarr.LogResource, 0, limit)
+
+	for page := int32(1); len(entries) < limit; page++ {
+		request := d.client.LogAPI.GetLog(d.auth).
+			Page(page).
+			PageSize(logPageSize).
+			SortKey("time").
+			SortDirection(lidarr.SORTDIRECTION_DESCENDING)
+		if level != "" {
+			request = request.Level(level)
+		}
+
+		response, _, err := request.Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		records := response.GetRecords()
+		if len(records) > limit-len(entries) {
+			records = records[:limit-len(entries)]
+		}
+
+		entries = append(entries, records...)
+
+		if len(response.GetRecords()) < logPageSize {
+			break
+		}
+	}
+
+	return entries, nil
+}
+
+func (e *LogEntry) write(log *lidarr.LogResource) {
+	e.ID = types.Int64Value(int64(log.GetId()))
+	e.Time = types.StringValue(log.GetTime().String())
+	e.Level = types.StringValue(log.GetLevel())
+	e.Logger = types.StringValue(log.GetLogger())
+	e.Message = types.StringValue(log.GetMessage())
+	e.Exception = types.StringValue(log.GetException())
+	e.ExceptionType = types.StringValue(log.GetExceptionType())
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccLogDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccLogDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccLogDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.lidarr_log.test", "id"),
+					resource.TestCheckResourceAttrSet("data.lidarr_log.test", "entries.0.message"),
+				),
+			},
+		},
+	})
+}
+
+const testAccLogDataSourceConfig = `
+data "lidarr_log" "test" {
+	level = "info"
+	limit = 5
+}
+`
+
+func TestLogDataSourceListLog(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		total int
+		limit int
+		calls int32
+		count int
+	}{
+		"single page": {
+			total: 250,
+			limit: 50,
+			calls: 1,
+			count: 50,
+		},
+		"multiple pages": {
+			total: 250,
+			limit: 120,
+			calls: 2,
+			count: 120,
+		},
+		"fewer entries": {
+			total: 130,
+			limit: 500,
+			calls: 2,
+			count: 130,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls int32
+
+			// fake Lidarr serving the requested page of the log
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&calls, 1)
+				assert.Equal(t, "error", r.URL.Query().Get("level"))
+
+				page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+				records := make([]lidarr.LogResource, 0, logPageSize)
+
+				for id := (page-1)*logPageSize + 1; id <= min(page*logPageSize, test.total); id++ {
+					record := lidarr.NewLogResource()
+					record.SetId(int32(id))
+					records = append(records, *record)
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{"page": page, "totalRecords": test.total, "records": records})
+			}))
+			defer server.Close()
+
+			config := lidarr.NewConfiguration()
+			config.Servers[0].URL = server.URL
+			d := LogDataSource{client: lidarr.NewAPIClient(config), auth: context.Background()}
+
+			entries, err := d.listLog("error", test.limit)
+			assert.NoError(t, err)
+			assert.Len(t, entries, test.count)
+			assert.Equal(t, test.calls, atomic.LoadInt32(&calls))
+		})
+	}
+}
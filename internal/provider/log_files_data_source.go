@@ -0,0 +1,191 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// log file types, matching the log file endpoints.
+const (
+	logFilesDataSourceName = "log_files"
+	logFileTypeLog         = "log"
+	logFileTypeUpdate      = "update"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &LogFilesDataSource{}
+
+func NewLogFilesDataSource() datasource.DataSource {
+	return &LogFilesDataSource{}
+}
+
+// LogFilesDataSource defines the log files implementation.
+type LogFilesDataSource struct {
+	client *lidarr.APIClient
+	auth   context.Context
+}
+
+// LogFiles describes the log files data model.
+type LogFiles struct {
+	LogFiles types.Set    `tfsdk:"log_files"`
+	Type     types.String `tfsdk:"type"`
+	ID       types.String `tfsdk:"id"`
+}
+
+// LogFile describes the log file data model.
+type LogFile struct {
+	Filename      types.String `tfsdk:"filename"`
+	Type          types.String `tfsdk:"type"`
+	LastWriteTime types.String `tfsdk:"last_write_time"`
+	ContentsURL   types.String `tfsdk:"contents_url"`
+	DownloadURL   types.String `tfsdk:"download_url"`
+	ID            types.Int64  `tfsdk:"id"`
+}
+
+func (l LogFile) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"filename":        types.StringType,
+			"type":            types.StringType,
+			"last_write_time": types.StringType,
+			"contents_url":    types.StringType,
+			"download_url":    types.StringType,
+			"id":              types.Int64Type,
+		})
+}
+
+func (d *LogFilesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + logFilesDataSourceName
+}
+
+func (d *LogFilesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the delay server.
+		MarkdownDescription: "<!-- subcategory:System -->\nList the log files stored by Lidarr.\nFor more information refer to [Log Files](https://wiki.servarr.com/lidarr/system#log-files) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only list the given type of log files. Valid values are 'log' and 'update', both are listed if not set.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(logFileTypeLog, logFileTypeUpdate),
+				},
+			},
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"log_files": schema.SetNestedAttribute{
+				MarkdownDescription: "Log file list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Log file ID.",
+							Computed:            true,
+						},
+						"filename": schema.StringAttribute{
+							MarkdownDescription: "File name.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Log file type, either 'log' or 'update'.",
+							Computed:            true,
+						},
+						"last_write_time": schema.StringAttribute{
+							MarkdownDescription: "Last write time.",
+							Computed:            true,
+						},
+						"contents_url": schema.StringAttribute{
+							MarkdownDescription: "URL path of the file contents.",
+							Computed:            true,
+						},
+						"download_url": schema.StringAttribute{
+							MarkdownDescription: "URL path to download the file.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *LogFilesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *LogFilesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *LogFiles
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	logFiles := make([]LogFile, 0)
+
+	// Get log files current value
+	if data.Type.IsNull() || data.Type.ValueString() == logFileTypeLog {
+		response, _, err := d.client.LogFileAPI.ListLogFile(d.auth).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, logFilesDataSourceName, err))
+
+			return
+		}
+
+		logFiles = appendLogFiles(logFiles, response, logFileTypeLog)
+	}
+
+	if data.Type.IsNull() || data.Type.ValueString() == logFileTypeUpdate {
+		response, _, err := d.client.UpdateLogFileAPI.ListLogFileUpdate(d.auth).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, logFilesDataSourceName, err))
+
+			return
+		}
+
+		logFiles = appendLogFiles(logFiles, response, logFileTypeUpdate)
+	}
+
+	tflog.Trace(ctx, "read "+logFilesDataSourceName)
+	// Map response body to resource schema attribute
+	logFileList, diags := types.SetValueFrom(ctx, LogFile{}.getType(), logFiles)
+	resp.Diagnostics.Append(diags...)
+
+	data.LogFiles = logFileList
+	data.ID = types.StringValue(strconv.Itoa(len(logFiles)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// appendLogFiles converts the log files of the given type and appends them to the list.
+func appendLogFiles(logFiles []LogFile, response []lidarr.LogFileResource, logFileType string) []LogFile {
+	for _, f := range response {
+		logFile := LogFile{Type: types.StringValue(logFileType)}
+		logFile.write(&f)
+		logFiles = append(logFiles, logFile)
+	}
+
+	return logFiles
+}
+
+func (l *LogFile) write(logFile *lidarr.LogFileResource) {
+	l.ID = types.Int64Value(int64(logFile.GetId()))
+	l.Filename = types.StringValue(logFile.GetFilename())
+	l.LastWriteTime = types.StringValue(logFile.GetLastWriteTime().String())
+	l.ContentsURL = types.StringValue(logFile.GetContentsUrl())
+	l.DownloadURL = types.StringValue(logFile.GetDownloadUrl())
+}
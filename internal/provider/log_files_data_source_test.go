@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccLogFilesDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccLogFilesDataSourceConfig("log") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccLogFilesDataSourceConfig("log"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.lidarr_log_files.test", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.lidarr_log_files.test", "log_files.*", map[string]string{"type": "log", "filename": "lidarr.txt"}),
+				),
+			},
+		},
+	})
+}
+
+func testAccLogFilesDataSourceConfig(logFileType string) string {
+	return `
+	data "lidarr_log_files" "test" {
+		type = "` + logFileType + `"
+	}
+	`
+}
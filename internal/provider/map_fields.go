@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"slices"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// implementationResource is a Lidarr resource configured through the fields of its implementation.
+type implementationResource interface {
+	GetImplementation() string
+	GetFields() []lidarr.Field
+	SetFields(v []lidarr.Field)
+}
+
+// typeMapFields converts the raw values set through field maps to the types of the implementation schema.
+// The schema is only requested when a field map is set.
+func typeMapFields[T any, P interface {
+	*T
+	implementationResource
+}](request P, list func() ([]T, *http.Response, error), name string, diags *diag.Diagnostics, fieldMaps ...types.Map) bool {
+	var names []string
+
+	for _, fieldMap := range fieldMaps {
+		for key := range fieldMap.Elements() {
+			names = append(names, key)
+		}
+	}
+
+	if len(names) == 0 {
+		return true
+	}
+
+	schemas, _, err := list()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, name, err))
+
+		return false
+	}
+
+	var definitions []lidarr.Field
+
+	if index := slices.IndexFunc(schemas, func(s T) bool { return P(&s).GetImplementation() == request.GetImplementation() }); index >= 0 {
+		definitions = P(&schemas[index]).GetFields()
+	}
+
+	fields, err := helpers.TypeMapFields(request.GetFields(), names, definitions)
+	if err != nil {
+		diags.AddError(helpers.ResourceError, "Unable to convert "+name+" fields, got error: "+err.Error())
+
+		return false
+	}
+
+	request.SetFields(fields)
+
+	return true
+}
+
+// typeDownloadClientFields converts the raw download client field values to the types of the Lidarr schema.
+func typeDownloadClientFields(auth context.Context, client *lidarr.APIClient, request *lidarr.DownloadClientResource, name string, diags *diag.Diagnostics, fieldMaps ...types.Map) bool {
+	return typeMapFields(request, client.DownloadClientAPI.ListDownloadClientSchema(auth).Execute, name, diags, fieldMaps...)
+}
+
+// typeIndexerFields converts the raw indexer field values to the types of the Lidarr schema.
+func typeIndexerFields(auth context.Context, client *lidarr.APIClient, request *lidarr.IndexerResource, name string, diags *diag.Diagnostics, fieldMaps ...types.Map) bool {
+	return typeMapFields(request, client.IndexerAPI.ListIndexerSchema(auth).Execute, name, diags, fieldMaps...)
+}
+
+// typeNotificationFields converts the raw notification field values to the types of the Lidarr schema.
+func typeNotificationFields(auth context.Context, client *lidarr.APIClient, request *lidarr.NotificationResource, name string, diags *diag.Diagnostics, fieldMaps ...types.Map) bool {
+	return typeMapFields(request, client.NotificationAPI.ListNotificationSchema(auth).Execute, name, diags, fieldMaps...)
+}
+
+// typeMetadataFields converts the raw metadata field values to the types of the Lidarr schema.
+func typeMetadataFields(auth context.Context, client *lidarr.APIClient, request *lidarr.MetadataResource, name string, diags *diag.Diagnostics, fieldMaps ...types.Map) bool {
+	return typeMapFields(request, client.MetadataAPI.ListMetadataSchema(auth).Execute, name, diags, fieldMaps...)
+}
@@ -77,6 +77,11 @@ func (d *MetadataConsumersDataSource) Schema(_ context.Context, _ datasource.Sch
 							Computed:            true,
 						},
 						// Field values
+						"fields": schema.MapAttribute{
+							MarkdownDescription: "Raw field values for settings not covered by other attributes, keyed by API field name.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
 						"track_metadata": schema.BoolAttribute{
 							MarkdownDescription: "Track metadata flag.",
 							Computed:            true,
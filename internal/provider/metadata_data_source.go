@@ -15,7 +15,10 @@ import (
 const metadataDataSourceName = "metadata"
 
 // Ensure provider defined types fully satisfy framework interfaces.
-var _ datasource.DataSource = &MetadataDataSource{}
+var (
+	_ datasource.DataSource                     = &MetadataDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &MetadataDataSource{}
+)
 
 func NewMetadataDataSource() datasource.DataSource {
 	return &MetadataDataSource{}
@@ -50,7 +53,8 @@ func (d *MetadataDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Metadata name.",
-				Required:            true,
+				Optional:            true,
+				Computed:            true,
 			},
 			"tags": schema.SetAttribute{
 				MarkdownDescription: "List of associated tags.",
@@ -59,9 +63,15 @@ func (d *MetadataDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Metadata ID.",
+				Optional:            true,
 				Computed:            true,
 			},
 			// Field values
+			"fields": schema.MapAttribute{
+				MarkdownDescription: "Raw field values for settings not covered by other attributes, keyed by API field name.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
 			"track_metadata": schema.BoolAttribute{
 				MarkdownDescription: "Track metadata flag.",
 				Computed:            true,
@@ -86,6 +96,12 @@ func (d *MetadataDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 	}
 }
 
+func (d *MetadataDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		helpers.IDOrName("name"),
+	}
+}
+
 func (d *MetadataDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
 		d.client = client
@@ -109,20 +125,20 @@ func (d *MetadataDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	data.find(ctx, data.Name.ValueString(), response, &resp.Diagnostics)
+	data.find(ctx, helpers.NewLookup(data.ID, "name", data.Name), response, &resp.Diagnostics)
 	tflog.Trace(ctx, "read "+metadataDataSourceName)
 	// Map response body to resource schema attribute
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (m *Metadata) find(ctx context.Context, name string, metadatas []lidarr.MetadataResource, diags *diag.Diagnostics) {
+func (m *Metadata) find(ctx context.Context, lookup helpers.Lookup, metadatas []lidarr.MetadataResource, diags *diag.Diagnostics) {
 	for _, metadata := range metadatas {
-		if metadata.GetName() == name {
+		if lookup.Matches(metadata.GetId(), metadata.GetName()) {
 			m.write(ctx, &metadata, diags)
 
 			return
 		}
 	}
 
-	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(metadataDataSourceName, "name", name))
+	diags.AddError(helpers.DataSourceError, lookup.NotFound(metadataDataSourceName, helpers.Values(metadatas, (*lidarr.MetadataResource).GetName)))
 }
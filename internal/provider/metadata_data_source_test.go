@@ -32,6 +32,17 @@ func TestAccMetadataDataSource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.lidarr_metadata.test", "id"),
 					resource.TestCheckResourceAttr("data.lidarr_metadata.test", "track_metadata", "false")),
 			},
+			// Conflicting lookup testing
+			{
+				Config:      testAccMetadataDataSourceConflictConfig,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			// Read by ID testing
+			{
+				Config: testAccMetadataResourceConfig("metadataData", "false") + testAccMetadataDataSourceIDConfig("lidarr_metadata.test.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lidarr_metadata.test", "name", "metadataData")),
+			},
 		},
 	})
 }
@@ -43,3 +54,18 @@ func testAccMetadataDataSourceConfig(name string) string {
 	}
 	`, name)
 }
+
+const testAccMetadataDataSourceConflictConfig = `
+data "lidarr_metadata" "test" {
+	id = 1
+	name = "Error"
+}
+`
+
+func testAccMetadataDataSourceIDConfig(id string) string {
+	return fmt.Sprintf(`
+	data "lidarr_metadata" "test" {
+		id = %s
+	}
+	`, id)
+}
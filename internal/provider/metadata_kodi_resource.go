@@ -40,14 +40,15 @@ type MetadataKodiResource struct {
 
 // MetadataKodi describes the Kodi metadata data model.
 type MetadataKodi struct {
-	Tags           types.Set    `tfsdk:"tags"`
-	Name           types.String `tfsdk:"name"`
-	ID             types.Int64  `tfsdk:"id"`
-	Enable         types.Bool   `tfsdk:"enable"`
-	ArtistMetadata types.Bool   `tfsdk:"artist_metadata"`
-	AlbumMetadata  types.Bool   `tfsdk:"album_metadata"`
-	ArtistImages   types.Bool   `tfsdk:"artist_images"`
-	AlbumImages    types.Bool   `tfsdk:"album_images"`
+	Tags            types.Set    `tfsdk:"tags"`
+	Name            types.String `tfsdk:"name"`
+	ID              types.Int64  `tfsdk:"id"`
+	Enable          types.Bool   `tfsdk:"enable"`
+	ArtistMetadata  types.Bool   `tfsdk:"artist_metadata"`
+	AlbumMetadata   types.Bool   `tfsdk:"album_metadata"`
+	ArtistImages    types.Bool   `tfsdk:"artist_images"`
+	AlbumImages     types.Bool   `tfsdk:"album_images"`
+	SkipDefaultTags types.Bool   `tfsdk:"skip_default_tags"`
 }
 
 func (m MetadataKodi) toMetadata() *Metadata {
@@ -82,7 +83,7 @@ func (r *MetadataKodiResource) Metadata(_ context.Context, req resource.Metadata
 
 func (r *MetadataKodiResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "<!-- subcategory:Metadata -->\nMetadata Kodi resource.\nFor more information refer to [Metadata](https://wiki.servarr.com/lidarr/settings#metadata) and [KODI](https://wiki.servarr.com/lidarr/supported#xbmcmetadata).",
+		MarkdownDescription: "<!-- subcategory:Metadata -->\nMetadata Kodi resource.\nThe existing Kodi consumer is adopted on create and disabled on destroy.\nFor more information refer to [Metadata](https://wiki.servarr.com/lidarr/settings#metadata) and [KODI](https://wiki.servarr.com/lidarr/supported#xbmcmetadata).",
 		Attributes: map[string]schema.Attribute{
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
@@ -99,6 +100,7 @@ func (r *MetadataKodiResource) Schema(_ context.Context, _ resource.SchemaReques
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"skip_default_tags": skipDefaultTagsAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Metadata ID.",
 				Computed:            true,
@@ -144,18 +146,26 @@ func (r *MetadataKodiResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
-	// Create new MetadataKodi
+	// Adopt the existing MetadataKodi or create a new one
 	request := metadata.read(ctx, &resp.Diagnostics)
+	applyDefaultTags(r.auth, request, metadata.SkipDefaultTags)
 
-	response, _, err := r.client.MetadataAPI.CreateMetadata(r.auth).MetadataResource(*request).Execute()
+	if !newTagResolver(r.auth, r.client).validate(metadataKodiResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	response, err := adoptMetadata(r.auth, r.client, request)
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, metadataKodiResourceName, err))
 
 		return
 	}
 
+	response = helpers.ReadAfterCreate(ctx, response, metadataKodiResourceName, r.client.MetadataAPI.GetMetadataById(r.auth, response.GetId()).Execute, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+metadataKodiResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, metadata.Tags, metadata.SkipDefaultTags)
 	metadata.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &metadata)...)
 }
@@ -171,8 +181,15 @@ func (r *MetadataKodiResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Get MetadataKodi current value
-	response, _, err := r.client.MetadataAPI.GetMetadataById(r.auth, int32(metadata.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.MetadataAPI.GetMetadataById(r.auth, int32(metadata.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			resp.Diagnostics.AddWarning(helpers.ResourceNotFound, helpers.ParseResourceNotFound(metadataKodiResourceName, metadata.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, metadataKodiResourceName, err))
 
 		return
@@ -180,6 +197,7 @@ func (r *MetadataKodiResource) Read(ctx context.Context, req resource.ReadReques
 
 	tflog.Trace(ctx, "read "+metadataKodiResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	removeDefaultTags(ctx, r.auth, response, metadata.Tags, metadata.SkipDefaultTags)
 	metadata.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &metadata)...)
 }
@@ -196,6 +214,11 @@ func (r *MetadataKodiResource) Update(ctx context.Context, req resource.UpdateRe
 
 	// Update MetadataKodi
 	request := metadata.read(ctx, &resp.Diagnostics)
+	applyDefaultTags(r.auth, request, metadata.SkipDefaultTags)
+
+	if !newTagResolver(r.auth, r.client).validate(metadataKodiResourceName, request, &resp.Diagnostics) {
+		return
+	}
 
 	response, _, err := r.client.MetadataAPI.UpdateMetadata(r.auth, request.GetId()).MetadataResource(*request).Execute()
 	if err != nil {
@@ -206,6 +229,7 @@ func (r *MetadataKodiResource) Update(ctx context.Context, req resource.UpdateRe
 
 	tflog.Trace(ctx, "updated "+metadataKodiResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, metadata.Tags, metadata.SkipDefaultTags)
 	metadata.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &metadata)...)
 }
@@ -219,15 +243,15 @@ func (r *MetadataKodiResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
-	// Delete MetadataKodi current value
-	_, err := r.client.MetadataAPI.DeleteMetadata(r.auth, int32(ID)).Execute()
+	// Disable MetadataKodi, the consumer is kept for the next adoption
+	err := disableMetadata(r.auth, r.client, int32(ID))
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, metadataKodiResourceName, err))
 
 		return
 	}
 
-	tflog.Trace(ctx, "deleted "+metadataKodiResourceName+": "+strconv.Itoa(int(ID)))
+	tflog.Trace(ctx, "disabled "+metadataKodiResourceName+": "+strconv.Itoa(int(ID)))
 	resp.State.RemoveResource(ctx)
 }
 
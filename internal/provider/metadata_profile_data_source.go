@@ -15,7 +15,10 @@ import (
 const metadataProfileDataSourceName = "metadata_profile"
 
 // Ensure provider defined types fully satisfy framework interfaces.
-var _ datasource.DataSource = &MetadataProfileDataSource{}
+var (
+	_ datasource.DataSource                     = &MetadataProfileDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &MetadataProfileDataSource{}
+)
 
 func NewMetadataProfileDataSource() datasource.DataSource {
 	return &MetadataProfileDataSource{}
@@ -38,11 +41,13 @@ func (d *MetadataProfileDataSource) Schema(_ context.Context, _ datasource.Schem
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Metadata Profile ID.",
+				Optional:            true,
 				Computed:            true,
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Metadata Profile name.",
-				Required:            true,
+				Optional:            true,
+				Computed:            true,
 			},
 			"primary_album_types": schema.SetAttribute{
 				MarkdownDescription: "Primary album types.",
@@ -63,6 +68,12 @@ func (d *MetadataProfileDataSource) Schema(_ context.Context, _ datasource.Schem
 	}
 }
 
+func (d *MetadataProfileDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		helpers.IDOrName("name"),
+	}
+}
+
 func (d *MetadataProfileDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
 		d.client = client
@@ -86,20 +97,20 @@ func (d *MetadataProfileDataSource) Read(ctx context.Context, req datasource.Rea
 		return
 	}
 
-	data.find(ctx, data.Name.ValueString(), response, &resp.Diagnostics)
+	data.find(ctx, helpers.NewLookup(data.ID, "name", data.Name), response, &resp.Diagnostics)
 	tflog.Trace(ctx, "read "+metadataProfileDataSourceName)
 	// Map response body to resource schema attribute
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (m *MetadataProfile) find(ctx context.Context, name string, profiles []lidarr.MetadataProfileResource, diags *diag.Diagnostics) {
+func (m *MetadataProfile) find(ctx context.Context, lookup helpers.Lookup, profiles []lidarr.MetadataProfileResource, diags *diag.Diagnostics) {
 	for _, p := range profiles {
-		if p.GetName() == name {
+		if lookup.Matches(p.GetId(), p.GetName()) {
 			m.write(ctx, &p, diags)
 
 			return
 		}
 	}
 
-	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(metadataProfileDataSourceName, "name", name))
+	diags.AddError(helpers.DataSourceError, lookup.NotFound(metadataProfileDataSourceName, helpers.Values(profiles, (*lidarr.MetadataProfileResource).GetName)))
 }
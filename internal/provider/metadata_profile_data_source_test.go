@@ -32,6 +32,17 @@ func TestAccMetadataProfileDataSource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.lidarr_metadata_profile.test", "id"),
 					resource.TestCheckResourceAttr("data.lidarr_metadata_profile.test", "release_statuses.0", "0")),
 			},
+			// Conflicting lookup testing
+			{
+				Config:      testAccMetadataProfileDataSourceConflictConfig,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			// Read by ID testing
+			{
+				Config: testAccMetadataProfileDataSourceIDConfig("1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lidarr_metadata_profile.test", "name", "Standard")),
+			},
 		},
 	})
 }
@@ -43,3 +54,18 @@ func testAccMetadataProfileDataSourceConfig(name string) string {
 	}
 	`, name)
 }
+
+const testAccMetadataProfileDataSourceConflictConfig = `
+data "lidarr_metadata_profile" "test" {
+	id = 1
+	name = "Error"
+}
+`
+
+func testAccMetadataProfileDataSourceIDConfig(id string) string {
+	return fmt.Sprintf(`
+	data "lidarr_metadata_profile" "test" {
+		id = %s
+	}
+	`, id)
+}
@@ -2,10 +2,14 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/devopsarr/lidarr-go/lidarr"
 	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -13,12 +17,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 const metadataProfileResourceName = "metadata_profile"
 
+// Lidarr metadata profile enumerations, the position in the slice is the ID.
+var (
+	metadataProfilePrimaryAlbumTypes   = []string{"Album", "EP", "Single", "Broadcast", "Other"}
+	metadataProfileSecondaryAlbumTypes = []string{"Studio", "Compilation", "Soundtrack", "Spokenword", "Interview", "Audiobook", "Live", "Remix", "DJ-mix", "Mixtape/Street", "Demo", "Audio drama"}
+	metadataProfileReleaseStatuses     = []string{"Official", "Promotion", "Bootleg", "Pseudo-Release"}
+)
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
 	_ resource.Resource                = &MetadataProfileResource{}
@@ -35,6 +47,13 @@ type MetadataProfileResource struct {
 	auth   context.Context
 }
 
+// MetadataProfileResourceData describes the metadata profile resource data model.
+// It extends the metadata profile data model with attributes not stored in Lidarr.
+type MetadataProfileResourceData struct {
+	MetadataProfile
+	DeletionProtection types.Bool `tfsdk:"deletion_protection"`
+}
+
 // MetadataProfile describes the metadata profile data model.
 type MetadataProfile struct {
 	PrimaryAlbumTypes   types.Set    `tfsdk:"primary_album_types"`
@@ -79,6 +98,7 @@ func (r *MetadataProfileResource) Schema(_ context.Context, _ resource.SchemaReq
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Profiles -->\nMetadata Profile resource.\nFor more information refer to [Metadata Profile](https://wiki.servarr.com/lidarr/settings#metadata-profiles) documentation.",
 		Attributes: map[string]schema.Attribute{
+			"deletion_protection": helpers.DeletionProtectionAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Metadata Profile ID.",
 				Computed:            true,
@@ -91,24 +111,55 @@ func (r *MetadataProfileResource) Schema(_ context.Context, _ resource.SchemaReq
 				Required:            true,
 			},
 			"primary_album_types": schema.SetAttribute{
-				MarkdownDescription: "Primary album types.",
+				MarkdownDescription: "Primary album types, at least one is required. " + metadataProfileValuesDescription(metadataProfilePrimaryAlbumTypes),
 				Required:            true,
 				ElementType:         types.Int64Type,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueInt64sAre(int64validator.OneOf(metadataProfileValues(metadataProfilePrimaryAlbumTypes)...)),
+				},
 			},
 			"secondary_album_types": schema.SetAttribute{
-				MarkdownDescription: "Secondary album types.",
+				MarkdownDescription: "Secondary album types. " + metadataProfileValuesDescription(metadataProfileSecondaryAlbumTypes),
 				Required:            true,
 				ElementType:         types.Int64Type,
+				Validators: []validator.Set{
+					setvalidator.ValueInt64sAre(int64validator.OneOf(metadataProfileValues(metadataProfileSecondaryAlbumTypes)...)),
+				},
 			},
 			"release_statuses": schema.SetAttribute{
-				MarkdownDescription: "Release statuses.",
+				MarkdownDescription: "Release statuses, at least one is required. " + metadataProfileValuesDescription(metadataProfileReleaseStatuses),
 				Required:            true,
 				ElementType:         types.Int64Type,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueInt64sAre(int64validator.OneOf(metadataProfileValues(metadataProfileReleaseStatuses)...)),
+				},
 			},
 		},
 	}
 }
 
+// metadataProfileValues returns the valid IDs of a metadata profile enumeration.
+func metadataProfileValues(names []string) []int64 {
+	values := make([]int64, len(names))
+	for id := range names {
+		values[id] = int64(id)
+	}
+
+	return values
+}
+
+// metadataProfileValuesDescription lists the valid IDs of a metadata profile enumeration with their names.
+func metadataProfileValuesDescription(names []string) string {
+	values := make([]string, len(names))
+	for id, name := range names {
+		values[id] = fmt.Sprintf("`%d` (%s)", id, name)
+	}
+
+	return "Valid values are " + strings.Join(values, ", ") + "."
+}
+
 func (r *MetadataProfileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + metadataProfileResourceName
 }
@@ -122,7 +173,7 @@ func (r *MetadataProfileResource) Configure(ctx context.Context, req resource.Co
 
 func (r *MetadataProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var profile *MetadataProfile
+	var profile *MetadataProfileResourceData
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &profile)...)
 
@@ -140,6 +191,8 @@ func (r *MetadataProfileResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	response = helpers.ReadAfterCreate(ctx, response, metadataProfileResourceName, r.client.MetadataProfileAPI.GetMetadataProfileById(r.auth, response.GetId()).Execute, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+metadataProfileResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	profile.write(ctx, response, &resp.Diagnostics)
@@ -148,7 +201,7 @@ func (r *MetadataProfileResource) Create(ctx context.Context, req resource.Creat
 
 func (r *MetadataProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var profile *MetadataProfile
+	var profile *MetadataProfileResourceData
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &profile)...)
 
@@ -157,8 +210,15 @@ func (r *MetadataProfileResource) Read(ctx context.Context, req resource.ReadReq
 	}
 
 	// Get metadataProfile current value
-	response, _, err := r.client.MetadataProfileAPI.GetMetadataProfileById(r.auth, int32(profile.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.MetadataProfileAPI.GetMetadataProfileById(r.auth, int32(profile.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			resp.Diagnostics.AddWarning(helpers.ResourceNotFound, helpers.ParseResourceNotFound(metadataProfileResourceName, profile.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, metadataProfileResourceName, err))
 
 		return
@@ -172,7 +232,7 @@ func (r *MetadataProfileResource) Read(ctx context.Context, req resource.ReadReq
 
 func (r *MetadataProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var profile *MetadataProfile
+	var profile *MetadataProfileResourceData
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &profile)...)
 
@@ -205,6 +265,10 @@ func (r *MetadataProfileResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	if helpers.DeletionProtected(ctx, req.State, metadataProfileResourceName, &resp.Diagnostics) {
+		return
+	}
+
 	// Delete metadataProfile current value
 	_, err := r.client.MetadataProfileAPI.DeleteMetadataProfile(r.auth, int32(ID)).Execute()
 	if err != nil {
@@ -219,6 +283,7 @@ func (r *MetadataProfileResource) Delete(ctx context.Context, req resource.Delet
 
 func (r *MetadataProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deletion_protection"), false)...)
 	tflog.Trace(ctx, "imported "+metadataProfileResourceName+": "+req.ID)
 }
 
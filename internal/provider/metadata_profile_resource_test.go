@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccMetadataProfileResource(t *testing.T) {
@@ -15,6 +16,15 @@ func TestAccMetadataProfileResource(t *testing.T) {
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
+			// Invalid values
+			{
+				Config:      testAccMetadataProfileResourceConfig("error", "1,7"),
+				ExpectError: regexp.MustCompile("must be one of"),
+			},
+			{
+				Config:      testAccMetadataProfileResourceConfig("error", ""),
+				ExpectError: regexp.MustCompile("set must contain at least 1 elements"),
+			},
 			// Unauthorized Create
 			{
 				Config:      testAccMetadataProfileResourceConfig("error", "1,2") + testUnauthorizedProvider,
@@ -61,3 +71,10 @@ func testAccMetadataProfileResourceConfig(name, primary string) string {
 		}
 	`, name, primary)
 }
+
+func TestMetadataProfileValuesDescription(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []int64{0, 1, 2, 3}, metadataProfileValues(metadataProfileReleaseStatuses))
+	assert.Equal(t, "Valid values are `0` (Official), `1` (Promotion), `2` (Bootleg), `3` (Pseudo-Release).", metadataProfileValuesDescription(metadataProfileReleaseStatuses))
+}
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -39,9 +41,17 @@ type MetadataResource struct {
 	auth   context.Context
 }
 
+// MetadataResourceData describes the metadata resource data model.
+// It extends the metadata data model with attributes not stored by Lidarr.
+type MetadataResourceData struct {
+	Metadata
+	SkipDefaultTags types.Bool `tfsdk:"skip_default_tags"`
+}
+
 // Metadata describes the metadata data model.
 type Metadata struct {
 	Tags           types.Set    `tfsdk:"tags"`
+	Fields         types.Map    `tfsdk:"fields"`
 	Name           types.String `tfsdk:"name"`
 	ConfigContract types.String `tfsdk:"config_contract"`
 	Implementation types.String `tfsdk:"implementation"`
@@ -58,6 +68,7 @@ func (m Metadata) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
 			"tags":            types.SetType{}.WithElementType(types.Int64Type),
+			"fields":          types.MapType{}.WithElementType(types.StringType),
 			"name":            types.StringType,
 			"config_contract": types.StringType,
 			"implementation":  types.StringType,
@@ -87,10 +98,16 @@ func (r *MetadataResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 			"config_contract": schema.StringAttribute{
 				MarkdownDescription: "Metadata configuration template.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"implementation": schema.StringAttribute{
 				MarkdownDescription: "Metadata implementation name.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Metadata name.",
@@ -102,6 +119,7 @@ func (r *MetadataResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"skip_default_tags": skipDefaultTagsAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Metadata ID.",
 				Computed:            true,
@@ -110,6 +128,15 @@ func (r *MetadataResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				},
 			},
 			// Field values
+			"fields": schema.MapAttribute{
+				MarkdownDescription: "Raw field values for settings not covered by other attributes, keyed by API field name. Values are converted to the type of the Lidarr field, lists are written as JSON.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Map{
+					helpers.UnmanagedFields(),
+				},
+			},
 			"track_metadata": schema.BoolAttribute{
 				MarkdownDescription: "Track metadata flag.",
 				Optional:            true,
@@ -148,7 +175,7 @@ func (r *MetadataResource) Configure(ctx context.Context, req resource.Configure
 
 func (r *MetadataResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var metadata *Metadata
+	var metadata *MetadataResourceData
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &metadata)...)
 
@@ -159,6 +186,16 @@ func (r *MetadataResource) Create(ctx context.Context, req resource.CreateReques
 	// Create new Metadata
 	request := metadata.read(ctx, &resp.Diagnostics)
 
+	if !typeMetadataFields(r.auth, r.client, request, metadataResourceName, &resp.Diagnostics, metadata.Fields) {
+		return
+	}
+
+	applyDefaultTags(r.auth, request, metadata.SkipDefaultTags)
+
+	if !newTagResolver(r.auth, r.client).validate(metadataResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.MetadataAPI.CreateMetadata(r.auth).MetadataResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, metadataResourceName, err))
@@ -166,18 +203,23 @@ func (r *MetadataResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	response = helpers.ReadAfterCreate(ctx, response, metadataResourceName, r.client.MetadataAPI.GetMetadataById(r.auth, response.GetId()).Execute, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+metadataResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct.
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Metadata
+	var state MetadataResourceData
 
+	state.writeFields(&metadata.Metadata)
+	removeDefaultTags(ctx, r.auth, response, metadata.Tags, metadata.SkipDefaultTags)
 	state.write(ctx, response, &resp.Diagnostics)
+	state.SkipDefaultTags = metadata.SkipDefaultTags
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *MetadataResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var metadata *Metadata
+	var metadata *MetadataResourceData
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &metadata)...)
 
@@ -186,8 +228,15 @@ func (r *MetadataResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	// Get Metadata current value
-	response, _, err := r.client.MetadataAPI.GetMetadataById(r.auth, int32(metadata.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.MetadataAPI.GetMetadataById(r.auth, int32(metadata.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			resp.Diagnostics.AddWarning(helpers.ResourceNotFound, helpers.ParseResourceNotFound(metadataResourceName, metadata.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, metadataResourceName, err))
 
 		return
@@ -196,15 +245,18 @@ func (r *MetadataResource) Read(ctx context.Context, req resource.ReadRequest, r
 	tflog.Trace(ctx, "read "+metadataResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct.
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Metadata
+	var state MetadataResourceData
 
+	state.writeFields(&metadata.Metadata)
+	removeDefaultTags(ctx, r.auth, response, metadata.Tags, metadata.SkipDefaultTags)
 	state.write(ctx, response, &resp.Diagnostics)
+	state.SkipDefaultTags = metadata.SkipDefaultTags
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *MetadataResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var metadata *Metadata
+	var metadata *MetadataResourceData
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &metadata)...)
 
@@ -215,6 +267,16 @@ func (r *MetadataResource) Update(ctx context.Context, req resource.UpdateReques
 	// Update Metadata
 	request := metadata.read(ctx, &resp.Diagnostics)
 
+	if !typeMetadataFields(r.auth, r.client, request, metadataResourceName, &resp.Diagnostics, metadata.Fields) {
+		return
+	}
+
+	applyDefaultTags(r.auth, request, metadata.SkipDefaultTags)
+
+	if !newTagResolver(r.auth, r.client).validate(metadataResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.MetadataAPI.UpdateMetadata(r.auth, request.GetId()).MetadataResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, metadataResourceName, err))
@@ -225,9 +287,12 @@ func (r *MetadataResource) Update(ctx context.Context, req resource.UpdateReques
 	tflog.Trace(ctx, "updated "+metadataResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct.
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Metadata
+	var state MetadataResourceData
 
+	state.writeFields(&metadata.Metadata)
+	removeDefaultTags(ctx, r.auth, response, metadata.Tags, metadata.SkipDefaultTags)
 	state.write(ctx, response, &resp.Diagnostics)
+	state.SkipDefaultTags = metadata.SkipDefaultTags
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -268,6 +333,7 @@ func (m *Metadata) write(ctx context.Context, metadata *lidarr.MetadataResource,
 	m.Tags, localDiag = types.SetValueFrom(ctx, types.Int64Type, metadata.Tags)
 	diags.Append(localDiag...)
 	helpers.WriteFields(ctx, m, metadata.GetFields(), metadataFields)
+	m.Fields = helpers.WriteMapFields(ctx, metadata.GetFields(), metadataFields, m.Fields)
 }
 
 func (m *Metadata) read(ctx context.Context, diags *diag.Diagnostics) *lidarr.MetadataResource {
@@ -278,7 +344,63 @@ func (m *Metadata) read(ctx context.Context, diags *diag.Diagnostics) *lidarr.Me
 	metadata.SetImplementation(m.Implementation.ValueString())
 	metadata.SetName(m.Name.ValueString())
 	diags.Append(m.Tags.ElementsAs(ctx, &metadata.Tags, true)...)
-	metadata.SetFields(helpers.ReadFields(ctx, m, metadataFields))
+	metadata.SetFields(helpers.MergeFields(helpers.ReadFields(ctx, m, metadataFields), helpers.ReadMapFields(ctx, m.Fields)))
 
 	return metadata
 }
+
+// writeFields copy the configured raw fields from another resource.
+func (m *Metadata) writeFields(metadata *Metadata) {
+	if !metadata.Fields.IsUnknown() {
+		m.Fields = metadata.Fields
+	}
+}
+
+// findMetadata returns the first metadata consumer with the given implementation, or nil if there is none.
+func findMetadata(metadatas []lidarr.MetadataResource, implementation string) *lidarr.MetadataResource {
+	for i := range metadatas {
+		if metadatas[i].GetImplementation() == implementation {
+			return &metadatas[i]
+		}
+	}
+
+	return nil
+}
+
+// adoptMetadata updates the existing consumer of the request implementation, Lidarr ships one per implementation,
+// and only creates a new consumer when none is found.
+func adoptMetadata(auth context.Context, client *lidarr.APIClient, request *lidarr.MetadataResource) (*lidarr.MetadataResource, error) {
+	metadatas, _, err := client.MetadataAPI.ListMetadata(auth).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	existing := findMetadata(metadatas, request.GetImplementation())
+	if existing == nil {
+		response, _, err := client.MetadataAPI.CreateMetadata(auth).MetadataResource(*request).Execute()
+
+		return response, err
+	}
+
+	request.SetId(existing.GetId())
+	response, _, err := client.MetadataAPI.UpdateMetadata(auth, existing.GetId()).MetadataResource(*request).Execute()
+
+	return response, err
+}
+
+// disableMetadata turns off the given consumer instead of deleting it, so that it can be adopted again.
+func disableMetadata(auth context.Context, client *lidarr.APIClient, id int32) error {
+	metadata, httpResp, err := client.MetadataAPI.GetMetadataById(auth, id).Execute()
+	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			return nil
+		}
+
+		return err
+	}
+
+	metadata.SetEnable(false)
+	_, _, err = client.MetadataAPI.UpdateMetadata(auth, id).MetadataResource(*metadata).Execute()
+
+	return err
+}
@@ -1,11 +1,17 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
 
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccMetadataResource(t *testing.T) {
@@ -46,6 +52,15 @@ func TestAccMetadataResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Replace testing
+			{
+				Config: testAccMetadataResourceReplaceConfig("resourceTest"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("lidarr_metadata.test", plancheck.ResourceActionReplace),
+					},
+				},
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -61,3 +76,171 @@ func testAccMetadataResourceConfig(name, metadata string) string {
 		track_metadata = %s
 	}`, name, metadata)
 }
+
+func testAccMetadataResourceReplaceConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "lidarr_metadata" "test" {
+		enable = true
+		name = "%s"
+		implementation = "RoksboxMetadata"
+		config_contract = "RoksboxMetadataSettings"
+		track_metadata = true
+	}`, name)
+}
+
+func TestAccMetadataResourceFields(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Managed field testing
+			{
+				Config:      testAccMetadataResourceFieldsConfig("resourceFieldsTest", "true", `fields = { "trackMetadata" = "true" }`),
+				ExpectError: regexp.MustCompile("Conflicting Extra Field"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccMetadataResourceFieldsConfig("resourceFieldsTest", "true", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("lidarr_metadata.test", "fields.trackMetadata"),
+					resource.TestCheckResourceAttr("lidarr_metadata.test", "track_metadata", "true"),
+					resource.TestCheckResourceAttrSet("lidarr_metadata.test", "id"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccMetadataResourceFieldsConfig("resourceFieldsTest", "false", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lidarr_metadata.test", "track_metadata", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "lidarr_metadata.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccMetadataResourceFieldsConfig(name, metadata, fields string) string {
+	return fmt.Sprintf(`
+	resource "lidarr_metadata" "test" {
+		enable = false
+		name = "%s"
+		implementation = "WdtvMetadata"
+		config_contract = "WdtvMetadataSettings"
+		track_metadata = %s
+		%s
+	}`, name, metadata, fields)
+}
+
+func TestMetadataWrite(t *testing.T) {
+	t.Parallel()
+
+	field := func(name string, value interface{}) lidarr.Field {
+		f := lidarr.NewField()
+		f.SetName(name)
+		f.SetValue(value)
+
+		return *f
+	}
+
+	tests := map[string]struct {
+		fields   []lidarr.Field
+		expected map[string]string
+	}{
+		"no fields": {
+			fields:   nil,
+			expected: map[string]string{},
+		},
+		"known fields": {
+			fields:   []lidarr.Field{field("trackMetadata", true)},
+			expected: map[string]string{},
+		},
+		"unknown fields": {
+			fields:   []lidarr.Field{field("trackMetadata", true), field("nfoFormat", "kodi")},
+			expected: map[string]string{"nfoFormat": "kodi"},
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			response := lidarr.NewMetadataResource()
+			response.SetName("Fork")
+			response.SetImplementation("ForkMetadata")
+			response.SetFields(test.fields)
+
+			var (
+				data   Metadata
+				fields map[string]string
+				diags  diag.Diagnostics
+			)
+
+			data.write(context.Background(), response, &diags)
+			assert.False(t, diags.HasError())
+			assert.Equal(t, types.StringValue("ForkMetadata"), data.Implementation)
+			assert.Empty(t, data.Fields.ElementsAs(context.Background(), &fields, false))
+			assert.Equal(t, test.expected, fields)
+		})
+	}
+}
+
+func TestFindMetadata(t *testing.T) {
+	t.Parallel()
+
+	metadata := func(id int32, implementation string) lidarr.MetadataResource {
+		m := lidarr.NewMetadataResource()
+		m.SetId(id)
+		m.SetImplementation(implementation)
+
+		return *m
+	}
+
+	metadatas := []lidarr.MetadataResource{
+		metadata(1, metadataKodiImplementation),
+		metadata(2, metadataRoksboxImplementation),
+		metadata(3, metadataKodiImplementation),
+	}
+
+	tests := map[string]struct {
+		implementation string
+		expected       int32
+	}{
+		"first match": {
+			implementation: metadataKodiImplementation,
+			expected:       1,
+		},
+		"single match": {
+			implementation: metadataRoksboxImplementation,
+			expected:       2,
+		},
+		"no match": {
+			implementation: metadataWdtvImplementation,
+			expected:       0,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			found := findMetadata(metadatas, test.implementation)
+			if test.expected == 0 {
+				assert.Nil(t, found)
+
+				return
+			}
+
+			assert.Equal(t, test.expected, found.GetId())
+		})
+	}
+}
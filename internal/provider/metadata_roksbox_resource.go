@@ -40,13 +40,14 @@ type MetadataRoksboxResource struct {
 
 // MetadataRoksbox describes the Roksbox metadata data model.
 type MetadataRoksbox struct {
-	Tags          types.Set    `tfsdk:"tags"`
-	Name          types.String `tfsdk:"name"`
-	ID            types.Int64  `tfsdk:"id"`
-	Enable        types.Bool   `tfsdk:"enable"`
-	ArtistImages  types.Bool   `tfsdk:"artist_images"`
-	AlbumImages   types.Bool   `tfsdk:"album_images"`
-	TrackMetadata types.Bool   `tfsdk:"track_metadata"`
+	Tags            types.Set    `tfsdk:"tags"`
+	Name            types.String `tfsdk:"name"`
+	ID              types.Int64  `tfsdk:"id"`
+	Enable          types.Bool   `tfsdk:"enable"`
+	ArtistImages    types.Bool   `tfsdk:"artist_images"`
+	AlbumImages     types.Bool   `tfsdk:"album_images"`
+	TrackMetadata   types.Bool   `tfsdk:"track_metadata"`
+	SkipDefaultTags types.Bool   `tfsdk:"skip_default_tags"`
 }
 
 func (m MetadataRoksbox) toMetadata() *Metadata {
@@ -79,7 +80,7 @@ func (r *MetadataRoksboxResource) Metadata(_ context.Context, req resource.Metad
 
 func (r *MetadataRoksboxResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "<!-- subcategory:Metadata -->\nMetadata Roksbox resource.\nFor more information refer to [Metadata](https://wiki.servarr.com/lidarr/settings#metadata) and [ROKSBOX](https://wiki.servarr.com/lidarr/supported#roksboxmetadata).",
+		MarkdownDescription: "<!-- subcategory:Metadata -->\nMetadata Roksbox resource.\nThe existing Roksbox consumer is adopted on create and disabled on destroy.\nFor more information refer to [Metadata](https://wiki.servarr.com/lidarr/settings#metadata) and [ROKSBOX](https://wiki.servarr.com/lidarr/supported#roksboxmetadata).",
 		Attributes: map[string]schema.Attribute{
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
@@ -96,6 +97,7 @@ func (r *MetadataRoksboxResource) Schema(_ context.Context, _ resource.SchemaReq
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"skip_default_tags": skipDefaultTagsAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Metadata ID.",
 				Computed:            true,
@@ -137,18 +139,26 @@ func (r *MetadataRoksboxResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	// Create new MetadataRoksbox
+	// Adopt the existing MetadataRoksbox or create a new one
 	request := metadata.read(ctx, &resp.Diagnostics)
+	applyDefaultTags(r.auth, request, metadata.SkipDefaultTags)
 
-	response, _, err := r.client.MetadataAPI.CreateMetadata(r.auth).MetadataResource(*request).Execute()
+	if !newTagResolver(r.auth, r.client).validate(metadataRoksboxResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	response, err := adoptMetadata(r.auth, r.client, request)
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, metadataRoksboxResourceName, err))
 
 		return
 	}
 
+	response = helpers.ReadAfterCreate(ctx, response, metadataRoksboxResourceName, r.client.MetadataAPI.GetMetadataById(r.auth, response.GetId()).Execute, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+metadataRoksboxResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, metadata.Tags, metadata.SkipDefaultTags)
 	metadata.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &metadata)...)
 }
@@ -164,8 +174,15 @@ func (r *MetadataRoksboxResource) Read(ctx context.Context, req resource.ReadReq
 	}
 
 	// Get MetadataRoksbox current value
-	response, _, err := r.client.MetadataAPI.GetMetadataById(r.auth, int32(metadata.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.MetadataAPI.GetMetadataById(r.auth, int32(metadata.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			resp.Diagnostics.AddWarning(helpers.ResourceNotFound, helpers.ParseResourceNotFound(metadataRoksboxResourceName, metadata.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, metadataRoksboxResourceName, err))
 
 		return
@@ -173,6 +190,7 @@ func (r *MetadataRoksboxResource) Read(ctx context.Context, req resource.ReadReq
 
 	tflog.Trace(ctx, "read "+metadataRoksboxResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	removeDefaultTags(ctx, r.auth, response, metadata.Tags, metadata.SkipDefaultTags)
 	metadata.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &metadata)...)
 }
@@ -189,6 +207,11 @@ func (r *MetadataRoksboxResource) Update(ctx context.Context, req resource.Updat
 
 	// Update MetadataRoksbox
 	request := metadata.read(ctx, &resp.Diagnostics)
+	applyDefaultTags(r.auth, request, metadata.SkipDefaultTags)
+
+	if !newTagResolver(r.auth, r.client).validate(metadataRoksboxResourceName, request, &resp.Diagnostics) {
+		return
+	}
 
 	response, _, err := r.client.MetadataAPI.UpdateMetadata(r.auth, request.GetId()).MetadataResource(*request).Execute()
 	if err != nil {
@@ -199,6 +222,7 @@ func (r *MetadataRoksboxResource) Update(ctx context.Context, req resource.Updat
 
 	tflog.Trace(ctx, "updated "+metadataRoksboxResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, metadata.Tags, metadata.SkipDefaultTags)
 	metadata.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &metadata)...)
 }
@@ -212,15 +236,15 @@ func (r *MetadataRoksboxResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
-	// Delete MetadataRoksbox current value
-	_, err := r.client.MetadataAPI.DeleteMetadata(r.auth, int32(ID)).Execute()
+	// Disable MetadataRoksbox, the consumer is kept for the next adoption
+	err := disableMetadata(r.auth, r.client, int32(ID))
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, metadataRoksboxResourceName, err))
 
 		return
 	}
 
-	tflog.Trace(ctx, "deleted "+metadataRoksboxResourceName+": "+strconv.Itoa(int(ID)))
+	tflog.Trace(ctx, "disabled "+metadataRoksboxResourceName+": "+strconv.Itoa(int(ID)))
 	resp.State.RemoveResource(ctx)
 }
 
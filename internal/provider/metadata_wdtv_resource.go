@@ -40,11 +40,12 @@ type MetadataWdtvResource struct {
 
 // MetadataWdtv describes the Wdtv metadata data model.
 type MetadataWdtv struct {
-	Tags          types.Set    `tfsdk:"tags"`
-	Name          types.String `tfsdk:"name"`
-	ID            types.Int64  `tfsdk:"id"`
-	Enable        types.Bool   `tfsdk:"enable"`
-	TrackMetadata types.Bool   `tfsdk:"track_metadata"`
+	Tags            types.Set    `tfsdk:"tags"`
+	Name            types.String `tfsdk:"name"`
+	ID              types.Int64  `tfsdk:"id"`
+	Enable          types.Bool   `tfsdk:"enable"`
+	TrackMetadata   types.Bool   `tfsdk:"track_metadata"`
+	SkipDefaultTags types.Bool   `tfsdk:"skip_default_tags"`
 }
 
 func (m MetadataWdtv) toMetadata() *Metadata {
@@ -73,7 +74,7 @@ func (r *MetadataWdtvResource) Metadata(_ context.Context, req resource.Metadata
 
 func (r *MetadataWdtvResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "<!-- subcategory:Metadata -->\nMetadata Wdtv resource.\nFor more information refer to [Metadata](https://wiki.servarr.com/lidarr/settings#metadata) and [WDTV](https://wiki.servarr.com/lidarr/supported#wdtvmetadata).",
+		MarkdownDescription: "<!-- subcategory:Metadata -->\nMetadata Wdtv resource.\nThe existing WDTV consumer is adopted on create and disabled on destroy.\nFor more information refer to [Metadata](https://wiki.servarr.com/lidarr/settings#metadata) and [WDTV](https://wiki.servarr.com/lidarr/supported#wdtvmetadata).",
 		Attributes: map[string]schema.Attribute{
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
@@ -90,6 +91,7 @@ func (r *MetadataWdtvResource) Schema(_ context.Context, _ resource.SchemaReques
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"skip_default_tags": skipDefaultTagsAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Metadata ID.",
 				Computed:            true,
@@ -123,18 +125,26 @@ func (r *MetadataWdtvResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
-	// Create new MetadataWdtv
+	// Adopt the existing MetadataWdtv or create a new one
 	request := metadata.read(ctx, &resp.Diagnostics)
+	applyDefaultTags(r.auth, request, metadata.SkipDefaultTags)
 
-	response, _, err := r.client.MetadataAPI.CreateMetadata(r.auth).MetadataResource(*request).Execute()
+	if !newTagResolver(r.auth, r.client).validate(metadataWdtvResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	response, err := adoptMetadata(r.auth, r.client, request)
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, metadataWdtvResourceName, err))
 
 		return
 	}
 
+	response = helpers.ReadAfterCreate(ctx, response, metadataWdtvResourceName, r.client.MetadataAPI.GetMetadataById(r.auth, response.GetId()).Execute, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+metadataWdtvResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, metadata.Tags, metadata.SkipDefaultTags)
 	metadata.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &metadata)...)
 }
@@ -150,8 +160,15 @@ func (r *MetadataWdtvResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Get MetadataWdtv current value
-	response, _, err := r.client.MetadataAPI.GetMetadataById(r.auth, int32(metadata.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.MetadataAPI.GetMetadataById(r.auth, int32(metadata.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			resp.Diagnostics.AddWarning(helpers.ResourceNotFound, helpers.ParseResourceNotFound(metadataWdtvResourceName, metadata.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, metadataWdtvResourceName, err))
 
 		return
@@ -159,6 +176,7 @@ func (r *MetadataWdtvResource) Read(ctx context.Context, req resource.ReadReques
 
 	tflog.Trace(ctx, "read "+metadataWdtvResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	removeDefaultTags(ctx, r.auth, response, metadata.Tags, metadata.SkipDefaultTags)
 	metadata.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &metadata)...)
 }
@@ -175,6 +193,11 @@ func (r *MetadataWdtvResource) Update(ctx context.Context, req resource.UpdateRe
 
 	// Update MetadataWdtv
 	request := metadata.read(ctx, &resp.Diagnostics)
+	applyDefaultTags(r.auth, request, metadata.SkipDefaultTags)
+
+	if !newTagResolver(r.auth, r.client).validate(metadataWdtvResourceName, request, &resp.Diagnostics) {
+		return
+	}
 
 	response, _, err := r.client.MetadataAPI.UpdateMetadata(r.auth, request.GetId()).MetadataResource(*request).Execute()
 	if err != nil {
@@ -185,6 +208,7 @@ func (r *MetadataWdtvResource) Update(ctx context.Context, req resource.UpdateRe
 
 	tflog.Trace(ctx, "updated "+metadataWdtvResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, metadata.Tags, metadata.SkipDefaultTags)
 	metadata.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &metadata)...)
 }
@@ -198,15 +222,15 @@ func (r *MetadataWdtvResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
-	// Delete MetadataWdtv current value
-	_, err := r.client.MetadataAPI.DeleteMetadata(r.auth, int32(ID)).Execute()
+	// Disable MetadataWdtv, the consumer is kept for the next adoption
+	err := disableMetadata(r.auth, r.client, int32(ID))
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, metadataWdtvResourceName, err))
 
 		return
 	}
 
-	tflog.Trace(ctx, "deleted "+metadataWdtvResourceName+": "+strconv.Itoa(int(ID)))
+	tflog.Trace(ctx, "disabled "+metadataWdtvResourceName+": "+strconv.Itoa(int(ID)))
 	resp.State.RemoveResource(ctx)
 }
 
@@ -11,8 +11,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -26,8 +24,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &NotificationAppriseResource{}
-	_ resource.ResourceWithImportState = &NotificationAppriseResource{}
+	_ resource.Resource                     = &NotificationAppriseResource{}
+	_ resource.ResourceWithImportState      = &NotificationAppriseResource{}
+	_ resource.ResourceWithConfigValidators = &NotificationAppriseResource{}
+	_ resource.ResourceWithUpgradeState     = &NotificationAppriseResource{}
 )
 
 func NewNotificationAppriseResource() resource.Resource {
@@ -42,6 +42,8 @@ type NotificationAppriseResource struct {
 
 // NotificationApprise describes the notification data model.
 type NotificationApprise struct {
+	TagLabels
+	ExtraFields           types.Map    `tfsdk:"extra_fields"`
 	Tags                  types.Set    `tfsdk:"tags"`
 	FieldTags             types.Set    `tfsdk:"field_tags"`
 	Name                  types.String `tfsdk:"name"`
@@ -52,9 +54,11 @@ type NotificationApprise struct {
 	ConfigurationKey      types.String `tfsdk:"configuration_key"`
 	NotificationType      types.Int64  `tfsdk:"notification_type"`
 	ID                    types.Int64  `tfsdk:"id"`
+	TestOnCreate          types.Bool   `tfsdk:"test_on_create"`
 	OnGrab                types.Bool   `tfsdk:"on_grab"`
 	OnReleaseImport       types.Bool   `tfsdk:"on_release_import"`
 	OnAlbumDelete         types.Bool   `tfsdk:"on_album_delete"`
+	OnArtistAdd           types.Bool   `tfsdk:"on_artist_add"`
 	OnArtistDelete        types.Bool   `tfsdk:"on_artist_delete"`
 	IncludeHealthWarnings types.Bool   `tfsdk:"include_health_warnings"`
 	OnApplicationUpdate   types.Bool   `tfsdk:"on_application_update"`
@@ -63,10 +67,12 @@ type NotificationApprise struct {
 	OnDownloadFailure     types.Bool   `tfsdk:"on_download_failure"`
 	OnUpgrade             types.Bool   `tfsdk:"on_upgrade"`
 	OnImportFailure       types.Bool   `tfsdk:"on_import_failure"`
+	SkipDefaultTags       types.Bool   `tfsdk:"skip_default_tags"`
 }
 
 func (n NotificationApprise) toNotification() *Notification {
 	return &Notification{
+		Fields:                n.ExtraFields,
 		Tags:                  n.Tags,
 		FieldTags:             n.FieldTags,
 		StatelessURLs:         n.StatelessURLs,
@@ -80,6 +86,7 @@ func (n NotificationApprise) toNotification() *Notification {
 		OnGrab:                n.OnGrab,
 		OnReleaseImport:       n.OnReleaseImport,
 		OnAlbumDelete:         n.OnAlbumDelete,
+		OnArtistAdd:           n.OnArtistAdd,
 		OnArtistDelete:        n.OnArtistDelete,
 		IncludeHealthWarnings: n.IncludeHealthWarnings,
 		OnApplicationUpdate:   n.OnApplicationUpdate,
@@ -107,6 +114,7 @@ func (n *NotificationApprise) fromNotification(notification *Notification) {
 	n.OnGrab = notification.OnGrab
 	n.OnReleaseImport = notification.OnReleaseImport
 	n.OnAlbumDelete = notification.OnAlbumDelete
+	n.OnArtistAdd = notification.OnArtistAdd
 	n.OnArtistDelete = notification.OnArtistDelete
 	n.IncludeHealthWarnings = notification.IncludeHealthWarnings
 	n.OnApplicationUpdate = notification.OnApplicationUpdate
@@ -115,6 +123,10 @@ func (n *NotificationApprise) fromNotification(notification *Notification) {
 	n.OnDownloadFailure = notification.OnDownloadFailure
 	n.OnUpgrade = notification.OnUpgrade
 	n.OnImportFailure = notification.OnImportFailure
+
+	if !n.ExtraFields.IsNull() {
+		n.ExtraFields = notification.Fields
+	}
 }
 
 func (r *NotificationAppriseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -123,125 +135,61 @@ func (r *NotificationAppriseResource) Metadata(_ context.Context, req resource.M
 
 func (r *NotificationAppriseResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             1,
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification Apprise resource.\nFor more information refer to [Notification](https://wiki.servarr.com/lidarr/settings#connect) and [Apprise](https://wiki.servarr.com/lidarr/supported#apprise).",
-		Attributes: map[string]schema.Attribute{
-			"on_grab": schema.BoolAttribute{
-				MarkdownDescription: "On grab flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_import_failure": schema.BoolAttribute{
-				MarkdownDescription: "On download flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_upgrade": schema.BoolAttribute{
-				MarkdownDescription: "On upgrade flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_download_failure": schema.BoolAttribute{
-				MarkdownDescription: "On download failure flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_release_import": schema.BoolAttribute{
-				MarkdownDescription: "On release import flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_album_delete": schema.BoolAttribute{
-				MarkdownDescription: "On album delete flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_artist_delete": schema.BoolAttribute{
-				MarkdownDescription: "On artist delete flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_health_issue": schema.BoolAttribute{
-				MarkdownDescription: "On health issue flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_health_restored": schema.BoolAttribute{
-				MarkdownDescription: "On health restored flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_application_update": schema.BoolAttribute{
-				MarkdownDescription: "On application update flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"include_health_warnings": schema.BoolAttribute{
-				MarkdownDescription: "Include health warnings.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "NotificationApprise name.",
-				Required:            true,
-			},
-			"tags": schema.SetAttribute{
-				MarkdownDescription: "List of associated tags.",
-				Optional:            true,
-				Computed:            true,
-				ElementType:         types.Int64Type,
-			},
-			"id": schema.Int64Attribute{
-				MarkdownDescription: "Notification ID.",
-				Computed:            true,
-				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.UseStateForUnknown(),
+		Attributes: notificationResourceAttributes(
+			[]string{"on_grab", "on_release_import", "on_upgrade", "on_artist_add", "on_artist_delete", "on_album_delete", "on_health_issue", "on_health_restored", "on_download_failure", "on_import_failure", "on_application_update"},
+			map[string]schema.Attribute{
+				// Field values
+				"notification_type": schema.Int64Attribute{
+					MarkdownDescription: "Notification type. `0` Info, `1` Success, `2` Warning, `3` Failure.",
+					Optional:            true,
+					Computed:            true,
+					Validators: []validator.Int64{
+						int64validator.OneOf(0, 1, 2, 3),
+					},
 				},
-			},
-			// Field values
-			"notification_type": schema.Int64Attribute{
-				MarkdownDescription: "Notification type. `0` Info, `1` Success, `2` Warning, `3` Failure.",
-				Optional:            true,
-				Computed:            true,
-				Validators: []validator.Int64{
-					int64validator.OneOf(0, 1, 2, 3),
+				"server_url": schema.StringAttribute{
+					MarkdownDescription: "Server URL.",
+					Required:            true,
+				},
+				"stateless_urls": schema.StringAttribute{
+					MarkdownDescription: "Stateless URLs.",
+					Optional:            true,
+					Computed:            true,
+				},
+				"configuration_key": schema.StringAttribute{
+					MarkdownDescription: "Configuration key.",
+					Optional:            true,
+					Computed:            true,
+					Sensitive:           true,
+				},
+				"auth_username": schema.StringAttribute{
+					MarkdownDescription: "Username.",
+					Optional:            true,
+					Computed:            true,
+				},
+				"auth_password": schema.StringAttribute{
+					MarkdownDescription: "Password.",
+					Optional:            true,
+					Computed:            true,
+					Sensitive:           true,
+				},
+				"field_tags": schema.SetAttribute{
+					MarkdownDescription: "Tags and emojis.",
+					Optional:            true,
+					Computed:            true,
+					ElementType:         types.StringType,
 				},
 			},
-			"server_url": schema.StringAttribute{
-				MarkdownDescription: "Server URL.",
-				Required:            true,
-			},
-			"stateless_urls": schema.StringAttribute{
-				MarkdownDescription: "Stateless URLs.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"configuration_key": schema.StringAttribute{
-				MarkdownDescription: "Configuration key.",
-				Optional:            true,
-				Computed:            true,
-				Sensitive:           true,
-			},
-			"auth_username": schema.StringAttribute{
-				MarkdownDescription: "Username.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"auth_password": schema.StringAttribute{
-				MarkdownDescription: "Password.",
-				Optional:            true,
-				Computed:            true,
-				Sensitive:           true,
-			},
-			"field_tags": schema.SetAttribute{
-				MarkdownDescription: "Tags and emojis.",
-				Optional:            true,
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
+		),
 	}
 }
 
+func (r *NotificationAppriseResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return notificationUpgradeState(ctx, r, notificationAttributesV1, "on_artist_add")
+}
+
 func (r *NotificationAppriseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -259,9 +207,34 @@ func (r *NotificationAppriseResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new NotificationApprise
 	request := notification.read(ctx, &resp.Diagnostics)
 
+	if !typeNotificationFields(r.auth, r.client, request, notificationAppriseResourceName, &resp.Diagnostics, notification.ExtraFields) {
+		return
+	}
+
+	applyDefaultTags(r.auth, request, notification.SkipDefaultTags)
+
+	if !resolver.validate(notificationAppriseResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	if !validateNotificationTriggers(r.auth, r.client, request, notificationAppriseResourceName, &resp.Diagnostics) {
+		return
+	}
+
+	if notification.TestOnCreate.ValueBool() && !testNotification(r.auth, r.client, request, notificationAppriseResourceName, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationAppriseResourceName, err))
@@ -269,9 +242,13 @@ func (r *NotificationAppriseResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	response = helpers.ReadAfterCreate(ctx, response, notificationAppriseResourceName, r.client.NotificationAPI.GetNotificationById(r.auth, response.GetId()).Execute, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+notificationAppriseResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, notification.Tags, notification.SkipDefaultTags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &notification.TagLabels, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -286,8 +263,15 @@ func (r *NotificationAppriseResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get NotificationApprise current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			resp.Diagnostics.AddWarning(helpers.ResourceNotFound, helpers.ParseResourceNotFound(notificationAppriseResourceName, notification.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationAppriseResourceName, err))
 
 		return
@@ -295,7 +279,11 @@ func (r *NotificationAppriseResource) Read(ctx context.Context, req resource.Rea
 
 	tflog.Trace(ctx, "read "+notificationAppriseResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	removeDefaultTags(ctx, r.auth, response, notification.Tags, notification.SkipDefaultTags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	newTagResolver(r.auth, r.client).write(ctx, &notification.TagLabels, notification.Tags, &resp.Diagnostics)
+	// Test flag is not stored by Lidarr, keep the state value or use the default on import
+	notification.TestOnCreate = types.BoolValue(notification.TestOnCreate.ValueBool())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -309,9 +297,31 @@ func (r *NotificationAppriseResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update NotificationApprise
 	request := notification.read(ctx, &resp.Diagnostics)
 
+	if !typeNotificationFields(r.auth, r.client, request, notificationAppriseResourceName, &resp.Diagnostics, notification.ExtraFields) {
+		return
+	}
+
+	preserveNotificationFields(r.auth, r.client, request)
+	applyDefaultTags(r.auth, request, notification.SkipDefaultTags)
+
+	if !resolver.validate(notificationAppriseResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	if !validateNotificationTriggers(r.auth, r.client, request, notificationAppriseResourceName, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationAppriseResourceName, err))
@@ -321,7 +331,9 @@ func (r *NotificationAppriseResource) Update(ctx context.Context, req resource.U
 
 	tflog.Trace(ctx, "updated "+notificationAppriseResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, notification.Tags, notification.SkipDefaultTags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &notification.TagLabels, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -347,10 +359,16 @@ func (r *NotificationAppriseResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *NotificationAppriseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	importNotificationState(ctx, r.auth, r.client, notificationAppriseImplementation, req, resp)
 	tflog.Trace(ctx, "imported "+notificationAppriseResourceName+": "+req.ID)
 }
 
+func (r *NotificationAppriseResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		notificationTriggersValidator{auth: r.auth},
+	}
+}
+
 func (n *NotificationApprise) write(ctx context.Context, notification *lidarr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
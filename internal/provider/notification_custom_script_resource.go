@@ -10,8 +10,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -24,8 +22,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &NotificationCustomScriptResource{}
-	_ resource.ResourceWithImportState = &NotificationCustomScriptResource{}
+	_ resource.Resource                     = &NotificationCustomScriptResource{}
+	_ resource.ResourceWithImportState      = &NotificationCustomScriptResource{}
+	_ resource.ResourceWithConfigValidators = &NotificationCustomScriptResource{}
+	_ resource.ResourceWithUpgradeState     = &NotificationCustomScriptResource{}
 )
 
 func NewNotificationCustomScriptResource() resource.Resource {
@@ -40,14 +40,18 @@ type NotificationCustomScriptResource struct {
 
 // NotificationCustomScript describes the notification data model.
 type NotificationCustomScript struct {
+	TagLabels
+	ExtraFields           types.Map    `tfsdk:"extra_fields"`
 	Tags                  types.Set    `tfsdk:"tags"`
 	Arguments             types.String `tfsdk:"arguments"`
 	Path                  types.String `tfsdk:"path"`
 	Name                  types.String `tfsdk:"name"`
 	ID                    types.Int64  `tfsdk:"id"`
+	TestOnCreate          types.Bool   `tfsdk:"test_on_create"`
 	OnGrab                types.Bool   `tfsdk:"on_grab"`
 	OnReleaseImport       types.Bool   `tfsdk:"on_release_import"`
 	OnAlbumDelete         types.Bool   `tfsdk:"on_album_delete"`
+	OnArtistAdd           types.Bool   `tfsdk:"on_artist_add"`
 	OnArtistDelete        types.Bool   `tfsdk:"on_artist_delete"`
 	OnUpgrade             types.Bool   `tfsdk:"on_upgrade"`
 	OnRename              types.Bool   `tfsdk:"on_rename"`
@@ -58,10 +62,12 @@ type NotificationCustomScript struct {
 	OnTrackRetag          types.Bool   `tfsdk:"on_track_retag"`
 	IncludeHealthWarnings types.Bool   `tfsdk:"include_health_warnings"`
 	OnApplicationUpdate   types.Bool   `tfsdk:"on_application_update"`
+	SkipDefaultTags       types.Bool   `tfsdk:"skip_default_tags"`
 }
 
 func (n NotificationCustomScript) toNotification() *Notification {
 	return &Notification{
+		Fields:                n.ExtraFields,
 		Tags:                  n.Tags,
 		Path:                  n.Path,
 		Arguments:             n.Arguments,
@@ -70,6 +76,7 @@ func (n NotificationCustomScript) toNotification() *Notification {
 		OnGrab:                n.OnGrab,
 		OnReleaseImport:       n.OnReleaseImport,
 		OnAlbumDelete:         n.OnAlbumDelete,
+		OnArtistAdd:           n.OnArtistAdd,
 		OnArtistDelete:        n.OnArtistDelete,
 		OnDownloadFailure:     n.OnDownloadFailure,
 		IncludeHealthWarnings: n.IncludeHealthWarnings,
@@ -100,10 +107,15 @@ func (n *NotificationCustomScript) fromNotification(notification *Notification)
 	n.OnHealthRestored = notification.OnHealthRestored
 	n.OnReleaseImport = notification.OnReleaseImport
 	n.OnAlbumDelete = notification.OnAlbumDelete
+	n.OnArtistAdd = notification.OnArtistAdd
 	n.OnArtistDelete = notification.OnArtistDelete
 	n.OnRename = notification.OnRename
 	n.OnUpgrade = notification.OnUpgrade
 	n.OnImportFailure = notification.OnImportFailure
+
+	if !n.ExtraFields.IsNull() {
+		n.ExtraFields = notification.Fields
+	}
 }
 
 func (r *NotificationCustomScriptResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -112,104 +124,30 @@ func (r *NotificationCustomScriptResource) Metadata(_ context.Context, req resou
 
 func (r *NotificationCustomScriptResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             1,
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification Custom Script resource.\nFor more information refer to [Notification](https://wiki.servarr.com/lidarr/settings#connect) and [Custom Script](https://wiki.servarr.com/lidarr/supported#customscript).",
-		Attributes: map[string]schema.Attribute{
-			"on_grab": schema.BoolAttribute{
-				MarkdownDescription: "On grab flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_release_import": schema.BoolAttribute{
-				MarkdownDescription: "On release import flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_album_delete": schema.BoolAttribute{
-				MarkdownDescription: "On album delete flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_artist_delete": schema.BoolAttribute{
-				MarkdownDescription: "On artist delete flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_upgrade": schema.BoolAttribute{
-				MarkdownDescription: "On upgrade flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_rename": schema.BoolAttribute{
-				MarkdownDescription: "On rename flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_download_failure": schema.BoolAttribute{
-				MarkdownDescription: "On download failure flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_import_failure": schema.BoolAttribute{
-				MarkdownDescription: "On import failure flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_track_retag": schema.BoolAttribute{
-				MarkdownDescription: "On track retag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_health_issue": schema.BoolAttribute{
-				MarkdownDescription: "On health issue flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_health_restored": schema.BoolAttribute{
-				MarkdownDescription: "On health restored flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_application_update": schema.BoolAttribute{
-				MarkdownDescription: "On application update flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"include_health_warnings": schema.BoolAttribute{
-				MarkdownDescription: "Include health warnings.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "NotificationCustomScript name.",
-				Required:            true,
-			},
-			"tags": schema.SetAttribute{
-				MarkdownDescription: "List of associated tags.",
-				Optional:            true,
-				Computed:            true,
-				ElementType:         types.Int64Type,
-			},
-			"id": schema.Int64Attribute{
-				MarkdownDescription: "Notification ID.",
-				Computed:            true,
-				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.UseStateForUnknown(),
+		Attributes: notificationResourceAttributes(
+			[]string{"on_grab", "on_release_import", "on_upgrade", "on_rename", "on_artist_add", "on_artist_delete", "on_album_delete", "on_health_issue", "on_health_restored", "on_download_failure", "on_import_failure", "on_track_retag", "on_application_update"},
+			map[string]schema.Attribute{
+				// Field values
+				"arguments": schema.StringAttribute{
+					MarkdownDescription: "Arguments.",
+					Optional:            true,
+					Computed:            true,
+				},
+				"path": schema.StringAttribute{
+					MarkdownDescription: "Path.",
+					Required:            true,
 				},
 			},
-			// Field values
-			"arguments": schema.StringAttribute{
-				MarkdownDescription: "Arguments.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"path": schema.StringAttribute{
-				MarkdownDescription: "Path.",
-				Required:            true,
-			},
-		},
+		),
 	}
 }
 
+func (r *NotificationCustomScriptResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return notificationUpgradeState(ctx, r, notificationAttributesV1, "on_artist_add")
+}
+
 func (r *NotificationCustomScriptResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -227,9 +165,34 @@ func (r *NotificationCustomScriptResource) Create(ctx context.Context, req resou
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new NotificationCustomScript
 	request := notification.read(ctx, &resp.Diagnostics)
 
+	if !typeNotificationFields(r.auth, r.client, request, notificationCustomScriptResourceName, &resp.Diagnostics, notification.ExtraFields) {
+		return
+	}
+
+	applyDefaultTags(r.auth, request, notification.SkipDefaultTags)
+
+	if !resolver.validate(notificationCustomScriptResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	if !validateNotificationTriggers(r.auth, r.client, request, notificationCustomScriptResourceName, &resp.Diagnostics) {
+		return
+	}
+
+	if notification.TestOnCreate.ValueBool() && !testNotification(r.auth, r.client, request, notificationCustomScriptResourceName, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationCustomScriptResourceName, err))
@@ -237,9 +200,13 @@ func (r *NotificationCustomScriptResource) Create(ctx context.Context, req resou
 		return
 	}
 
+	response = helpers.ReadAfterCreate(ctx, response, notificationCustomScriptResourceName, r.client.NotificationAPI.GetNotificationById(r.auth, response.GetId()).Execute, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+notificationCustomScriptResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, notification.Tags, notification.SkipDefaultTags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &notification.TagLabels, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -254,8 +221,15 @@ func (r *NotificationCustomScriptResource) Read(ctx context.Context, req resourc
 	}
 
 	// Get NotificationCustomScript current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			resp.Diagnostics.AddWarning(helpers.ResourceNotFound, helpers.ParseResourceNotFound(notificationCustomScriptResourceName, notification.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationCustomScriptResourceName, err))
 
 		return
@@ -263,7 +237,11 @@ func (r *NotificationCustomScriptResource) Read(ctx context.Context, req resourc
 
 	tflog.Trace(ctx, "read "+notificationCustomScriptResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	removeDefaultTags(ctx, r.auth, response, notification.Tags, notification.SkipDefaultTags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	newTagResolver(r.auth, r.client).write(ctx, &notification.TagLabels, notification.Tags, &resp.Diagnostics)
+	// Test flag is not stored by Lidarr, keep the state value or use the default on import
+	notification.TestOnCreate = types.BoolValue(notification.TestOnCreate.ValueBool())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -277,9 +255,31 @@ func (r *NotificationCustomScriptResource) Update(ctx context.Context, req resou
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update NotificationCustomScript
 	request := notification.read(ctx, &resp.Diagnostics)
 
+	if !typeNotificationFields(r.auth, r.client, request, notificationCustomScriptResourceName, &resp.Diagnostics, notification.ExtraFields) {
+		return
+	}
+
+	preserveNotificationFields(r.auth, r.client, request)
+	applyDefaultTags(r.auth, request, notification.SkipDefaultTags)
+
+	if !resolver.validate(notificationCustomScriptResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	if !validateNotificationTriggers(r.auth, r.client, request, notificationCustomScriptResourceName, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationCustomScriptResourceName, err))
@@ -289,7 +289,9 @@ func (r *NotificationCustomScriptResource) Update(ctx context.Context, req resou
 
 	tflog.Trace(ctx, "updated "+notificationCustomScriptResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, notification.Tags, notification.SkipDefaultTags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &notification.TagLabels, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -315,10 +317,16 @@ func (r *NotificationCustomScriptResource) Delete(ctx context.Context, req resou
 }
 
 func (r *NotificationCustomScriptResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	importNotificationState(ctx, r.auth, r.client, notificationCustomScriptImplementation, req, resp)
 	tflog.Trace(ctx, "imported "+notificationCustomScriptResourceName+": "+req.ID)
 }
 
+func (r *NotificationCustomScriptResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		notificationTriggersValidator{auth: r.auth},
+	}
+}
+
 func (n *NotificationCustomScript) write(ctx context.Context, notification *lidarr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
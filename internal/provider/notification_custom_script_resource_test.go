@@ -25,6 +25,7 @@ func TestAccNotificationCustomScriptResource(t *testing.T) {
 				Config: testAccNotificationCustomScriptResourceConfig("resourceScriptTest", "false"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("lidarr_notification_custom_script.test", "on_upgrade", "false"),
+					resource.TestCheckResourceAttr("lidarr_notification_custom_script.test", "on_album_delete", "true"),
 					resource.TestCheckResourceAttrSet("lidarr_notification_custom_script.test", "id"),
 				),
 			},
@@ -38,6 +39,7 @@ func TestAccNotificationCustomScriptResource(t *testing.T) {
 				Config: testAccNotificationCustomScriptResourceConfig("resourceScriptTest", "true"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("lidarr_notification_custom_script.test", "on_upgrade", "true"),
+					resource.TestCheckResourceAttr("lidarr_notification_custom_script.test", "on_artist_delete", "true"),
 				),
 			},
 			// ImportState testing
@@ -56,16 +58,18 @@ func testAccNotificationCustomScriptResourceConfig(name, upgrade string) string
 	resource "lidarr_notification_custom_script" "test" {
 		on_grab                            = false
 		on_track_retag                     = true
-		on_upgrade                         = %s
+		on_upgrade                         = %[1]s
 		on_rename                          = false
 		on_release_import                  = false
 		on_download_failure                = false
 		on_import_failure 				   = true
+		on_album_delete                    = true
+		on_artist_delete                   = %[1]s
 		on_health_issue                    = false
 		on_application_update              = false
 	  
 		include_health_warnings = false
-		name                    = "%s"
+		name                    = "%[2]s"
 	  
 		path = "/scripts/test.sh"
 	}`, upgrade, name)
@@ -15,7 +15,10 @@ import (
 const notificationDataSourceName = "notification"
 
 // Ensure provider defined types fully satisfy framework interfaces.
-var _ datasource.DataSource = &NotificationDataSource{}
+var (
+	_ datasource.DataSource                     = &NotificationDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &NotificationDataSource{}
+)
 
 func NewNotificationDataSource() datasource.DataSource {
 	return &NotificationDataSource{}
@@ -48,6 +51,10 @@ func (d *NotificationDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 				MarkdownDescription: "On album delete flag.",
 				Computed:            true,
 			},
+			"on_artist_add": schema.BoolAttribute{
+				MarkdownDescription: "On artist add flag.",
+				Computed:            true,
+			},
 			"on_artist_delete": schema.BoolAttribute{
 				MarkdownDescription: "On artist delete flag.",
 				Computed:            true,
@@ -69,7 +76,7 @@ func (d *NotificationDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 				Computed:            true,
 			},
 			"on_track_retag": schema.BoolAttribute{
-				MarkdownDescription: "On track retag.",
+				MarkdownDescription: "On track retag flag.",
 				Computed:            true,
 			},
 			"on_health_issue": schema.BoolAttribute{
@@ -98,7 +105,13 @@ func (d *NotificationDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Notification name.",
-				Required:            true,
+				Optional:            true,
+				Computed:            true,
+			},
+			"fields": schema.MapAttribute{
+				MarkdownDescription: "Raw field values for settings not covered by other attributes, keyed by API field name.",
+				Computed:            true,
+				ElementType:         types.StringType,
 			},
 			"tags": schema.SetAttribute{
 				MarkdownDescription: "List of associated tags.",
@@ -107,6 +120,7 @@ func (d *NotificationDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
+				Optional:            true,
 				Computed:            true,
 			},
 			// Field values
@@ -150,6 +164,14 @@ func (d *NotificationDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 				MarkdownDescription: "Port.",
 				Computed:            true,
 			},
+			"topic_id": schema.Int64Attribute{
+				MarkdownDescription: "Topic ID.",
+				Computed:            true,
+			},
+			"use_encryption": schema.Int64Attribute{
+				MarkdownDescription: "Use encryption. `0` Preferred, `1` Always, `2` Never.",
+				Computed:            true,
+			},
 			"method": schema.Int64Attribute{
 				MarkdownDescription: "Method. `1` POST, `2` PUT.",
 				Computed:            true,
@@ -413,6 +435,12 @@ func (d *NotificationDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 	}
 }
 
+func (d *NotificationDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		helpers.IDOrName("name"),
+	}
+}
+
 func (d *NotificationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
 		d.client = client
@@ -436,20 +464,20 @@ func (d *NotificationDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
-	data.find(ctx, data.Name.ValueString(), response, &resp.Diagnostics)
+	data.find(ctx, helpers.NewLookup(data.ID, "name", data.Name), response, &resp.Diagnostics)
 	tflog.Trace(ctx, "read "+notificationDataSourceName)
 	// Map response body to resource schema attribute
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (n *Notification) find(ctx context.Context, name string, notifications []lidarr.NotificationResource, diags *diag.Diagnostics) {
+func (n *Notification) find(ctx context.Context, lookup helpers.Lookup, notifications []lidarr.NotificationResource, diags *diag.Diagnostics) {
 	for _, notification := range notifications {
-		if notification.GetName() == name {
+		if lookup.Matches(notification.GetId(), notification.GetName()) {
 			n.write(ctx, &notification, diags)
 
 			return
 		}
 	}
 
-	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(notificationDataSourceName, "name", name))
+	diags.AddError(helpers.DataSourceError, lookup.NotFound(notificationDataSourceName, helpers.Values(notifications, (*lidarr.NotificationResource).GetName)))
 }
@@ -32,6 +32,17 @@ func TestAccNotificationDataSource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.lidarr_notification.test", "id"),
 					resource.TestCheckResourceAttr("data.lidarr_notification.test", "path", "/scripts/test.sh")),
 			},
+			// Conflicting lookup testing
+			{
+				Config:      testAccNotificationDataSourceConflictConfig,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			// Read by ID testing
+			{
+				Config: testAccNotificationResourceConfig("dataTest", "true") + testAccNotificationDataSourceIDConfig("lidarr_notification.test.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lidarr_notification.test", "name", "dataTest")),
+			},
 		},
 	})
 }
@@ -43,3 +54,18 @@ func testAccNotificationDataSourceConfig(name string) string {
 	}
 	`, name)
 }
+
+const testAccNotificationDataSourceConflictConfig = `
+data "lidarr_notification" "test" {
+	id = 1
+	name = "Error"
+}
+`
+
+func testAccNotificationDataSourceIDConfig(id string) string {
+	return fmt.Sprintf(`
+	data "lidarr_notification" "test" {
+		id = %s
+	}
+	`, id)
+}
@@ -2,16 +2,19 @@ package provider
 
 import (
 	"context"
+	"slices"
 	"strconv"
 
 	"github.com/devopsarr/lidarr-go/lidarr"
 	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -22,10 +25,19 @@ const (
 	notificationDiscordConfigContract = "DiscordSettings"
 )
 
+var (
+	// notificationDiscordGrabFields lists the grab field names, indexed by their value.
+	notificationDiscordGrabFields = []string{"Overview", "Rating", "Genres", "Quality", "Group", "Size", "Links", "Release", "Poster", "Fanart"}
+	// notificationDiscordImportFields lists the import field names, indexed by their value.
+	notificationDiscordImportFields = []string{"Overview", "Rating", "Genres", "Quality", "Codecs", "Group", "Size", "Languages", "Subtitles", "Links", "Release", "Poster", "Fanart"}
+)
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &NotificationDiscordResource{}
-	_ resource.ResourceWithImportState = &NotificationDiscordResource{}
+	_ resource.Resource                     = &NotificationDiscordResource{}
+	_ resource.ResourceWithImportState      = &NotificationDiscordResource{}
+	_ resource.ResourceWithConfigValidators = &NotificationDiscordResource{}
+	_ resource.ResourceWithUpgradeState     = &NotificationDiscordResource{}
 )
 
 func NewNotificationDiscordResource() resource.Resource {
@@ -40,18 +52,24 @@ type NotificationDiscordResource struct {
 
 // NotificationDiscord describes the notification data model.
 type NotificationDiscord struct {
+	TagLabels
+	ExtraFields           types.Map    `tfsdk:"extra_fields"`
 	Tags                  types.Set    `tfsdk:"tags"`
 	ImportFields          types.Set    `tfsdk:"import_fields"`
 	GrabFields            types.Set    `tfsdk:"grab_fields"`
+	ImportFieldsNames     types.Set    `tfsdk:"import_fields_names"`
+	GrabFieldsNames       types.Set    `tfsdk:"grab_fields_names"`
 	WebHookURL            types.String `tfsdk:"web_hook_url"`
 	Name                  types.String `tfsdk:"name"`
 	Username              types.String `tfsdk:"username"`
 	Avatar                types.String `tfsdk:"avatar"`
 	Author                types.String `tfsdk:"author"`
 	ID                    types.Int64  `tfsdk:"id"`
+	TestOnCreate          types.Bool   `tfsdk:"test_on_create"`
 	OnGrab                types.Bool   `tfsdk:"on_grab"`
 	OnReleaseImport       types.Bool   `tfsdk:"on_release_import"`
 	OnAlbumDelete         types.Bool   `tfsdk:"on_album_delete"`
+	OnArtistAdd           types.Bool   `tfsdk:"on_artist_add"`
 	OnArtistDelete        types.Bool   `tfsdk:"on_artist_delete"`
 	OnTrackRetag          types.Bool   `tfsdk:"on_track_retag"`
 	IncludeHealthWarnings types.Bool   `tfsdk:"include_health_warnings"`
@@ -62,10 +80,12 @@ type NotificationDiscord struct {
 	OnRename              types.Bool   `tfsdk:"on_rename"`
 	OnUpgrade             types.Bool   `tfsdk:"on_upgrade"`
 	OnImportFailure       types.Bool   `tfsdk:"on_import_failure"`
+	SkipDefaultTags       types.Bool   `tfsdk:"skip_default_tags"`
 }
 
 func (n NotificationDiscord) toNotification() *Notification {
 	return &Notification{
+		Fields:                n.ExtraFields,
 		Tags:                  n.Tags,
 		ImportFields:          n.ImportFields,
 		GrabFields:            n.GrabFields,
@@ -78,6 +98,7 @@ func (n NotificationDiscord) toNotification() *Notification {
 		OnGrab:                n.OnGrab,
 		OnReleaseImport:       n.OnReleaseImport,
 		OnAlbumDelete:         n.OnAlbumDelete,
+		OnArtistAdd:           n.OnArtistAdd,
 		OnArtistDelete:        n.OnArtistDelete,
 		OnTrackRetag:          n.OnTrackRetag,
 		IncludeHealthWarnings: n.IncludeHealthWarnings,
@@ -106,6 +127,7 @@ func (n *NotificationDiscord) fromNotification(notification *Notification) {
 	n.OnGrab = notification.OnGrab
 	n.OnReleaseImport = notification.OnReleaseImport
 	n.OnAlbumDelete = notification.OnAlbumDelete
+	n.OnArtistAdd = notification.OnArtistAdd
 	n.OnArtistDelete = notification.OnArtistDelete
 	n.OnTrackRetag = notification.OnTrackRetag
 	n.IncludeHealthWarnings = notification.IncludeHealthWarnings
@@ -116,6 +138,10 @@ func (n *NotificationDiscord) fromNotification(notification *Notification) {
 	n.OnRename = notification.OnRename
 	n.OnUpgrade = notification.OnUpgrade
 	n.OnImportFailure = notification.OnImportFailure
+
+	if !n.ExtraFields.IsNull() {
+		n.ExtraFields = notification.Fields
+	}
 }
 
 func (r *NotificationDiscordResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -124,126 +150,70 @@ func (r *NotificationDiscordResource) Metadata(_ context.Context, req resource.M
 
 func (r *NotificationDiscordResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             1,
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification Discord resource.\nFor more information refer to [Notification](https://wiki.servarr.com/lidarr/settings#connect) and [Discord](https://wiki.servarr.com/lidarr/supported#discord).",
-		Attributes: map[string]schema.Attribute{
-			"on_grab": schema.BoolAttribute{
-				MarkdownDescription: "On grab flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_import_failure": schema.BoolAttribute{
-				MarkdownDescription: "On download flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_upgrade": schema.BoolAttribute{
-				MarkdownDescription: "On upgrade flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_rename": schema.BoolAttribute{
-				MarkdownDescription: "On rename flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_download_failure": schema.BoolAttribute{
-				MarkdownDescription: "On download failure flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_track_retag": schema.BoolAttribute{
-				MarkdownDescription: "On track retag flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_release_import": schema.BoolAttribute{
-				MarkdownDescription: "On release import flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_album_delete": schema.BoolAttribute{
-				MarkdownDescription: "On album delete flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_artist_delete": schema.BoolAttribute{
-				MarkdownDescription: "On artist delete flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_health_issue": schema.BoolAttribute{
-				MarkdownDescription: "On health issue flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_health_restored": schema.BoolAttribute{
-				MarkdownDescription: "On health restored flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_application_update": schema.BoolAttribute{
-				MarkdownDescription: "On application update flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"include_health_warnings": schema.BoolAttribute{
-				MarkdownDescription: "Include health warnings.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "NotificationDiscord name.",
-				Required:            true,
-			},
-			"tags": schema.SetAttribute{
-				MarkdownDescription: "List of associated tags.",
-				Optional:            true,
-				Computed:            true,
-				ElementType:         types.Int64Type,
-			},
-			"id": schema.Int64Attribute{
-				MarkdownDescription: "Notification ID.",
-				Computed:            true,
-				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.UseStateForUnknown(),
+		Attributes: notificationResourceAttributes(
+			[]string{"on_grab", "on_release_import", "on_upgrade", "on_rename", "on_artist_add", "on_artist_delete", "on_album_delete", "on_health_issue", "on_health_restored", "on_download_failure", "on_import_failure", "on_track_retag", "on_application_update"},
+			map[string]schema.Attribute{
+				// Field values
+				"web_hook_url": schema.StringAttribute{
+					MarkdownDescription: "Web hook URL.",
+					Required:            true,
+				},
+				"username": schema.StringAttribute{
+					MarkdownDescription: "Username.",
+					Optional:            true,
+					Computed:            true,
+				},
+				"avatar": schema.StringAttribute{
+					MarkdownDescription: "Avatar.",
+					Optional:            true,
+					Computed:            true,
+				},
+				"author": schema.StringAttribute{
+					MarkdownDescription: "Author.",
+					Optional:            true,
+					Computed:            true,
+				},
+				"grab_fields": schema.SetAttribute{
+					MarkdownDescription: "Grab fields. `0` Overview, `1` Rating, `2` Genres, `3` Quality, `4` Group, `5` Size, `6` Links, `7` Release, `8` Poster, `9` Fanart.",
+					Optional:            true,
+					Computed:            true,
+					ElementType:         types.Int64Type,
+				},
+				"import_fields": schema.SetAttribute{
+					MarkdownDescription: "Import fields. `0` Overview, `1` Rating, `2` Genres, `3` Quality, `4` Codecs, `5` Group, `6` Size, `7` Languages, `8` Subtitles, `9` Links, `10` Release, `11` Poster, `12` Fanart.",
+					Optional:            true,
+					Computed:            true,
+					ElementType:         types.Int64Type,
+				},
+				"grab_fields_names": schema.SetAttribute{
+					MarkdownDescription: "Grab fields by name, alternative to `grab_fields`. Allowed values: `Overview`, `Rating`, `Genres`, `Quality`, `Group`, `Size`, `Links`, `Release`, `Poster`, `Fanart`.",
+					Optional:            true,
+					Computed:            true,
+					ElementType:         types.StringType,
+					Validators: []validator.Set{
+						setvalidator.ValueStringsAre(stringvalidator.OneOf(notificationDiscordGrabFields...)),
+					},
+				},
+				"import_fields_names": schema.SetAttribute{
+					MarkdownDescription: "Import fields by name, alternative to `import_fields`. Allowed values: `Overview`, `Rating`, `Genres`, `Quality`, `Codecs`, `Group`, `Size`, `Languages`, `Subtitles`, `Links`, `Release`, `Poster`, `Fanart`.",
+					Optional:            true,
+					Computed:            true,
+					ElementType:         types.StringType,
+					Validators: []validator.Set{
+						setvalidator.ValueStringsAre(stringvalidator.OneOf(notificationDiscordImportFields...)),
+					},
 				},
 			},
-			// Field values
-			"web_hook_url": schema.StringAttribute{
-				MarkdownDescription: "Web hook URL.",
-				Required:            true,
-			},
-			"username": schema.StringAttribute{
-				MarkdownDescription: "Username.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"avatar": schema.StringAttribute{
-				MarkdownDescription: "Avatar.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"author": schema.StringAttribute{
-				MarkdownDescription: "Author.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"grab_fields": schema.SetAttribute{
-				MarkdownDescription: "Grab fields. `0` Overview, `1` Rating, `2` Genres, `3` Quality, `4` Group, `5` Size, `6` Links, `7` Release, `8` Poster, `9` Fanart.",
-				Optional:            true,
-				Computed:            true,
-				ElementType:         types.Int64Type,
-			},
-			"import_fields": schema.SetAttribute{
-				MarkdownDescription: "Import fields. `0` Overview, `1` Rating, `2` Genres, `3` Quality, `4` Codecs, `5` Group, `6` Size, `7` Languages, `8` Subtitles, `9` Links, `10` Release, `11` Poster, `12` Fanart.",
-				Optional:            true,
-				Computed:            true,
-				ElementType:         types.Int64Type,
-			},
-		},
+		),
 	}
 }
 
+func (r *NotificationDiscordResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return notificationUpgradeState(ctx, r, notificationAttributesV1, "grab_fields_names", "import_fields_names", "on_artist_add")
+}
+
 func (r *NotificationDiscordResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -261,9 +231,34 @@ func (r *NotificationDiscordResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new NotificationDiscord
 	request := notification.read(ctx, &resp.Diagnostics)
 
+	if !typeNotificationFields(r.auth, r.client, request, notificationDiscordResourceName, &resp.Diagnostics, notification.ExtraFields) {
+		return
+	}
+
+	applyDefaultTags(r.auth, request, notification.SkipDefaultTags)
+
+	if !resolver.validate(notificationDiscordResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	if !validateNotificationTriggers(r.auth, r.client, request, notificationDiscordResourceName, &resp.Diagnostics) {
+		return
+	}
+
+	if notification.TestOnCreate.ValueBool() && !testNotification(r.auth, r.client, request, notificationDiscordResourceName, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationDiscordResourceName, err))
@@ -271,9 +266,13 @@ func (r *NotificationDiscordResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	response = helpers.ReadAfterCreate(ctx, response, notificationDiscordResourceName, r.client.NotificationAPI.GetNotificationById(r.auth, response.GetId()).Execute, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+notificationDiscordResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, notification.Tags, notification.SkipDefaultTags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &notification.TagLabels, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -288,8 +287,15 @@ func (r *NotificationDiscordResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get NotificationDiscord current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			resp.Diagnostics.AddWarning(helpers.ResourceNotFound, helpers.ParseResourceNotFound(notificationDiscordResourceName, notification.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationDiscordResourceName, err))
 
 		return
@@ -297,7 +303,11 @@ func (r *NotificationDiscordResource) Read(ctx context.Context, req resource.Rea
 
 	tflog.Trace(ctx, "read "+notificationDiscordResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	removeDefaultTags(ctx, r.auth, response, notification.Tags, notification.SkipDefaultTags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	newTagResolver(r.auth, r.client).write(ctx, &notification.TagLabels, notification.Tags, &resp.Diagnostics)
+	// Test flag is not stored by Lidarr, keep the state value or use the default on import
+	notification.TestOnCreate = types.BoolValue(notification.TestOnCreate.ValueBool())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -311,9 +321,31 @@ func (r *NotificationDiscordResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update NotificationDiscord
 	request := notification.read(ctx, &resp.Diagnostics)
 
+	if !typeNotificationFields(r.auth, r.client, request, notificationDiscordResourceName, &resp.Diagnostics, notification.ExtraFields) {
+		return
+	}
+
+	preserveNotificationFields(r.auth, r.client, request)
+	applyDefaultTags(r.auth, request, notification.SkipDefaultTags)
+
+	if !resolver.validate(notificationDiscordResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	if !validateNotificationTriggers(r.auth, r.client, request, notificationDiscordResourceName, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationDiscordResourceName, err))
@@ -323,7 +355,9 @@ func (r *NotificationDiscordResource) Update(ctx context.Context, req resource.U
 
 	tflog.Trace(ctx, "updated "+notificationDiscordResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, notification.Tags, notification.SkipDefaultTags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &notification.TagLabels, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -349,16 +383,80 @@ func (r *NotificationDiscordResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *NotificationDiscordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	importNotificationState(ctx, r.auth, r.client, notificationDiscordImplementation, req, resp)
 	tflog.Trace(ctx, "imported "+notificationDiscordResourceName+": "+req.ID)
 }
 
+func (r *NotificationDiscordResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		notificationTriggersValidator{auth: r.auth},
+		resourcevalidator.Conflicting(
+			path.MatchRoot("grab_fields"),
+			path.MatchRoot("grab_fields_names"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("import_fields"),
+			path.MatchRoot("import_fields_names"),
+		),
+	}
+}
+
 func (n *NotificationDiscord) write(ctx context.Context, notification *lidarr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
 	n.fromNotification(genericNotification)
+	n.GrabFieldsNames = discordFieldNames(ctx, n.GrabFields, notificationDiscordGrabFields, diags)
+	n.ImportFieldsNames = discordFieldNames(ctx, n.ImportFields, notificationDiscordImportFields, diags)
 }
 
 func (n *NotificationDiscord) read(ctx context.Context, diags *diag.Diagnostics) *lidarr.NotificationResource {
-	return n.toNotification().read(ctx, diags)
+	genericNotification := n.toNotification()
+	genericNotification.GrabFields = discordFieldValues(ctx, n.GrabFields, n.GrabFieldsNames, notificationDiscordGrabFields, diags)
+	genericNotification.ImportFields = discordFieldValues(ctx, n.ImportFields, n.ImportFieldsNames, notificationDiscordImportFields, diags)
+
+	return genericNotification.read(ctx, diags)
+}
+
+// discordFieldValues translates the configured field names to their values, unless the values are configured directly.
+func discordFieldValues(ctx context.Context, values, names types.Set, fields []string, diags *diag.Diagnostics) types.Set {
+	if (!values.IsNull() && !values.IsUnknown()) || names.IsNull() || names.IsUnknown() {
+		return values
+	}
+
+	var list []string
+
+	diags.Append(names.ElementsAs(ctx, &list, false)...)
+
+	ints := make([]int64, 0, len(list))
+
+	for _, name := range list {
+		if i := slices.Index(fields, name); i >= 0 {
+			ints = append(ints, int64(i))
+		}
+	}
+
+	set, d := types.SetValueFrom(ctx, types.Int64Type, ints)
+	diags.Append(d...)
+
+	return set
+}
+
+// discordFieldNames translates the field values to their names.
+func discordFieldNames(ctx context.Context, values types.Set, fields []string, diags *diag.Diagnostics) types.Set {
+	var list []int64
+
+	diags.Append(values.ElementsAs(ctx, &list, false)...)
+
+	names := make([]string, 0, len(list))
+
+	for _, value := range list {
+		if value >= 0 && int(value) < len(fields) {
+			names = append(names, fields[value])
+		}
+	}
+
+	set, d := types.SetValueFrom(ctx, types.StringType, names)
+	diags.Append(d...)
+
+	return set
 }
@@ -1,11 +1,18 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"os"
 	"regexp"
 	"testing"
 
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccNotificationDiscordResource(t *testing.T) {
@@ -25,6 +32,7 @@ func TestAccNotificationDiscordResource(t *testing.T) {
 				Config: testAccNotificationDiscordResourceConfig("resourceDiscordTest", "dog-picture"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("lidarr_notification_discord.test", "avatar", "dog-picture"),
+					resource.TestCheckTypeSetElemAttr("lidarr_notification_discord.test", "grab_fields_names.*", "Fanart"),
 					resource.TestCheckResourceAttrSet("lidarr_notification_discord.test", "id"),
 				),
 			},
@@ -74,3 +82,143 @@ func testAccNotificationDiscordResourceConfig(name, avatar string) string {
 		import_fields = [0,1,2,3,4,5,6,7,8,9,10,11]
 	}`, name, avatar)
 }
+
+func TestAccNotificationDiscordResourceFieldNames(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid name testing
+			{
+				Config:      testAccNotificationDiscordResourceFieldNamesConfig("resourceDiscordNamesTest", "Codecs"),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccNotificationDiscordResourceFieldNamesConfig("resourceDiscordNamesTest", "Quality"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("lidarr_notification_discord.test", "grab_fields.*", "3"),
+					resource.TestCheckTypeSetElemAttr("lidarr_notification_discord.test", "import_fields.*", "4"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccNotificationDiscordResourceFieldNamesConfig("resourceDiscordNamesTest", "Size"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("lidarr_notification_discord.test", "grab_fields.*", "5"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccNotificationDiscordResourceFieldNamesConfig(name, field string) string {
+	return fmt.Sprintf(`
+	resource "lidarr_notification_discord" "test" {
+		on_grab = true
+		name    = "%s"
+
+		web_hook_url        = "http://discord-web-hook.com"
+		grab_fields_names   = ["Overview", "%s"]
+		import_fields_names = ["Overview", "Codecs"]
+	}`, name, field)
+}
+
+func TestAccNotificationDiscordResourceTagLabels(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Missing tag
+			{
+				Config:      testAccNotificationDiscordResourceTagLabelsConfig("false"),
+				ExpectError: regexp.MustCompile("do not exist"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccNotificationDiscordResourceTagLabelsConfig("true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lidarr_notification_discord.labels", "tag_labels.0", "DiscordLabel"),
+					resource.TestCheckResourceAttr("lidarr_notification_discord.labels", "tags.#", "1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "lidarr_notification_discord.labels",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"tag_labels", "create_missing_tags"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccNotificationDiscordResourceTagLabelsConfig(create string) string {
+	return fmt.Sprintf(`
+	resource "lidarr_notification_discord" "labels" {
+		on_grab = true
+		name    = "DiscordLabels"
+
+		web_hook_url = "http://discord-web-hook.com"
+
+		tag_labels          = ["DiscordLabel"]
+		create_missing_tags = %s
+	}`, create)
+}
+
+func TestNotificationDiscordResourceMapping(t *testing.T) {
+	t.Parallel()
+
+	data, transport := testFixtureProvider(t, map[string]string{
+		"GET /api/v1/notification/1":      "notification_discord.json",
+		"GET /api/v1/notification/schema": "notification_schema.json",
+	})
+	r := NewNotificationDiscordResource()
+
+	// Read maps the Lidarr notification to the resource attributes
+	state := testResourceRead(t, r, data, 1)
+
+	var notification NotificationDiscord
+
+	assert.False(t, state.Get(context.Background(), &notification).HasError())
+	assert.Equal(t, "Discord", notification.Name.ValueString())
+	assert.Equal(t, "https://discord.com/api/webhooks/test", notification.WebHookURL.ValueString())
+	assert.Equal(t, "lidarr", notification.Username.ValueString())
+	assert.Equal(t, "Lidarr", notification.Author.ValueString())
+	assert.True(t, notification.OnGrab.ValueBool())
+	assert.False(t, notification.OnRename.ValueBool())
+	assert.True(t, notification.OnImportFailure.ValueBool())
+	assert.True(t, notification.GrabFields.Equal(types.SetValueMust(types.Int64Type, []attr.Value{
+		types.Int64Value(0), types.Int64Value(1), types.Int64Value(2), types.Int64Value(3), types.Int64Value(5),
+	})))
+
+	// Applying the read state back must send the same notification to Lidarr
+	testResourceUpdate(t, r, state)
+
+	var expected, actual lidarr.NotificationResource
+
+	content, err := os.ReadFile("testdata/notification_discord.json")
+	assert.NoError(t, err)
+	assert.NoError(t, json.Unmarshal(content, &expected))
+	assert.NoError(t, json.Unmarshal(transport.request("PUT /api/v1/notification/1"), &actual))
+
+	assert.Equal(t, expected.GetName(), actual.GetName())
+	assert.Equal(t, expected.GetImplementation(), actual.GetImplementation())
+	assert.Equal(t, expected.GetConfigContract(), actual.GetConfigContract())
+	assert.Equal(t, expected.GetTags(), actual.GetTags())
+	// Alternative spellings of the same field are sent along, Lidarr ignores the unknown ones
+	fields := testFieldValues(actual.GetFields())
+	for name, value := range testFieldValues(expected.GetFields()) {
+		assert.Equal(t, value, fields[name], name)
+	}
+
+	for _, trigger := range notificationTriggers {
+		assert.Equal(t, trigger.enabled(&expected), trigger.enabled(&actual), trigger.attribute)
+	}
+}
@@ -6,12 +6,14 @@ import (
 
 	"github.com/devopsarr/lidarr-go/lidarr"
 	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -20,12 +22,17 @@ const (
 	notificationEmailResourceName   = "notification_email"
 	notificationEmailImplementation = "Email"
 	notificationEmailConfigContract = "EmailSettings"
+	emailEncryptionPreferred        = 0
+	emailEncryptionAlways           = 1
+	emailEncryptionNever            = 2
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &NotificationEmailResource{}
-	_ resource.ResourceWithImportState = &NotificationEmailResource{}
+	_ resource.Resource                     = &NotificationEmailResource{}
+	_ resource.ResourceWithImportState      = &NotificationEmailResource{}
+	_ resource.ResourceWithConfigValidators = &NotificationEmailResource{}
+	_ resource.ResourceWithUpgradeState     = &NotificationEmailResource{}
 )
 
 func NewNotificationEmailResource() resource.Resource {
@@ -40,6 +47,8 @@ type NotificationEmailResource struct {
 
 // NotificationEmail describes the notification data model.
 type NotificationEmail struct {
+	TagLabels
+	ExtraFields           types.Map    `tfsdk:"extra_fields"`
 	Tags                  types.Set    `tfsdk:"tags"`
 	To                    types.Set    `tfsdk:"to"`
 	Cc                    types.Set    `tfsdk:"cc"`
@@ -51,10 +60,15 @@ type NotificationEmail struct {
 	Password              types.String `tfsdk:"password"`
 	ID                    types.Int64  `tfsdk:"id"`
 	Port                  types.Int64  `tfsdk:"port"`
+	UseEncryption         types.Int64  `tfsdk:"use_encryption"`
+	TestOnCreate          types.Bool   `tfsdk:"test_on_create"`
 	RequireEncryption     types.Bool   `tfsdk:"require_encryption"`
 	OnGrab                types.Bool   `tfsdk:"on_grab"`
 	OnReleaseImport       types.Bool   `tfsdk:"on_release_import"`
 	OnAlbumDelete         types.Bool   `tfsdk:"on_album_delete"`
+	OnRename              types.Bool   `tfsdk:"on_rename"`
+	OnTrackRetag          types.Bool   `tfsdk:"on_track_retag"`
+	OnArtistAdd           types.Bool   `tfsdk:"on_artist_add"`
 	OnArtistDelete        types.Bool   `tfsdk:"on_artist_delete"`
 	IncludeHealthWarnings types.Bool   `tfsdk:"include_health_warnings"`
 	OnApplicationUpdate   types.Bool   `tfsdk:"on_application_update"`
@@ -63,10 +77,12 @@ type NotificationEmail struct {
 	OnDownloadFailure     types.Bool   `tfsdk:"on_download_failure"`
 	OnUpgrade             types.Bool   `tfsdk:"on_upgrade"`
 	OnImportFailure       types.Bool   `tfsdk:"on_import_failure"`
+	SkipDefaultTags       types.Bool   `tfsdk:"skip_default_tags"`
 }
 
 func (n NotificationEmail) toNotification() *Notification {
 	return &Notification{
+		Fields:                n.ExtraFields,
 		Tags:                  n.Tags,
 		From:                  n.From,
 		To:                    n.To,
@@ -78,10 +94,13 @@ func (n NotificationEmail) toNotification() *Notification {
 		Password:              n.Password,
 		Name:                  n.Name,
 		ID:                    n.ID,
-		RequireEncryption:     n.RequireEncryption,
+		UseEncryption:         n.useEncryption(),
 		OnGrab:                n.OnGrab,
 		OnReleaseImport:       n.OnReleaseImport,
 		OnAlbumDelete:         n.OnAlbumDelete,
+		OnRename:              n.OnRename,
+		OnTrackRetag:          n.OnTrackRetag,
+		OnArtistAdd:           n.OnArtistAdd,
 		OnArtistDelete:        n.OnArtistDelete,
 		IncludeHealthWarnings: n.IncludeHealthWarnings,
 		OnApplicationUpdate:   n.OnApplicationUpdate,
@@ -107,10 +126,18 @@ func (n *NotificationEmail) fromNotification(notification *Notification) {
 	n.Password = notification.Password
 	n.Name = notification.Name
 	n.ID = notification.ID
+	n.UseEncryption = notification.UseEncryption
 	n.RequireEncryption = notification.RequireEncryption
+
+	if !notification.UseEncryption.IsNull() {
+		n.RequireEncryption = types.BoolValue(notification.UseEncryption.ValueInt64() == emailEncryptionAlways)
+	}
 	n.OnGrab = notification.OnGrab
 	n.OnReleaseImport = notification.OnReleaseImport
 	n.OnAlbumDelete = notification.OnAlbumDelete
+	n.OnRename = notification.OnRename
+	n.OnTrackRetag = notification.OnTrackRetag
+	n.OnArtistAdd = notification.OnArtistAdd
 	n.OnArtistDelete = notification.OnArtistDelete
 	n.IncludeHealthWarnings = notification.IncludeHealthWarnings
 	n.OnApplicationUpdate = notification.OnApplicationUpdate
@@ -119,6 +146,27 @@ func (n *NotificationEmail) fromNotification(notification *Notification) {
 	n.OnDownloadFailure = notification.OnDownloadFailure
 	n.OnUpgrade = notification.OnUpgrade
 	n.OnImportFailure = notification.OnImportFailure
+
+	if !n.ExtraFields.IsNull() {
+		n.ExtraFields = notification.Fields
+	}
+}
+
+// useEncryption returns the configured encryption, translating the deprecated require_encryption flag.
+func (n NotificationEmail) useEncryption() types.Int64 {
+	if !n.UseEncryption.IsNull() && !n.UseEncryption.IsUnknown() {
+		return n.UseEncryption
+	}
+
+	if n.RequireEncryption.IsNull() || n.RequireEncryption.IsUnknown() {
+		return n.UseEncryption
+	}
+
+	if n.RequireEncryption.ValueBool() {
+		return types.Int64Value(emailEncryptionAlways)
+	}
+
+	return types.Int64Value(emailEncryptionPreferred)
 }
 
 func (r *NotificationEmailResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -127,131 +175,151 @@ func (r *NotificationEmailResource) Metadata(_ context.Context, req resource.Met
 
 func (r *NotificationEmailResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		// Version 1 always stores use_encryption, previously unset when require_encryption was used.
+		Version:             1,
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification Email resource.\nFor more information refer to [Notification](https://wiki.servarr.com/lidarr/settings#connect) and [Email](https://wiki.servarr.com/lidarr/supported#email).",
-		Attributes: map[string]schema.Attribute{
-			"on_grab": schema.BoolAttribute{
-				MarkdownDescription: "On grab flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_import_failure": schema.BoolAttribute{
-				MarkdownDescription: "On download flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_upgrade": schema.BoolAttribute{
-				MarkdownDescription: "On upgrade flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_download_failure": schema.BoolAttribute{
-				MarkdownDescription: "On download failure flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_release_import": schema.BoolAttribute{
-				MarkdownDescription: "On release import flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_album_delete": schema.BoolAttribute{
-				MarkdownDescription: "On album delete flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_artist_delete": schema.BoolAttribute{
-				MarkdownDescription: "On artist delete flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_health_issue": schema.BoolAttribute{
-				MarkdownDescription: "On health issue flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_health_restored": schema.BoolAttribute{
-				MarkdownDescription: "On health restored flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_application_update": schema.BoolAttribute{
-				MarkdownDescription: "On application update flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"include_health_warnings": schema.BoolAttribute{
-				MarkdownDescription: "Include health warnings.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "NotificationEmail name.",
-				Required:            true,
-			},
-			"tags": schema.SetAttribute{
-				MarkdownDescription: "List of associated tags.",
-				Optional:            true,
-				Computed:            true,
-				ElementType:         types.Int64Type,
-			},
-			"id": schema.Int64Attribute{
-				MarkdownDescription: "Notification ID.",
-				Computed:            true,
-				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.UseStateForUnknown(),
+		Attributes: notificationResourceAttributes(
+			[]string{"on_grab", "on_release_import", "on_upgrade", "on_rename", "on_artist_add", "on_artist_delete", "on_album_delete", "on_health_issue", "on_health_restored", "on_download_failure", "on_import_failure", "on_track_retag", "on_application_update"},
+			map[string]schema.Attribute{
+				// Field values
+				"require_encryption": schema.BoolAttribute{
+					MarkdownDescription: "Require encryption flag. Deprecated, `true` maps to `use_encryption` `1` and `false` to `0`.",
+					DeprecationMessage:  "Use use_encryption instead.",
+					Optional:            true,
+					Computed:            true,
+				},
+				"use_encryption": schema.Int64Attribute{
+					MarkdownDescription: "Use encryption. `0` Preferred, `1` Always, `2` Never.",
+					Optional:            true,
+					Computed:            true,
+					Validators: []validator.Int64{
+						int64validator.OneOf(emailEncryptionPreferred, emailEncryptionAlways, emailEncryptionNever),
+					},
+				},
+				"port": schema.Int64Attribute{
+					MarkdownDescription: "Port.",
+					Optional:            true,
+					Computed:            true,
+					Validators: []validator.Int64{
+						helpers.Port(),
+					},
+				},
+				"server": schema.StringAttribute{
+					MarkdownDescription: "Server.",
+					Required:            true,
+				},
+				"username": schema.StringAttribute{
+					MarkdownDescription: "Username.",
+					Optional:            true,
+					Computed:            true,
+				},
+				"password": schema.StringAttribute{
+					MarkdownDescription: "Password.",
+					Optional:            true,
+					Computed:            true,
+					Sensitive:           true,
+				},
+				"from": schema.StringAttribute{
+					MarkdownDescription: "From.",
+					Required:            true,
+					Validators: []validator.String{
+						helpers.EmailAddress(),
+					},
+				},
+				"to": schema.SetAttribute{
+					MarkdownDescription: "To.",
+					Required:            true,
+					ElementType:         types.StringType,
+					Validators: []validator.Set{
+						setvalidator.SizeAtLeast(1),
+						helpers.EmailAddresses(),
+					},
+				},
+				"cc": schema.SetAttribute{
+					MarkdownDescription: "Cc.",
+					Optional:            true,
+					Computed:            true,
+					ElementType:         types.StringType,
+					Validators: []validator.Set{
+						helpers.EmailAddresses(),
+					},
+				},
+				"bcc": schema.SetAttribute{
+					MarkdownDescription: "Bcc.",
+					Optional:            true,
+					Computed:            true,
+					ElementType:         types.StringType,
+					Validators: []validator.Set{
+						helpers.EmailAddresses(),
+					},
 				},
 			},
-			// Field values
-			"require_encryption": schema.BoolAttribute{
-				MarkdownDescription: "Require encryption flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"port": schema.Int64Attribute{
-				MarkdownDescription: "Port.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"server": schema.StringAttribute{
-				MarkdownDescription: "Server.",
-				Required:            true,
-			},
-			"username": schema.StringAttribute{
-				MarkdownDescription: "Username.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"password": schema.StringAttribute{
-				MarkdownDescription: "Password.",
-				Optional:            true,
-				Computed:            true,
-				Sensitive:           true,
-			},
-			"from": schema.StringAttribute{
-				MarkdownDescription: "From.",
-				Required:            true,
-			},
-			"to": schema.SetAttribute{
-				MarkdownDescription: "To.",
-				Required:            true,
-				ElementType:         types.StringType,
-			},
-			"cc": schema.SetAttribute{
-				MarkdownDescription: "Cc.",
-				Optional:            true,
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"bcc": schema.SetAttribute{
-				MarkdownDescription: "Bcc.",
-				Optional:            true,
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
+		),
+	}
+}
+
+func (r *NotificationEmailResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		notificationTriggersValidator{auth: r.auth},
+		resourcevalidator.Conflicting(
+			path.MatchRoot("require_encryption"),
+			path.MatchRoot("use_encryption"),
+		),
+	}
+}
+
+func (r *NotificationEmailResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   notificationEmailSchemaV0(),
+			StateUpgrader: upgradeNotificationEmailStateV0,
 		},
 	}
 }
 
+// notificationEmailSchemaV0 describes the attribute types of the version 0 state,
+// before the tag, test, extra field and use_encryption attributes were added.
+func notificationEmailSchemaV0() *schema.Schema {
+	attributes := map[string]schema.Attribute{
+		"tags":               schema.SetAttribute{Optional: true, ElementType: types.Int64Type},
+		"to":                 schema.SetAttribute{Optional: true, ElementType: types.StringType},
+		"cc":                 schema.SetAttribute{Optional: true, ElementType: types.StringType},
+		"bcc":                schema.SetAttribute{Optional: true, ElementType: types.StringType},
+		"from":               schema.StringAttribute{Optional: true},
+		"server":             schema.StringAttribute{Optional: true},
+		"name":               schema.StringAttribute{Optional: true},
+		"username":           schema.StringAttribute{Optional: true},
+		"password":           schema.StringAttribute{Optional: true, Sensitive: true},
+		"id":                 schema.Int64Attribute{Computed: true},
+		"port":               schema.Int64Attribute{Optional: true},
+		"require_encryption": schema.BoolAttribute{Optional: true},
+	}
+
+	for _, trigger := range []string{
+		"on_grab", "on_release_import", "on_album_delete", "on_artist_delete", "include_health_warnings", "on_application_update",
+		"on_health_issue", "on_health_restored", "on_download_failure", "on_upgrade", "on_import_failure",
+	} {
+		attributes[trigger] = schema.BoolAttribute{Optional: true}
+	}
+
+	return &schema.Schema{Attributes: attributes}
+}
+
+// upgradeNotificationEmailStateV0 sets use_encryption from the deprecated require_encryption flag.
+func upgradeNotificationEmailStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	helpers.UpgradeState(ctx, req, resp)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var notification NotificationEmail
+
+	resp.Diagnostics.Append(resp.State.Get(ctx, &notification)...)
+	notification.UseEncryption = notification.useEncryption()
+	resp.Diagnostics.Append(resp.State.Set(ctx, notification)...)
+}
+
 func (r *NotificationEmailResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -269,9 +337,34 @@ func (r *NotificationEmailResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new NotificationEmail
 	request := notification.read(ctx, &resp.Diagnostics)
 
+	if !typeNotificationFields(r.auth, r.client, request, notificationEmailResourceName, &resp.Diagnostics, notification.ExtraFields) {
+		return
+	}
+
+	applyDefaultTags(r.auth, request, notification.SkipDefaultTags)
+
+	if !resolver.validate(notificationEmailResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	if !validateNotificationTriggers(r.auth, r.client, request, notificationEmailResourceName, &resp.Diagnostics) {
+		return
+	}
+
+	if notification.TestOnCreate.ValueBool() && !testNotification(r.auth, r.client, request, notificationEmailResourceName, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationEmailResourceName, err))
@@ -279,9 +372,13 @@ func (r *NotificationEmailResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	response = helpers.ReadAfterCreate(ctx, response, notificationEmailResourceName, r.client.NotificationAPI.GetNotificationById(r.auth, response.GetId()).Execute, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+notificationEmailResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, notification.Tags, notification.SkipDefaultTags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &notification.TagLabels, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -296,8 +393,15 @@ func (r *NotificationEmailResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	// Get NotificationEmail current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			resp.Diagnostics.AddWarning(helpers.ResourceNotFound, helpers.ParseResourceNotFound(notificationEmailResourceName, notification.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationEmailResourceName, err))
 
 		return
@@ -305,7 +409,11 @@ func (r *NotificationEmailResource) Read(ctx context.Context, req resource.ReadR
 
 	tflog.Trace(ctx, "read "+notificationEmailResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	removeDefaultTags(ctx, r.auth, response, notification.Tags, notification.SkipDefaultTags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	newTagResolver(r.auth, r.client).write(ctx, &notification.TagLabels, notification.Tags, &resp.Diagnostics)
+	// Test flag is not stored by Lidarr, keep the state value or use the default on import
+	notification.TestOnCreate = types.BoolValue(notification.TestOnCreate.ValueBool())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -319,9 +427,31 @@ func (r *NotificationEmailResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update NotificationEmail
 	request := notification.read(ctx, &resp.Diagnostics)
 
+	if !typeNotificationFields(r.auth, r.client, request, notificationEmailResourceName, &resp.Diagnostics, notification.ExtraFields) {
+		return
+	}
+
+	preserveNotificationFields(r.auth, r.client, request)
+	applyDefaultTags(r.auth, request, notification.SkipDefaultTags)
+
+	if !resolver.validate(notificationEmailResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	if !validateNotificationTriggers(r.auth, r.client, request, notificationEmailResourceName, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationEmailResourceName, err))
@@ -331,7 +461,9 @@ func (r *NotificationEmailResource) Update(ctx context.Context, req resource.Upd
 
 	tflog.Trace(ctx, "updated "+notificationEmailResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, notification.Tags, notification.SkipDefaultTags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &notification.TagLabels, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -357,7 +489,7 @@ func (r *NotificationEmailResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *NotificationEmailResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	importNotificationState(ctx, r.auth, r.client, notificationEmailImplementation, req, resp)
 	tflog.Trace(ctx, "imported "+notificationEmailResourceName+": "+req.ID)
 }
 
@@ -1,11 +1,18 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
 
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccNotificationEmailResource(t *testing.T) {
@@ -15,31 +22,53 @@ func TestAccNotificationEmailResource(t *testing.T) {
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
+			// Invalid from
+			{
+				Config:      testAccNotificationEmailResourceConfig("resourceEmailTest", "test.email.com", "use_encryption = 1"),
+				ExpectError: regexp.MustCompile("Invalid Email Address"),
+			},
 			// Unauthorized Create
 			{
-				Config:      testAccNotificationEmailResourceConfig("resourceEmailTest", "test@email.com") + testUnauthorizedProvider,
+				Config:      testAccNotificationEmailResourceConfig("resourceEmailTest", "test@email.com", "use_encryption = 1") + testUnauthorizedProvider,
 				ExpectError: regexp.MustCompile("Client Error"),
 			},
 			// Create and Read testing
 			{
-				Config: testAccNotificationEmailResourceConfig("resourceEmailTest", "test@email.com"),
+				Config: testAccNotificationEmailResourceConfig("resourceEmailTest", "test@email.com", "use_encryption = 1"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("lidarr_notification_email.test", "from", "test@email.com"),
+					resource.TestCheckResourceAttr("lidarr_notification_email.test", "on_album_delete", "true"),
+					resource.TestCheckResourceAttr("lidarr_notification_email.test", "use_encryption", "1"),
 					resource.TestCheckResourceAttrSet("lidarr_notification_email.test", "id"),
 				),
 			},
 			// Unauthorized Read
 			{
-				Config:      testAccNotificationEmailResourceConfig("resourceEmailTest", "test@email.com") + testUnauthorizedProvider,
+				Config:      testAccNotificationEmailResourceConfig("resourceEmailTest", "test@email.com", "use_encryption = 1") + testUnauthorizedProvider,
 				ExpectError: regexp.MustCompile("Client Error"),
 			},
 			// Update and Read testing
 			{
-				Config: testAccNotificationEmailResourceConfig("resourceEmailTest", "test123@email.com"),
+				Config: testAccNotificationEmailResourceConfig("resourceEmailTest", "test123@email.com", "use_encryption = 2"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("lidarr_notification_email.test", "from", "test123@email.com"),
+					resource.TestCheckResourceAttr("lidarr_notification_email.test", "use_encryption", "2"),
+					resource.TestCheckResourceAttr("lidarr_notification_email.test", "require_encryption", "false"),
+				),
+			},
+			// Legacy require_encryption testing
+			{
+				Config: testAccNotificationEmailResourceConfig("resourceEmailTest", "test123@email.com", "require_encryption = true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lidarr_notification_email.test", "use_encryption", "1"),
+					resource.TestCheckResourceAttr("lidarr_notification_email.test", "require_encryption", "true"),
 				),
 			},
+			// Conflicting encryption attributes
+			{
+				Config:      testAccNotificationEmailResourceConfig("resourceEmailTest", "test123@email.com", "require_encryption = true\n\t\tuse_encryption = 1"),
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
 			// ImportState testing
 			{
 				ResourceName:      "lidarr_notification_email.test",
@@ -51,7 +80,80 @@ func TestAccNotificationEmailResource(t *testing.T) {
 	})
 }
 
-func testAccNotificationEmailResourceConfig(name, from string) string {
+func TestNotificationEmailResourceUpgradeStateV0(t *testing.T) {
+	t.Parallel()
+
+	// state written by the version 0 schema
+	stateV0 := func(encryption string) string {
+		return `{"id":1,"name":"test","tags":[],"server":"smtp.email.com","port":587,"username":"user","password":"pass",` +
+			`"from":"test@email.com","to":["user@email.com"],"cc":[],"bcc":[],"require_encryption":` + encryption + `,` +
+			`"on_grab":true,"on_release_import":false,"on_upgrade":false,"on_album_delete":false,"on_artist_delete":false,` +
+			`"on_health_issue":false,"on_health_restored":false,"include_health_warnings":false,"on_download_failure":false,` +
+			`"on_import_failure":false,"on_application_update":false}`
+	}
+
+	tests := map[string]struct {
+		state      string
+		encryption types.Int64
+	}{
+		"require encryption": {
+			state:      stateV0("true"),
+			encryption: types.Int64Value(emailEncryptionAlways),
+		},
+		"not require encryption": {
+			state:      stateV0("false"),
+			encryption: types.Int64Value(emailEncryptionPreferred),
+		},
+		"null require encryption": {
+			state:      stateV0("null"),
+			encryption: types.Int64Null(),
+		},
+		"removed attribute": {
+			state:      `{"id":1,"name":"test","from":"test@email.com","to":["user@email.com"],"on_download":true,"on_grab":true}`,
+			encryption: types.Int64Null(),
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			r := &NotificationEmailResource{}
+			upgrader := r.UpgradeState(ctx)[0]
+
+			schemaResp := fwresource.SchemaResponse{}
+			r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+			raw := tfprotov6.RawState{JSON: []byte(test.state)}
+			prior, err := raw.UnmarshalWithOpts(upgrader.PriorSchema.Type().TerraformType(ctx), tfprotov6.UnmarshalOpts{
+				ValueFromJSONOpts: tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true},
+			})
+			assert.NoError(t, err)
+
+			req := fwresource.UpgradeStateRequest{State: &tfsdk.State{Raw: prior, Schema: *upgrader.PriorSchema}}
+			resp := fwresource.UpgradeStateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+			upgrader.StateUpgrader(ctx, req, &resp)
+			assert.False(t, resp.Diagnostics.HasError())
+
+			var notification NotificationEmail
+
+			resp.Diagnostics.Append(resp.State.Get(ctx, &notification)...)
+			assert.False(t, resp.Diagnostics.HasError())
+			assert.Equal(t, test.encryption, notification.UseEncryption)
+			assert.Equal(t, int64(1), notification.ID.ValueInt64())
+			assert.Equal(t, "test@email.com", notification.From.ValueString())
+			assert.Len(t, notification.To.Elements(), 1)
+			assert.True(t, notification.OnGrab.ValueBool())
+			assert.True(t, notification.OnRename.IsNull())
+			assert.True(t, notification.TestOnCreate.IsNull())
+			assert.True(t, notification.Labels.IsNull())
+		})
+	}
+}
+
+func testAccNotificationEmailResourceConfig(name, from, encryption string) string {
 	return fmt.Sprintf(`
 	resource "lidarr_notification_email" "test" {
 		on_grab                            = false
@@ -59,6 +161,7 @@ func testAccNotificationEmailResourceConfig(name, from string) string {
 		on_upgrade                         = false
 		on_download_failure                    = false
 		on_release_import   = false
+		on_album_delete = true
 		on_health_issue                    = false
 		on_application_update              = false
 	  
@@ -69,5 +172,6 @@ func testAccNotificationEmailResourceConfig(name, from string) string {
 		port = 587
 		from = "%s"
 		to = ["test@test.com", "test1@test.com"]
-	}`, name, from)
+		%s
+	}`, name, from, encryption)
 }
@@ -10,8 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -24,8 +23,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &NotificationEmbyResource{}
-	_ resource.ResourceWithImportState = &NotificationEmbyResource{}
+	_ resource.Resource                     = &NotificationEmbyResource{}
+	_ resource.ResourceWithImportState      = &NotificationEmbyResource{}
+	_ resource.ResourceWithConfigValidators = &NotificationEmbyResource{}
+	_ resource.ResourceWithUpgradeState     = &NotificationEmbyResource{}
 )
 
 func NewNotificationEmbyResource() resource.Resource {
@@ -40,12 +41,15 @@ type NotificationEmbyResource struct {
 
 // NotificationEmby describes the notification data model.
 type NotificationEmby struct {
+	TagLabels
+	ExtraFields           types.Map    `tfsdk:"extra_fields"`
 	Tags                  types.Set    `tfsdk:"tags"`
 	Host                  types.String `tfsdk:"host"`
 	APIKey                types.String `tfsdk:"api_key"`
 	Name                  types.String `tfsdk:"name"`
 	ID                    types.Int64  `tfsdk:"id"`
 	Port                  types.Int64  `tfsdk:"port"`
+	TestOnCreate          types.Bool   `tfsdk:"test_on_create"`
 	UpdateLibrary         types.Bool   `tfsdk:"update_library"`
 	Notify                types.Bool   `tfsdk:"notify"`
 	UseSSL                types.Bool   `tfsdk:"use_ssl"`
@@ -60,10 +64,12 @@ type NotificationEmby struct {
 	OnHealthIssue         types.Bool   `tfsdk:"on_health_issue"`
 	OnHealthRestored      types.Bool   `tfsdk:"on_health_restored"`
 	OnUpgrade             types.Bool   `tfsdk:"on_upgrade"`
+	SkipDefaultTags       types.Bool   `tfsdk:"skip_default_tags"`
 }
 
 func (n NotificationEmby) toNotification() *Notification {
 	return &Notification{
+		Fields:                n.ExtraFields,
 		Tags:                  n.Tags,
 		Host:                  n.Host,
 		Name:                  n.Name,
@@ -110,6 +116,10 @@ func (n *NotificationEmby) fromNotification(notification *Notification) {
 	n.OnHealthRestored = notification.OnHealthRestored
 	n.OnRename = notification.OnRename
 	n.OnUpgrade = notification.OnUpgrade
+
+	if !n.ExtraFields.IsNull() {
+		n.ExtraFields = notification.Fields
+	}
 }
 
 func (r *NotificationEmbyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -118,114 +128,53 @@ func (r *NotificationEmbyResource) Metadata(_ context.Context, req resource.Meta
 
 func (r *NotificationEmbyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             1,
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification Emby resource.\nFor more information refer to [Notification](https://wiki.servarr.com/lidarr/settings#connect) and [Emby](https://wiki.servarr.com/lidarr/supported#mediabrowser).",
-		Attributes: map[string]schema.Attribute{
-			"on_grab": schema.BoolAttribute{
-				MarkdownDescription: "On grab flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_upgrade": schema.BoolAttribute{
-				MarkdownDescription: "On upgrade flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_rename": schema.BoolAttribute{
-				MarkdownDescription: "On rename flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_track_retag": schema.BoolAttribute{
-				MarkdownDescription: "On track retag flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_release_import": schema.BoolAttribute{
-				MarkdownDescription: "On release import flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_album_delete": schema.BoolAttribute{
-				MarkdownDescription: "On album delete flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_artist_delete": schema.BoolAttribute{
-				MarkdownDescription: "On artist delete flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_health_issue": schema.BoolAttribute{
-				MarkdownDescription: "On health issue flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_health_restored": schema.BoolAttribute{
-				MarkdownDescription: "On health restored flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_application_update": schema.BoolAttribute{
-				MarkdownDescription: "On application update flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"include_health_warnings": schema.BoolAttribute{
-				MarkdownDescription: "Include health warnings.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "NotificationEmby name.",
-				Required:            true,
-			},
-			"tags": schema.SetAttribute{
-				MarkdownDescription: "List of associated tags.",
-				Optional:            true,
-				Computed:            true,
-				ElementType:         types.Int64Type,
-			},
-			"id": schema.Int64Attribute{
-				MarkdownDescription: "Notification ID.",
-				Computed:            true,
-				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.UseStateForUnknown(),
+		Attributes: notificationResourceAttributes(
+			[]string{"on_grab", "on_release_import", "on_upgrade", "on_rename", "on_artist_delete", "on_album_delete", "on_health_issue", "on_health_restored", "on_track_retag", "on_application_update"},
+			map[string]schema.Attribute{
+				// Field values
+				"use_ssl": schema.BoolAttribute{
+					MarkdownDescription: "Use SSL flag.",
+					Optional:            true,
+					Computed:            true,
+				},
+				"notify": schema.BoolAttribute{
+					MarkdownDescription: "Notify flag.",
+					Optional:            true,
+					Computed:            true,
+				},
+				"update_library": schema.BoolAttribute{
+					MarkdownDescription: "Update library flag.",
+					Optional:            true,
+					Computed:            true,
+				},
+				"port": schema.Int64Attribute{
+					MarkdownDescription: "Port.",
+					Optional:            true,
+					Computed:            true,
+					Validators: []validator.Int64{
+						helpers.Port(),
+					},
+				},
+				"api_key": schema.StringAttribute{
+					MarkdownDescription: "API key.",
+					Required:            true,
+					Sensitive:           true,
+				},
+				"host": schema.StringAttribute{
+					MarkdownDescription: "Host.",
+					Required:            true,
 				},
 			},
-			// Field values
-			"use_ssl": schema.BoolAttribute{
-				MarkdownDescription: "Use SSL flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"notify": schema.BoolAttribute{
-				MarkdownDescription: "Notify flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"update_library": schema.BoolAttribute{
-				MarkdownDescription: "Update library flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"port": schema.Int64Attribute{
-				MarkdownDescription: "Port.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"api_key": schema.StringAttribute{
-				MarkdownDescription: "API key.",
-				Required:            true,
-				Sensitive:           true,
-			},
-			"host": schema.StringAttribute{
-				MarkdownDescription: "Host.",
-				Required:            true,
-			},
-		},
+		),
 	}
 }
 
+func (r *NotificationEmbyResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return notificationUpgradeState(ctx, r, notificationAttributesV1)
+}
+
 func (r *NotificationEmbyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -243,9 +192,34 @@ func (r *NotificationEmbyResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new NotificationEmby
 	request := notification.read(ctx, &resp.Diagnostics)
 
+	if !typeNotificationFields(r.auth, r.client, request, notificationEmbyResourceName, &resp.Diagnostics, notification.ExtraFields) {
+		return
+	}
+
+	applyDefaultTags(r.auth, request, notification.SkipDefaultTags)
+
+	if !resolver.validate(notificationEmbyResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	if !validateNotificationTriggers(r.auth, r.client, request, notificationEmbyResourceName, &resp.Diagnostics) {
+		return
+	}
+
+	if notification.TestOnCreate.ValueBool() && !testNotification(r.auth, r.client, request, notificationEmbyResourceName, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationEmbyResourceName, err))
@@ -253,9 +227,13 @@ func (r *NotificationEmbyResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	response = helpers.ReadAfterCreate(ctx, response, notificationEmbyResourceName, r.client.NotificationAPI.GetNotificationById(r.auth, response.GetId()).Execute, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+notificationEmbyResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, notification.Tags, notification.SkipDefaultTags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &notification.TagLabels, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -270,8 +248,15 @@ func (r *NotificationEmbyResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	// Get NotificationEmby current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			resp.Diagnostics.AddWarning(helpers.ResourceNotFound, helpers.ParseResourceNotFound(notificationEmbyResourceName, notification.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationEmbyResourceName, err))
 
 		return
@@ -279,7 +264,11 @@ func (r *NotificationEmbyResource) Read(ctx context.Context, req resource.ReadRe
 
 	tflog.Trace(ctx, "read "+notificationEmbyResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	removeDefaultTags(ctx, r.auth, response, notification.Tags, notification.SkipDefaultTags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	newTagResolver(r.auth, r.client).write(ctx, &notification.TagLabels, notification.Tags, &resp.Diagnostics)
+	// Test flag is not stored by Lidarr, keep the state value or use the default on import
+	notification.TestOnCreate = types.BoolValue(notification.TestOnCreate.ValueBool())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -293,9 +282,31 @@ func (r *NotificationEmbyResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update NotificationEmby
 	request := notification.read(ctx, &resp.Diagnostics)
 
+	if !typeNotificationFields(r.auth, r.client, request, notificationEmbyResourceName, &resp.Diagnostics, notification.ExtraFields) {
+		return
+	}
+
+	preserveNotificationFields(r.auth, r.client, request)
+	applyDefaultTags(r.auth, request, notification.SkipDefaultTags)
+
+	if !resolver.validate(notificationEmbyResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	if !validateNotificationTriggers(r.auth, r.client, request, notificationEmbyResourceName, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationEmbyResourceName, err))
@@ -305,7 +316,9 @@ func (r *NotificationEmbyResource) Update(ctx context.Context, req resource.Upda
 
 	tflog.Trace(ctx, "updated "+notificationEmbyResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, notification.Tags, notification.SkipDefaultTags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &notification.TagLabels, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -331,10 +344,16 @@ func (r *NotificationEmbyResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *NotificationEmbyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	importNotificationState(ctx, r.auth, r.client, notificationEmbyImplementation, req, resp)
 	tflog.Trace(ctx, "imported "+notificationEmbyResourceName+": "+req.ID)
 }
 
+func (r *NotificationEmbyResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		notificationTriggersValidator{auth: r.auth},
+	}
+}
+
 func (n *NotificationEmby) write(ctx context.Context, notification *lidarr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
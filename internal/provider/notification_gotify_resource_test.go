@@ -15,27 +15,45 @@ func TestAccNotificationGotifyResource(t *testing.T) {
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
+			// Extra field conflicting with a typed attribute
+			{
+				Config:      testAccNotificationGotifyResourceExtraFieldsConfig("resourceGotifyTest", "appToken"),
+				ExpectError: regexp.MustCompile("Conflicting Extra Field"),
+			},
 			// Unauthorized Create
 			{
-				Config:      testAccNotificationGotifyResourceConfig("resourceGotifyTest", 0) + testUnauthorizedProvider,
+				Config:      testAccNotificationGotifyResourceConfig("resourceGotifyTest", 0, false) + testUnauthorizedProvider,
 				ExpectError: regexp.MustCompile("Client Error"),
 			},
+			// Failing test on create
+			{
+				Config:      testAccNotificationGotifyResourceConfig("resourceGotifyTest", 0, true),
+				ExpectError: regexp.MustCompile("Unable to validate"),
+			},
 			// Create and Read testing
 			{
-				Config: testAccNotificationGotifyResourceConfig("resourceGotifyTest", 0),
+				Config: testAccNotificationGotifyResourceConfig("resourceGotifyTest", 0, false),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("lidarr_notification_gotify.test", "priority", "0"),
+					resource.TestCheckResourceAttr("lidarr_notification_gotify.test", "test_on_create", "false"),
 					resource.TestCheckResourceAttrSet("lidarr_notification_gotify.test", "id"),
 				),
 			},
 			// Unauthorized Read
 			{
-				Config:      testAccNotificationGotifyResourceConfig("resourceGotifyTest", 0) + testUnauthorizedProvider,
+				Config:      testAccNotificationGotifyResourceConfig("resourceGotifyTest", 0, false) + testUnauthorizedProvider,
 				ExpectError: regexp.MustCompile("Client Error"),
 			},
+			// Extra fields testing
+			{
+				Config: testAccNotificationGotifyResourceExtraFieldsConfig("resourceGotifyTest", "includeArtistPoster"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lidarr_notification_gotify.test", "extra_fields.includeArtistPoster", "true"),
+				),
+			},
 			// Update and Read testing
 			{
-				Config: testAccNotificationGotifyResourceConfig("resourceGotifyTest", 5),
+				Config: testAccNotificationGotifyResourceConfig("resourceGotifyTest", 5, false),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("lidarr_notification_gotify.test", "priority", "5"),
 				),
@@ -47,12 +65,27 @@ func TestAccNotificationGotifyResource(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"app_token"},
 			},
+			// ImportState by name testing
+			{
+				ResourceName:            "lidarr_notification_gotify.test",
+				ImportState:             true,
+				ImportStateId:           "name:resourceGotifyTest",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"app_token"},
+			},
+			// ImportState by missing name testing
+			{
+				ResourceName:  "lidarr_notification_gotify.test",
+				ImportState:   true,
+				ImportStateId: "name:missingGotifyTest",
+				ExpectError:   regexp.MustCompile("No notification found"),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
 }
 
-func testAccNotificationGotifyResourceConfig(name string, priority int) string {
+func testAccNotificationGotifyResourceConfig(name string, priority int, testOnCreate bool) string {
 	return fmt.Sprintf(`
 	resource "lidarr_notification_gotify" "test" {
 		on_grab               = false
@@ -69,5 +102,23 @@ func testAccNotificationGotifyResourceConfig(name string, priority int) string {
 		server = "http://gotify-server.net"
 		app_token = "Token"
 		priority = %d
-	}`, name, priority)
+
+		test_on_create = %t
+	}`, name, priority, testOnCreate)
+}
+
+func testAccNotificationGotifyResourceExtraFieldsConfig(name, field string) string {
+	return fmt.Sprintf(`
+	resource "lidarr_notification_gotify" "test" {
+		on_grab = true
+		name    = "%s"
+
+		server = "http://gotify-server.net"
+		app_token = "Token"
+		priority = 0
+
+		extra_fields = {
+			%s = "true"
+		}
+	}`, name, field)
 }
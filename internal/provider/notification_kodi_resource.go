@@ -10,8 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -24,8 +23,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &NotificationKodiResource{}
-	_ resource.ResourceWithImportState = &NotificationKodiResource{}
+	_ resource.Resource                     = &NotificationKodiResource{}
+	_ resource.ResourceWithImportState      = &NotificationKodiResource{}
+	_ resource.ResourceWithConfigValidators = &NotificationKodiResource{}
+	_ resource.ResourceWithUpgradeState     = &NotificationKodiResource{}
 )
 
 func NewNotificationKodiResource() resource.Resource {
@@ -40,6 +41,8 @@ type NotificationKodiResource struct {
 
 // NotificationKodi describes the notification data model.
 type NotificationKodi struct {
+	TagLabels
+	ExtraFields           types.Map    `tfsdk:"extra_fields"`
 	Tags                  types.Set    `tfsdk:"tags"`
 	Host                  types.String `tfsdk:"host"`
 	Name                  types.String `tfsdk:"name"`
@@ -48,6 +51,7 @@ type NotificationKodi struct {
 	DisplayTime           types.Int64  `tfsdk:"display_time"`
 	Port                  types.Int64  `tfsdk:"port"`
 	ID                    types.Int64  `tfsdk:"id"`
+	TestOnCreate          types.Bool   `tfsdk:"test_on_create"`
 	OnGrab                types.Bool   `tfsdk:"on_grab"`
 	UseSSL                types.Bool   `tfsdk:"use_ssl"`
 	Notify                types.Bool   `tfsdk:"notify"`
@@ -62,10 +66,12 @@ type NotificationKodi struct {
 	OnHealthIssue         types.Bool   `tfsdk:"on_health_issue"`
 	OnHealthRestored      types.Bool   `tfsdk:"on_health_restored"`
 	OnUpgrade             types.Bool   `tfsdk:"on_upgrade"`
+	SkipDefaultTags       types.Bool   `tfsdk:"skip_default_tags"`
 }
 
 func (n NotificationKodi) toNotification() *Notification {
 	return &Notification{
+		Fields:                n.ExtraFields,
 		Tags:                  n.Tags,
 		Port:                  n.Port,
 		Host:                  n.Host,
@@ -116,6 +122,10 @@ func (n *NotificationKodi) fromNotification(notification *Notification) {
 	n.OnHealthRestored = notification.OnHealthRestored
 	n.OnRename = notification.OnRename
 	n.OnUpgrade = notification.OnUpgrade
+
+	if !n.ExtraFields.IsNull() {
+		n.ExtraFields = notification.Fields
+	}
 }
 
 func (r *NotificationKodiResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -124,124 +134,73 @@ func (r *NotificationKodiResource) Metadata(_ context.Context, req resource.Meta
 
 func (r *NotificationKodiResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             1,
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification Kodi resource.\nFor more information refer to [Notification](https://wiki.servarr.com/lidarr/settings#connect) and [Kodi](https://wiki.servarr.com/lidarr/supported#xbmc).",
-		Attributes: map[string]schema.Attribute{
-			"on_grab": schema.BoolAttribute{
-				MarkdownDescription: "On grab flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_upgrade": schema.BoolAttribute{
-				MarkdownDescription: "On upgrade flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_rename": schema.BoolAttribute{
-				MarkdownDescription: "On rename flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_track_retag": schema.BoolAttribute{
-				MarkdownDescription: "On track retag flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_release_import": schema.BoolAttribute{
-				MarkdownDescription: "On release import flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_health_issue": schema.BoolAttribute{
-				MarkdownDescription: "On health issue flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_health_restored": schema.BoolAttribute{
-				MarkdownDescription: "On health restored flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_application_update": schema.BoolAttribute{
-				MarkdownDescription: "On application update flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"include_health_warnings": schema.BoolAttribute{
-				MarkdownDescription: "Include health warnings.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "NotificationKodi name.",
-				Required:            true,
-			},
-			"tags": schema.SetAttribute{
-				MarkdownDescription: "List of associated tags.",
-				Optional:            true,
-				Computed:            true,
-				ElementType:         types.Int64Type,
-			},
-			"id": schema.Int64Attribute{
-				MarkdownDescription: "Notification ID.",
-				Computed:            true,
-				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.UseStateForUnknown(),
+		Attributes: notificationResourceAttributes(
+			[]string{"on_grab", "on_release_import", "on_upgrade", "on_rename", "on_health_issue", "on_health_restored", "on_track_retag", "on_application_update"},
+			map[string]schema.Attribute{
+				// Field values
+				"use_ssl": schema.BoolAttribute{
+					MarkdownDescription: "Use SSL flag.",
+					Optional:            true,
+					Computed:            true,
+				},
+				"notify": schema.BoolAttribute{
+					MarkdownDescription: "Notification flag.",
+					Optional:            true,
+					Computed:            true,
+				},
+				"update_library": schema.BoolAttribute{
+					MarkdownDescription: "Update library flag.",
+					Optional:            true,
+					Computed:            true,
+				},
+				"clean_library": schema.BoolAttribute{
+					MarkdownDescription: "Clean library flag.",
+					Optional:            true,
+					Computed:            true,
+				},
+				"always_update": schema.BoolAttribute{
+					MarkdownDescription: "Always update flag.",
+					Optional:            true,
+					Computed:            true,
+				},
+				"display_time": schema.Int64Attribute{
+					MarkdownDescription: "Display time.",
+					Optional:            true,
+					Computed:            true,
+				},
+				"port": schema.Int64Attribute{
+					MarkdownDescription: "Port.",
+					Required:            true,
+					Validators: []validator.Int64{
+						helpers.Port(),
+					},
+				},
+				"host": schema.StringAttribute{
+					MarkdownDescription: "Host.",
+					Required:            true,
+				},
+				"username": schema.StringAttribute{
+					MarkdownDescription: "Username.",
+					Optional:            true,
+					Computed:            true,
+				},
+				"password": schema.StringAttribute{
+					MarkdownDescription: "Password.",
+					Optional:            true,
+					Computed:            true,
+					Sensitive:           true,
 				},
 			},
-			// Field values
-			"use_ssl": schema.BoolAttribute{
-				MarkdownDescription: "Use SSL flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"notify": schema.BoolAttribute{
-				MarkdownDescription: "Notification flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"update_library": schema.BoolAttribute{
-				MarkdownDescription: "Update library flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"clean_library": schema.BoolAttribute{
-				MarkdownDescription: "Clean library flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"always_update": schema.BoolAttribute{
-				MarkdownDescription: "Always update flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"display_time": schema.Int64Attribute{
-				MarkdownDescription: "Display time.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"port": schema.Int64Attribute{
-				MarkdownDescription: "Port.",
-				Required:            true,
-			},
-			"host": schema.StringAttribute{
-				MarkdownDescription: "Host.",
-				Required:            true,
-			},
-			"username": schema.StringAttribute{
-				MarkdownDescription: "Username.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"password": schema.StringAttribute{
-				MarkdownDescription: "Password.",
-				Optional:            true,
-				Computed:            true,
-				Sensitive:           true,
-			},
-		},
+		),
 	}
 }
 
+func (r *NotificationKodiResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return notificationUpgradeState(ctx, r, notificationAttributesV1)
+}
+
 func (r *NotificationKodiResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -259,9 +218,34 @@ func (r *NotificationKodiResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new NotificationKodi
 	request := notification.read(ctx, &resp.Diagnostics)
 
+	if !typeNotificationFields(r.auth, r.client, request, notificationKodiResourceName, &resp.Diagnostics, notification.ExtraFields) {
+		return
+	}
+
+	applyDefaultTags(r.auth, request, notification.SkipDefaultTags)
+
+	if !resolver.validate(notificationKodiResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	if !validateNotificationTriggers(r.auth, r.client, request, notificationKodiResourceName, &resp.Diagnostics) {
+		return
+	}
+
+	if notification.TestOnCreate.ValueBool() && !testNotification(r.auth, r.client, request, notificationKodiResourceName, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationKodiResourceName, err))
@@ -269,9 +253,13 @@ func (r *NotificationKodiResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	response = helpers.ReadAfterCreate(ctx, response, notificationKodiResourceName, r.client.NotificationAPI.GetNotificationById(r.auth, response.GetId()).Execute, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+notificationKodiResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, notification.Tags, notification.SkipDefaultTags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &notification.TagLabels, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -286,8 +274,15 @@ func (r *NotificationKodiResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	// Get NotificationKodi current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			resp.Diagnostics.AddWarning(helpers.ResourceNotFound, helpers.ParseResourceNotFound(notificationKodiResourceName, notification.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationKodiResourceName, err))
 
 		return
@@ -295,7 +290,11 @@ func (r *NotificationKodiResource) Read(ctx context.Context, req resource.ReadRe
 
 	tflog.Trace(ctx, "read "+notificationKodiResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	removeDefaultTags(ctx, r.auth, response, notification.Tags, notification.SkipDefaultTags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	newTagResolver(r.auth, r.client).write(ctx, &notification.TagLabels, notification.Tags, &resp.Diagnostics)
+	// Test flag is not stored by Lidarr, keep the state value or use the default on import
+	notification.TestOnCreate = types.BoolValue(notification.TestOnCreate.ValueBool())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -309,9 +308,31 @@ func (r *NotificationKodiResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update NotificationKodi
 	request := notification.read(ctx, &resp.Diagnostics)
 
+	if !typeNotificationFields(r.auth, r.client, request, notificationKodiResourceName, &resp.Diagnostics, notification.ExtraFields) {
+		return
+	}
+
+	preserveNotificationFields(r.auth, r.client, request)
+	applyDefaultTags(r.auth, request, notification.SkipDefaultTags)
+
+	if !resolver.validate(notificationKodiResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	if !validateNotificationTriggers(r.auth, r.client, request, notificationKodiResourceName, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationKodiResourceName, err))
@@ -321,7 +342,9 @@ func (r *NotificationKodiResource) Update(ctx context.Context, req resource.Upda
 
 	tflog.Trace(ctx, "updated "+notificationKodiResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, notification.Tags, notification.SkipDefaultTags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &notification.TagLabels, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -347,10 +370,16 @@ func (r *NotificationKodiResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *NotificationKodiResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	importNotificationState(ctx, r.auth, r.client, notificationKodiImplementation, req, resp)
 	tflog.Trace(ctx, "imported "+notificationKodiResourceName+": "+req.ID)
 }
 
+func (r *NotificationKodiResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		notificationTriggersValidator{auth: r.auth},
+	}
+}
+
 func (n *NotificationKodi) write(ctx context.Context, notification *lidarr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
@@ -10,8 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -24,8 +23,10 @@ const (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &NotificationPlexResource{}
-	_ resource.ResourceWithImportState = &NotificationPlexResource{}
+	_ resource.Resource                     = &NotificationPlexResource{}
+	_ resource.ResourceWithImportState      = &NotificationPlexResource{}
+	_ resource.ResourceWithConfigValidators = &NotificationPlexResource{}
+	_ resource.ResourceWithUpgradeState     = &NotificationPlexResource{}
 )
 
 func NewNotificationPlexResource() resource.Resource {
@@ -40,12 +41,15 @@ type NotificationPlexResource struct {
 
 // NotificationPlex describes the notification data model.
 type NotificationPlex struct {
+	TagLabels
+	ExtraFields     types.Map    `tfsdk:"extra_fields"`
 	Tags            types.Set    `tfsdk:"tags"`
 	Host            types.String `tfsdk:"host"`
 	AuthToken       types.String `tfsdk:"auth_token"`
 	Name            types.String `tfsdk:"name"`
 	ID              types.Int64  `tfsdk:"id"`
 	Port            types.Int64  `tfsdk:"port"`
+	TestOnCreate    types.Bool   `tfsdk:"test_on_create"`
 	UpdateLibrary   types.Bool   `tfsdk:"update_library"`
 	UseSSL          types.Bool   `tfsdk:"use_ssl"`
 	OnReleaseImport types.Bool   `tfsdk:"on_release_import"`
@@ -54,10 +58,12 @@ type NotificationPlex struct {
 	OnTrackRetag    types.Bool   `tfsdk:"on_track_retag"`
 	OnRename        types.Bool   `tfsdk:"on_rename"`
 	OnUpgrade       types.Bool   `tfsdk:"on_upgrade"`
+	SkipDefaultTags types.Bool   `tfsdk:"skip_default_tags"`
 }
 
 func (n NotificationPlex) toNotification() *Notification {
 	return &Notification{
+		Fields:          n.ExtraFields,
 		Tags:            n.Tags,
 		Host:            n.Host,
 		Name:            n.Name,
@@ -90,6 +96,10 @@ func (n *NotificationPlex) fromNotification(notification *Notification) {
 	n.OnTrackRetag = notification.OnTrackRetag
 	n.OnRename = notification.OnRename
 	n.OnUpgrade = notification.OnUpgrade
+
+	if !n.ExtraFields.IsNull() {
+		n.ExtraFields = notification.Fields
+	}
 }
 
 func (r *NotificationPlexResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -98,84 +108,48 @@ func (r *NotificationPlexResource) Metadata(_ context.Context, req resource.Meta
 
 func (r *NotificationPlexResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             1,
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification Plex resource.\nFor more information refer to [Notification](https://wiki.servarr.com/lidarr/settings#connect) and [Plex](https://wiki.servarr.com/lidarr/supported#plexserver).",
-		Attributes: map[string]schema.Attribute{
-			"on_upgrade": schema.BoolAttribute{
-				MarkdownDescription: "On upgrade flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_rename": schema.BoolAttribute{
-				MarkdownDescription: "On rename flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_track_retag": schema.BoolAttribute{
-				MarkdownDescription: "On track retag flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_release_import": schema.BoolAttribute{
-				MarkdownDescription: "On release import flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_album_delete": schema.BoolAttribute{
-				MarkdownDescription: "On album delete flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_artist_delete": schema.BoolAttribute{
-				MarkdownDescription: "On artist delete flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "NotificationPlex name.",
-				Required:            true,
-			},
-			"tags": schema.SetAttribute{
-				MarkdownDescription: "List of associated tags.",
-				Optional:            true,
-				Computed:            true,
-				ElementType:         types.Int64Type,
-			},
-			"id": schema.Int64Attribute{
-				MarkdownDescription: "Notification ID.",
-				Computed:            true,
-				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.UseStateForUnknown(),
+		Attributes: notificationResourceAttributes(
+			[]string{"on_release_import", "on_upgrade", "on_rename", "on_artist_delete", "on_album_delete", "on_track_retag"},
+			map[string]schema.Attribute{
+				// Field values
+				"use_ssl": schema.BoolAttribute{
+					MarkdownDescription: "Use SSL flag.",
+					Optional:            true,
+					Computed:            true,
+				},
+				"update_library": schema.BoolAttribute{
+					MarkdownDescription: "Update library flag.",
+					Optional:            true,
+					Computed:            true,
+				},
+				"port": schema.Int64Attribute{
+					MarkdownDescription: "Port.",
+					Optional:            true,
+					Computed:            true,
+					Validators: []validator.Int64{
+						helpers.Port(),
+					},
+				},
+				"auth_token": schema.StringAttribute{
+					MarkdownDescription: "Auth Token.",
+					Required:            true,
+					Sensitive:           true,
+				},
+				"host": schema.StringAttribute{
+					MarkdownDescription: "Host.",
+					Required:            true,
 				},
 			},
-			// Field values
-			"use_ssl": schema.BoolAttribute{
-				MarkdownDescription: "Use SSL flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"update_library": schema.BoolAttribute{
-				MarkdownDescription: "Update library flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"port": schema.Int64Attribute{
-				MarkdownDescription: "Port.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"auth_token": schema.StringAttribute{
-				MarkdownDescription: "Auth Token.",
-				Required:            true,
-				Sensitive:           true,
-			},
-			"host": schema.StringAttribute{
-				MarkdownDescription: "Host.",
-				Required:            true,
-			},
-		},
+		),
 	}
 }
 
+func (r *NotificationPlexResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return notificationUpgradeState(ctx, r, notificationAttributesV1)
+}
+
 func (r *NotificationPlexResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -193,9 +167,34 @@ func (r *NotificationPlexResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new NotificationPlex
 	request := notification.read(ctx, &resp.Diagnostics)
 
+	if !typeNotificationFields(r.auth, r.client, request, notificationPlexResourceName, &resp.Diagnostics, notification.ExtraFields) {
+		return
+	}
+
+	applyDefaultTags(r.auth, request, notification.SkipDefaultTags)
+
+	if !resolver.validate(notificationPlexResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	if !validateNotificationTriggers(r.auth, r.client, request, notificationPlexResourceName, &resp.Diagnostics) {
+		return
+	}
+
+	if notification.TestOnCreate.ValueBool() && !testNotification(r.auth, r.client, request, notificationPlexResourceName, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationPlexResourceName, err))
@@ -203,9 +202,13 @@ func (r *NotificationPlexResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	response = helpers.ReadAfterCreate(ctx, response, notificationPlexResourceName, r.client.NotificationAPI.GetNotificationById(r.auth, response.GetId()).Execute, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+notificationPlexResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, notification.Tags, notification.SkipDefaultTags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &notification.TagLabels, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -220,8 +223,15 @@ func (r *NotificationPlexResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	// Get NotificationPlex current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			resp.Diagnostics.AddWarning(helpers.ResourceNotFound, helpers.ParseResourceNotFound(notificationPlexResourceName, notification.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationPlexResourceName, err))
 
 		return
@@ -229,7 +239,11 @@ func (r *NotificationPlexResource) Read(ctx context.Context, req resource.ReadRe
 
 	tflog.Trace(ctx, "read "+notificationPlexResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	removeDefaultTags(ctx, r.auth, response, notification.Tags, notification.SkipDefaultTags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	newTagResolver(r.auth, r.client).write(ctx, &notification.TagLabels, notification.Tags, &resp.Diagnostics)
+	// Test flag is not stored by Lidarr, keep the state value or use the default on import
+	notification.TestOnCreate = types.BoolValue(notification.TestOnCreate.ValueBool())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -243,9 +257,31 @@ func (r *NotificationPlexResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update NotificationPlex
 	request := notification.read(ctx, &resp.Diagnostics)
 
+	if !typeNotificationFields(r.auth, r.client, request, notificationPlexResourceName, &resp.Diagnostics, notification.ExtraFields) {
+		return
+	}
+
+	preserveNotificationFields(r.auth, r.client, request)
+	applyDefaultTags(r.auth, request, notification.SkipDefaultTags)
+
+	if !resolver.validate(notificationPlexResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	if !validateNotificationTriggers(r.auth, r.client, request, notificationPlexResourceName, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationPlexResourceName, err))
@@ -255,7 +291,9 @@ func (r *NotificationPlexResource) Update(ctx context.Context, req resource.Upda
 
 	tflog.Trace(ctx, "updated "+notificationPlexResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, notification.Tags, notification.SkipDefaultTags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &notification.TagLabels, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -281,10 +319,16 @@ func (r *NotificationPlexResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *NotificationPlexResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	importNotificationState(ctx, r.auth, r.client, notificationPlexImplementation, req, resp)
 	tflog.Trace(ctx, "imported "+notificationPlexResourceName+": "+req.ID)
 }
 
+func (r *NotificationPlexResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		notificationTriggersValidator{auth: r.auth},
+	}
+}
+
 func (n *NotificationPlex) write(ctx context.Context, notification *lidarr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
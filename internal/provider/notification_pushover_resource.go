@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
 	"github.com/devopsarr/lidarr-go/lidarr"
@@ -11,8 +12,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -22,12 +21,22 @@ const (
 	notificationPushoverResourceName   = "notification_pushover"
 	notificationPushoverImplementation = "Pushover"
 	notificationPushoverConfigContract = "PushoverSettings"
+	invalidPushoverEmergency           = "Invalid Pushover Emergency Priority"
+	// notificationPushoverEmergency is the priority requiring the notification to be acknowledged.
+	notificationPushoverEmergency = 2
+	// notificationPushoverMinRetry is the minimum retry interval in seconds of emergency notifications.
+	notificationPushoverMinRetry = 30
+	// notificationPushoverMaxExpire is the maximum time in seconds emergency notifications are retried for.
+	notificationPushoverMaxExpire = 10800
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &NotificationPushoverResource{}
-	_ resource.ResourceWithImportState = &NotificationPushoverResource{}
+	_ resource.Resource                     = &NotificationPushoverResource{}
+	_ resource.ResourceWithImportState      = &NotificationPushoverResource{}
+	_ resource.ResourceWithConfigValidators = &NotificationPushoverResource{}
+	_ resource.ResourceWithUpgradeState     = &NotificationPushoverResource{}
+	_ resource.ConfigValidator              = notificationPushoverEmergencyValidator{}
 )
 
 func NewNotificationPushoverResource() resource.Resource {
@@ -42,6 +51,8 @@ type NotificationPushoverResource struct {
 
 // NotificationPushover describes the notification data model.
 type NotificationPushover struct {
+	TagLabels
+	ExtraFields           types.Map    `tfsdk:"extra_fields"`
 	Tags                  types.Set    `tfsdk:"tags"`
 	Devices               types.Set    `tfsdk:"devices"`
 	Sound                 types.String `tfsdk:"sound"`
@@ -52,9 +63,11 @@ type NotificationPushover struct {
 	ID                    types.Int64  `tfsdk:"id"`
 	Retry                 types.Int64  `tfsdk:"retry"`
 	Expire                types.Int64  `tfsdk:"expire"`
+	TestOnCreate          types.Bool   `tfsdk:"test_on_create"`
 	OnGrab                types.Bool   `tfsdk:"on_grab"`
 	OnReleaseImport       types.Bool   `tfsdk:"on_release_import"`
 	OnAlbumDelete         types.Bool   `tfsdk:"on_album_delete"`
+	OnArtistAdd           types.Bool   `tfsdk:"on_artist_add"`
 	OnArtistDelete        types.Bool   `tfsdk:"on_artist_delete"`
 	IncludeHealthWarnings types.Bool   `tfsdk:"include_health_warnings"`
 	OnApplicationUpdate   types.Bool   `tfsdk:"on_application_update"`
@@ -63,10 +76,12 @@ type NotificationPushover struct {
 	OnDownloadFailure     types.Bool   `tfsdk:"on_download_failure"`
 	OnUpgrade             types.Bool   `tfsdk:"on_upgrade"`
 	OnImportFailure       types.Bool   `tfsdk:"on_import_failure"`
+	SkipDefaultTags       types.Bool   `tfsdk:"skip_default_tags"`
 }
 
 func (n NotificationPushover) toNotification() *Notification {
 	return &Notification{
+		Fields:                n.ExtraFields,
 		Tags:                  n.Tags,
 		Devices:               n.Devices,
 		Sound:                 n.Sound,
@@ -80,6 +95,7 @@ func (n NotificationPushover) toNotification() *Notification {
 		OnGrab:                n.OnGrab,
 		OnReleaseImport:       n.OnReleaseImport,
 		OnAlbumDelete:         n.OnAlbumDelete,
+		OnArtistAdd:           n.OnArtistAdd,
 		OnArtistDelete:        n.OnArtistDelete,
 		IncludeHealthWarnings: n.IncludeHealthWarnings,
 		OnApplicationUpdate:   n.OnApplicationUpdate,
@@ -107,6 +123,7 @@ func (n *NotificationPushover) fromNotification(notification *Notification) {
 	n.OnGrab = notification.OnGrab
 	n.OnReleaseImport = notification.OnReleaseImport
 	n.OnAlbumDelete = notification.OnAlbumDelete
+	n.OnArtistAdd = notification.OnArtistAdd
 	n.OnArtistDelete = notification.OnArtistDelete
 	n.IncludeHealthWarnings = notification.IncludeHealthWarnings
 	n.OnApplicationUpdate = notification.OnApplicationUpdate
@@ -115,6 +132,10 @@ func (n *NotificationPushover) fromNotification(notification *Notification) {
 	n.OnDownloadFailure = notification.OnDownloadFailure
 	n.OnUpgrade = notification.OnUpgrade
 	n.OnImportFailure = notification.OnImportFailure
+
+	if !n.ExtraFields.IsNull() {
+		n.ExtraFields = notification.Fields
+	}
 }
 
 func (r *NotificationPushoverResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -123,124 +144,66 @@ func (r *NotificationPushoverResource) Metadata(_ context.Context, req resource.
 
 func (r *NotificationPushoverResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             1,
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification Pushover resource.\nFor more information refer to [Notification](https://wiki.servarr.com/lidarr/settings#connect) and [Pushover](https://wiki.servarr.com/lidarr/supported#pushover).",
-		Attributes: map[string]schema.Attribute{
-			"on_grab": schema.BoolAttribute{
-				MarkdownDescription: "On grab flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_import_failure": schema.BoolAttribute{
-				MarkdownDescription: "On download flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_upgrade": schema.BoolAttribute{
-				MarkdownDescription: "On upgrade flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_download_failure": schema.BoolAttribute{
-				MarkdownDescription: "On download failure flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_release_import": schema.BoolAttribute{
-				MarkdownDescription: "On release import flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_album_delete": schema.BoolAttribute{
-				MarkdownDescription: "On album delete flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_artist_delete": schema.BoolAttribute{
-				MarkdownDescription: "On artist delete flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_health_issue": schema.BoolAttribute{
-				MarkdownDescription: "On health issue flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_health_restored": schema.BoolAttribute{
-				MarkdownDescription: "On health restored flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_application_update": schema.BoolAttribute{
-				MarkdownDescription: "On application update flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"include_health_warnings": schema.BoolAttribute{
-				MarkdownDescription: "Include health warnings.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "NotificationPushover name.",
-				Required:            true,
-			},
-			"tags": schema.SetAttribute{
-				MarkdownDescription: "List of associated tags.",
-				Optional:            true,
-				Computed:            true,
-				ElementType:         types.Int64Type,
-			},
-			"id": schema.Int64Attribute{
-				MarkdownDescription: "Notification ID.",
-				Computed:            true,
-				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.UseStateForUnknown(),
+		Attributes: notificationResourceAttributes(
+			[]string{"on_grab", "on_release_import", "on_upgrade", "on_artist_add", "on_artist_delete", "on_album_delete", "on_health_issue", "on_health_restored", "on_download_failure", "on_import_failure", "on_application_update"},
+			map[string]schema.Attribute{
+				// Field values
+				"priority": schema.Int64Attribute{
+					MarkdownDescription: "Priority. `-2` Silent, `-1` Quiet, `0` Normal, `1` High, `2` Emergency.",
+					Optional:            true,
+					Computed:            true,
+					Validators: []validator.Int64{
+						int64validator.Between(-2, 2),
+					},
 				},
-			},
-			// Field values
-			"priority": schema.Int64Attribute{
-				MarkdownDescription: "Priority. `-2` Silent, `-1` Quiet, `0` Normal, `1` High, `2` Emergency, `8` High.",
-				Optional:            true,
-				Computed:            true,
-				Validators: []validator.Int64{
-					int64validator.OneOf(-1, -2, 0, 1, 2),
+				"retry": schema.Int64Attribute{
+					MarkdownDescription: "Retry interval in seconds of emergency notifications, at least `30` with priority `2`.",
+					Optional:            true,
+					Computed:            true,
+					Validators: []validator.Int64{
+						int64validator.AtLeast(0),
+					},
+				},
+				"expire": schema.Int64Attribute{
+					MarkdownDescription: "Time in seconds emergency notifications are retried for, between `1` and `10800` with priority `2`.",
+					Optional:            true,
+					Computed:            true,
+					Validators: []validator.Int64{
+						int64validator.Between(0, notificationPushoverMaxExpire),
+					},
+				},
+				"sound": schema.StringAttribute{
+					MarkdownDescription: "Sound.",
+					Optional:            true,
+					Computed:            true,
+				},
+				"api_key": schema.StringAttribute{
+					MarkdownDescription: "API key.",
+					Required:            true,
+					Sensitive:           true,
+				},
+				"user_key": schema.StringAttribute{
+					MarkdownDescription: "User key.",
+					Optional:            true,
+					Sensitive:           true,
+				},
+				"devices": schema.SetAttribute{
+					MarkdownDescription: "List of devices.",
+					Optional:            true,
+					Computed:            true,
+					ElementType:         types.StringType,
 				},
 			},
-			"retry": schema.Int64Attribute{
-				MarkdownDescription: "Retry.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"expire": schema.Int64Attribute{
-				MarkdownDescription: "Expire.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"sound": schema.StringAttribute{
-				MarkdownDescription: "Sound.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"api_key": schema.StringAttribute{
-				MarkdownDescription: "API key.",
-				Required:            true,
-				Sensitive:           true,
-			},
-			"user_key": schema.StringAttribute{
-				MarkdownDescription: "User key.",
-				Optional:            true,
-				Sensitive:           true,
-			},
-			"devices": schema.SetAttribute{
-				MarkdownDescription: "List of devices.",
-				Optional:            true,
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
+		),
 	}
 }
 
+func (r *NotificationPushoverResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return notificationUpgradeState(ctx, r, notificationAttributesV1, "on_artist_add")
+}
+
 func (r *NotificationPushoverResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -258,9 +221,34 @@ func (r *NotificationPushoverResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new NotificationPushover
 	request := notification.read(ctx, &resp.Diagnostics)
 
+	if !typeNotificationFields(r.auth, r.client, request, notificationPushoverResourceName, &resp.Diagnostics, notification.ExtraFields) {
+		return
+	}
+
+	applyDefaultTags(r.auth, request, notification.SkipDefaultTags)
+
+	if !resolver.validate(notificationPushoverResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	if !validateNotificationTriggers(r.auth, r.client, request, notificationPushoverResourceName, &resp.Diagnostics) {
+		return
+	}
+
+	if notification.TestOnCreate.ValueBool() && !testNotification(r.auth, r.client, request, notificationPushoverResourceName, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationPushoverResourceName, err))
@@ -268,9 +256,13 @@ func (r *NotificationPushoverResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	response = helpers.ReadAfterCreate(ctx, response, notificationPushoverResourceName, r.client.NotificationAPI.GetNotificationById(r.auth, response.GetId()).Execute, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+notificationPushoverResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, notification.Tags, notification.SkipDefaultTags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &notification.TagLabels, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -285,8 +277,15 @@ func (r *NotificationPushoverResource) Read(ctx context.Context, req resource.Re
 	}
 
 	// Get NotificationPushover current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			resp.Diagnostics.AddWarning(helpers.ResourceNotFound, helpers.ParseResourceNotFound(notificationPushoverResourceName, notification.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationPushoverResourceName, err))
 
 		return
@@ -294,7 +293,11 @@ func (r *NotificationPushoverResource) Read(ctx context.Context, req resource.Re
 
 	tflog.Trace(ctx, "read "+notificationPushoverResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	removeDefaultTags(ctx, r.auth, response, notification.Tags, notification.SkipDefaultTags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	newTagResolver(r.auth, r.client).write(ctx, &notification.TagLabels, notification.Tags, &resp.Diagnostics)
+	// Test flag is not stored by Lidarr, keep the state value or use the default on import
+	notification.TestOnCreate = types.BoolValue(notification.TestOnCreate.ValueBool())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -308,9 +311,31 @@ func (r *NotificationPushoverResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update NotificationPushover
 	request := notification.read(ctx, &resp.Diagnostics)
 
+	if !typeNotificationFields(r.auth, r.client, request, notificationPushoverResourceName, &resp.Diagnostics, notification.ExtraFields) {
+		return
+	}
+
+	preserveNotificationFields(r.auth, r.client, request)
+	applyDefaultTags(r.auth, request, notification.SkipDefaultTags)
+
+	if !resolver.validate(notificationPushoverResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	if !validateNotificationTriggers(r.auth, r.client, request, notificationPushoverResourceName, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationPushoverResourceName, err))
@@ -320,7 +345,9 @@ func (r *NotificationPushoverResource) Update(ctx context.Context, req resource.
 
 	tflog.Trace(ctx, "updated "+notificationPushoverResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, notification.Tags, notification.SkipDefaultTags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &notification.TagLabels, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -346,10 +373,52 @@ func (r *NotificationPushoverResource) Delete(ctx context.Context, req resource.
 }
 
 func (r *NotificationPushoverResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	importNotificationState(ctx, r.auth, r.client, notificationPushoverImplementation, req, resp)
 	tflog.Trace(ctx, "imported "+notificationPushoverResourceName+": "+req.ID)
 }
 
+func (r *NotificationPushoverResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		notificationTriggersValidator{auth: r.auth},
+		notificationPushoverEmergencyValidator{},
+	}
+}
+
+// notificationPushoverEmergencyValidator enforces the retry and expire Pushover requires with emergency priority.
+// Lidarr forwards invalid values as they are, and the notifications then silently fail.
+type notificationPushoverEmergencyValidator struct{}
+
+func (v notificationPushoverEmergencyValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("emergency priority requires retry to be at least %d and expire between 1 and %d", notificationPushoverMinRetry, notificationPushoverMaxExpire)
+}
+
+func (v notificationPushoverEmergencyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v notificationPushoverEmergencyValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var priority, retry, expire types.Int64
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("priority"), &priority)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("retry"), &retry)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("expire"), &expire)...)
+
+	if resp.Diagnostics.HasError() || priority.ValueInt64() != notificationPushoverEmergency {
+		return
+	}
+
+	// Unset values are sent as 0
+	if !retry.IsUnknown() && retry.ValueInt64() < notificationPushoverMinRetry {
+		resp.Diagnostics.AddAttributeError(path.Root("retry"), invalidPushoverEmergency,
+			fmt.Sprintf("Pushover requires retry to be at least %d seconds with emergency priority (2), got %d.", notificationPushoverMinRetry, retry.ValueInt64()))
+	}
+
+	if !expire.IsUnknown() && (expire.ValueInt64() < 1 || expire.ValueInt64() > notificationPushoverMaxExpire) {
+		resp.Diagnostics.AddAttributeError(path.Root("expire"), invalidPushoverEmergency,
+			fmt.Sprintf("Pushover requires expire to be between 1 and %d seconds with emergency priority (2), got %d.", notificationPushoverMaxExpire, expire.ValueInt64()))
+	}
+}
+
 func (n *NotificationPushover) write(ctx context.Context, notification *lidarr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
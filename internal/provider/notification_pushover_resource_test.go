@@ -1,11 +1,16 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
 
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccNotificationPushoverResource(t *testing.T) {
@@ -15,14 +20,24 @@ func TestAccNotificationPushoverResource(t *testing.T) {
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
+			// Invalid priority
+			{
+				Config:      testAccNotificationPushoverResourceConfig("resourcePushoverTest", 3, 0, 0),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value"),
+			},
+			// Emergency priority without retry
+			{
+				Config:      testAccNotificationPushoverResourceConfig("resourcePushoverTest", 2, 0, 3600),
+				ExpectError: regexp.MustCompile("Invalid Pushover Emergency Priority"),
+			},
 			// Unauthorized Create
 			{
-				Config:      testAccNotificationPushoverResourceConfig("resourcePushoverTest", 0) + testUnauthorizedProvider,
+				Config:      testAccNotificationPushoverResourceConfig("resourcePushoverTest", 0, 0, 0) + testUnauthorizedProvider,
 				ExpectError: regexp.MustCompile("Client Error"),
 			},
 			// Create and Read testing
 			{
-				Config: testAccNotificationPushoverResourceConfig("resourcePushoverTest", 0),
+				Config: testAccNotificationPushoverResourceConfig("resourcePushoverTest", 0, 0, 0),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("lidarr_notification_pushover.test", "priority", "0"),
 					resource.TestCheckResourceAttrSet("lidarr_notification_pushover.test", "id"),
@@ -30,14 +45,16 @@ func TestAccNotificationPushoverResource(t *testing.T) {
 			},
 			// Unauthorized Read
 			{
-				Config:      testAccNotificationPushoverResourceConfig("resourcePushoverTest", 0) + testUnauthorizedProvider,
+				Config:      testAccNotificationPushoverResourceConfig("resourcePushoverTest", 0, 0, 0) + testUnauthorizedProvider,
 				ExpectError: regexp.MustCompile("Client Error"),
 			},
 			// Update and Read testing
 			{
-				Config: testAccNotificationPushoverResourceConfig("resourcePushoverTest", 2),
+				Config: testAccNotificationPushoverResourceConfig("resourcePushoverTest", 2, 60, 3600),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("lidarr_notification_pushover.test", "priority", "2"),
+					resource.TestCheckResourceAttr("lidarr_notification_pushover.test", "retry", "60"),
+					resource.TestCheckResourceAttr("lidarr_notification_pushover.test", "expire", "3600"),
 				),
 			},
 			// ImportState testing
@@ -52,7 +69,7 @@ func TestAccNotificationPushoverResource(t *testing.T) {
 	})
 }
 
-func testAccNotificationPushoverResourceConfig(name string, priority int) string {
+func testAccNotificationPushoverResourceConfig(name string, priority, retry, expire int) string {
 	return fmt.Sprintf(`
 	resource "lidarr_notification_pushover" "test" {
 		on_grab          		= false
@@ -68,5 +85,88 @@ func testAccNotificationPushoverResourceConfig(name string, priority int) string
 
 		api_key = "Key"
 		priority = %d
-	}`, name, priority)
+		retry = %d
+		expire = %d
+	}`, name, priority, retry, expire)
+}
+
+func TestNotificationPushoverEmergencyValidator(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		priority types.Int64
+		retry    types.Int64
+		expire   types.Int64
+		errors   int
+	}{
+		"normal priority": {
+			priority: types.Int64Value(0),
+			retry:    types.Int64Null(),
+			expire:   types.Int64Null(),
+		},
+		"unknown priority": {
+			priority: types.Int64Unknown(),
+			retry:    types.Int64Value(10),
+			expire:   types.Int64Null(),
+		},
+		"valid emergency": {
+			priority: types.Int64Value(2),
+			retry:    types.Int64Value(30),
+			expire:   types.Int64Value(10800),
+		},
+		"unknown values": {
+			priority: types.Int64Value(2),
+			retry:    types.Int64Unknown(),
+			expire:   types.Int64Unknown(),
+		},
+		"short retry": {
+			priority: types.Int64Value(2),
+			retry:    types.Int64Value(10),
+			expire:   types.Int64Value(3600),
+			errors:   1,
+		},
+		"long expire": {
+			priority: types.Int64Value(2),
+			retry:    types.Int64Value(60),
+			expire:   types.Int64Value(20000),
+			errors:   1,
+		},
+		"unset values": {
+			priority: types.Int64Value(2),
+			retry:    types.Int64Null(),
+			expire:   types.Int64Null(),
+			errors:   2,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			r := &NotificationPushoverResource{}
+
+			schemaResp := fwresource.SchemaResponse{}
+			r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+			config := tfsdk.State{Schema: schemaResp.Schema}
+			diags := config.Set(ctx, NotificationPushover{
+				TagLabels:   TagLabels{Labels: types.SetNull(types.StringType)},
+				ExtraFields: types.MapNull(types.StringType),
+				Tags:        types.SetNull(types.Int64Type),
+				Devices:     types.SetNull(types.StringType),
+				Priority:    test.priority,
+				Retry:       test.retry,
+				Expire:      test.expire,
+			})
+			assert.False(t, diags.HasError())
+
+			resp := fwresource.ValidateConfigResponse{}
+			notificationPushoverEmergencyValidator{}.ValidateResource(ctx, fwresource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw},
+			}, &resp)
+			assert.Equal(t, test.errors, resp.Diagnostics.ErrorsCount(), resp.Diagnostics)
+		})
+	}
 }
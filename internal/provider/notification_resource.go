@@ -2,7 +2,12 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"maps"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
 
 	"github.com/devopsarr/lidarr-go/lidarr"
 	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
@@ -12,8 +17,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -23,14 +30,16 @@ const notificationResourceName = "notification"
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &NotificationResource{}
-	_ resource.ResourceWithImportState = &NotificationResource{}
+	_ resource.Resource                     = &NotificationResource{}
+	_ resource.ResourceWithImportState      = &NotificationResource{}
+	_ resource.ResourceWithConfigValidators = &NotificationResource{}
+	_ resource.ResourceWithUpgradeState     = &NotificationResource{}
 )
 
 var notificationFields = helpers.Fields{
 	Bools:                  []string{"alwaysUpdate", "cleanLibrary", "directMessage", "notify", "requireEncryption", "sendSilently", "updateLibrary", "useEuEndpoint", "useSsl"},
 	Strings:                []string{"accessToken", "accessTokenSecret", "apiKey", "aPIKey", "appToken", "arguments", "author", "authToken", "authUser", "avatar", "botToken", "channel", "chatId", "consumerKey", "consumerSecret", "deviceNames", "expires", "from", "host", "icon", "mention", "password", "path", "refreshToken", "senderDomain", "senderId", "server", "signIn", "sound", "token", "urlBase", "url", "userKey", "username", "userName", "webHookUrl", "authUsername", "authPassword", "statelessUrls", "configurationKey", "serverUrl", "clickUrl", "event", "key", "senderNumber", "receiverId"},
-	Ints:                   []string{"method", "port", "priority", "displayTime", "retry", "expire", "notificationType"},
+	Ints:                   []string{"method", "port", "priority", "displayTime", "retry", "expire", "notificationType", "useEncryption", "topicId"},
 	StringSlices:           []string{"channelTags", "deviceIds", "devices", "recipients", "to", "cC", "bcc", "fieldTags", "topics"},
 	StringSlicesExceptions: []string{"tags"},
 	IntSlices:              []string{"grabFields", "importFields"},
@@ -46,9 +55,20 @@ type NotificationResource struct {
 	auth   context.Context
 }
 
+// NotificationResourceData describes the notification resource data model.
+// It extends the notification data model with attributes not returned by Lidarr.
+type NotificationResourceData struct {
+	SensitiveFields types.Map `tfsdk:"sensitive_fields"`
+	Notification
+	TagLabels
+	TestOnCreate    types.Bool `tfsdk:"test_on_create"`
+	SkipDefaultTags types.Bool `tfsdk:"skip_default_tags"`
+}
+
 // Notification describes the notification data model.
 type Notification struct {
 	Tags                  types.Set    `tfsdk:"tags"`
+	Fields                types.Map    `tfsdk:"fields"`
 	FieldTags             types.Set    `tfsdk:"field_tags"`
 	Recipients            types.Set    `tfsdk:"recipients"`
 	Devices               types.Set    `tfsdk:"devices"`
@@ -113,6 +133,8 @@ type Notification struct {
 	DisplayTime           types.Int64  `tfsdk:"display_time"`
 	Priority              types.Int64  `tfsdk:"priority"`
 	Port                  types.Int64  `tfsdk:"port"`
+	UseEncryption         types.Int64  `tfsdk:"use_encryption"`
+	TopicID               types.Int64  `tfsdk:"topic_id"`
 	Method                types.Int64  `tfsdk:"method"`
 	ID                    types.Int64  `tfsdk:"id"`
 	UpdateLibrary         types.Bool   `tfsdk:"update_library"`
@@ -128,6 +150,7 @@ type Notification struct {
 	OnGrab                types.Bool   `tfsdk:"on_grab"`
 	OnReleaseImport       types.Bool   `tfsdk:"on_release_import"`
 	OnAlbumDelete         types.Bool   `tfsdk:"on_album_delete"`
+	OnArtistAdd           types.Bool   `tfsdk:"on_artist_add"`
 	OnArtistDelete        types.Bool   `tfsdk:"on_artist_delete"`
 	OnUpgrade             types.Bool   `tfsdk:"on_upgrade"`
 	OnRename              types.Bool   `tfsdk:"on_rename"`
@@ -143,6 +166,7 @@ func (n Notification) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
 			"tags":                    types.SetType{}.WithElementType(types.Int64Type),
+			"fields":                  types.MapType{}.WithElementType(types.StringType),
 			"import_fields":           types.SetType{}.WithElementType(types.Int64Type),
 			"grab_fields":             types.SetType{}.WithElementType(types.Int64Type),
 			"field_tags":              types.SetType{}.WithElementType(types.StringType),
@@ -207,6 +231,8 @@ func (n Notification) getType() attr.Type {
 			"display_time":            types.Int64Type,
 			"priority":                types.Int64Type,
 			"port":                    types.Int64Type,
+			"use_encryption":          types.Int64Type,
+			"topic_id":                types.Int64Type,
 			"method":                  types.Int64Type,
 			"id":                      types.Int64Type,
 			"update_library":          types.BoolType,
@@ -222,6 +248,7 @@ func (n Notification) getType() attr.Type {
 			"on_grab":                 types.BoolType,
 			"on_release_import":       types.BoolType,
 			"on_album_delete":         types.BoolType,
+			"on_artist_add":           types.BoolType,
 			"on_artist_delete":        types.BoolType,
 			"on_upgrade":              types.BoolType,
 			"on_rename":               types.BoolType,
@@ -240,91 +267,42 @@ func (r *NotificationResource) Metadata(_ context.Context, req resource.Metadata
 
 func (r *NotificationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             1,
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nGeneric Notification resource. When possible use a specific resource instead.\nFor more information refer to [Notification](https://wiki.servarr.com/lidarr/settings#connect).",
 		Attributes: map[string]schema.Attribute{
-			"on_grab": schema.BoolAttribute{
-				MarkdownDescription: "On grab flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_release_import": schema.BoolAttribute{
-				MarkdownDescription: "On release import flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_album_delete": schema.BoolAttribute{
-				MarkdownDescription: "On album delete flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_artist_delete": schema.BoolAttribute{
-				MarkdownDescription: "On artist delete flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_upgrade": schema.BoolAttribute{
-				MarkdownDescription: "On upgrade flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_rename": schema.BoolAttribute{
-				MarkdownDescription: "On rename flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_download_failure": schema.BoolAttribute{
-				MarkdownDescription: "On download failure flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_import_failure": schema.BoolAttribute{
-				MarkdownDescription: "On import failure flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_track_retag": schema.BoolAttribute{
-				MarkdownDescription: "On track retag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_health_issue": schema.BoolAttribute{
-				MarkdownDescription: "On health issue flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_health_restored": schema.BoolAttribute{
-				MarkdownDescription: "On health restored flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_application_update": schema.BoolAttribute{
-				MarkdownDescription: "On application update flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"include_health_warnings": schema.BoolAttribute{
-				MarkdownDescription: "Include health warnings.",
-				Optional:            true,
-				Computed:            true,
-			},
 			"config_contract": schema.StringAttribute{
 				MarkdownDescription: "Notification configuration template.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"implementation": schema.StringAttribute{
 				MarkdownDescription: "Notification implementation name.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Notification name.",
 				Required:            true,
 			},
+			"test_on_create": schema.BoolAttribute{
+				MarkdownDescription: "Run the Lidarr notification test before creating it. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"tags": schema.SetAttribute{
 				MarkdownDescription: "List of associated tags.",
 				Optional:            true,
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels":          tagLabelsAttribute(),
+			"create_missing_tags": createMissingTagsAttribute(),
+			"skip_default_tags":   skipDefaultTagsAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -382,6 +360,22 @@ func (r *NotificationResource) Schema(_ context.Context, _ resource.SchemaReques
 				MarkdownDescription: "Port.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Int64{
+					helpers.PortOrDefault(),
+				},
+			},
+			"topic_id": schema.Int64Attribute{
+				MarkdownDescription: "Topic ID.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"use_encryption": schema.Int64Attribute{
+				MarkdownDescription: "Use encryption. `0` Preferred, `1` Always, `2` Never.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.OneOf(0, 1, 2),
+				},
 			},
 			"method": schema.Int64Attribute{
 				MarkdownDescription: "Method. `1` POST, `2` PUT.",
@@ -716,8 +710,31 @@ func (r *NotificationResource) Schema(_ context.Context, _ resource.SchemaReques
 				Computed:            true,
 				ElementType:         types.StringType,
 			},
+			"fields": schema.MapAttribute{
+				MarkdownDescription: "Raw field values for settings not covered by other attributes, keyed by API field name. Values are converted to the type of the Lidarr field, lists are written as JSON.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Map{
+					helpers.UnmanagedFields(),
+				},
+			},
+			"sensitive_fields": schema.MapAttribute{
+				MarkdownDescription: "Raw field values like `fields`, for secrets that Lidarr masks on read. Values are kept from the configuration.",
+				Optional:            true,
+				Sensitive:           true,
+				ElementType:         types.StringType,
+			},
 		},
 	}
+
+	// Trigger flags are shared with the implementation specific resources
+	maps.Copy(resp.Schema.Attributes, notificationTriggerAttributes(allNotificationTriggers()))
+}
+
+func (r *NotificationResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return notificationUpgradeState(ctx, r, nil,
+		"create_missing_tags", "fields", "on_artist_add", "sensitive_fields", "skip_default_tags", "tag_labels", "test_on_create", "topic_id", "use_encryption")
 }
 
 func (r *NotificationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -729,7 +746,7 @@ func (r *NotificationResource) Configure(ctx context.Context, req resource.Confi
 
 func (r *NotificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var notification *Notification
+	var notification *NotificationResourceData
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &notification)...)
 
@@ -737,9 +754,34 @@ func (r *NotificationResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new Notification
 	request := notification.read(ctx, &resp.Diagnostics)
 
+	if !typeNotificationFields(r.auth, r.client, request, notificationResourceName, &resp.Diagnostics, notification.Fields, notification.SensitiveFields) {
+		return
+	}
+
+	applyDefaultTags(r.auth, request, notification.SkipDefaultTags)
+
+	if !resolver.validate(notificationResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	if !validateNotificationTriggers(r.auth, r.client, request, notificationResourceName, &resp.Diagnostics) {
+		return
+	}
+
+	if notification.TestOnCreate.ValueBool() && !testNotification(r.auth, r.client, request, notificationResourceName, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationResourceName, err))
@@ -747,19 +789,25 @@ func (r *NotificationResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	response = helpers.ReadAfterCreate(ctx, response, notificationResourceName, r.client.NotificationAPI.GetNotificationById(r.auth, response.GetId()).Execute, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+notificationResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Notification
+	var state NotificationResourceData
 
 	state.writeSensitive(notification)
+	removeDefaultTags(ctx, r.auth, response, notification.Tags, notification.SkipDefaultTags)
 	state.write(ctx, response, &resp.Diagnostics)
+	state.TagLabels = notification.TagLabels
+	state.SkipDefaultTags = notification.SkipDefaultTags
+	resolver.write(ctx, &state.TagLabels, state.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *NotificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var notification *Notification
+	var notification *NotificationResourceData
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &notification)...)
 
@@ -768,8 +816,15 @@ func (r *NotificationResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Get Notification current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			resp.Diagnostics.AddWarning(helpers.ResourceNotFound, helpers.ParseResourceNotFound(notificationResourceName, notification.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationResourceName, err))
 
 		return
@@ -778,16 +833,20 @@ func (r *NotificationResource) Read(ctx context.Context, req resource.ReadReques
 	tflog.Trace(ctx, "read "+notificationResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Notification
+	var state NotificationResourceData
 
 	state.writeSensitive(notification)
+	removeDefaultTags(ctx, r.auth, response, notification.Tags, notification.SkipDefaultTags)
 	state.write(ctx, response, &resp.Diagnostics)
+	state.TagLabels = notification.TagLabels
+	state.SkipDefaultTags = notification.SkipDefaultTags
+	newTagResolver(r.auth, r.client).write(ctx, &state.TagLabels, state.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *NotificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var notification *Notification
+	var notification *NotificationResourceData
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &notification)...)
 
@@ -795,9 +854,30 @@ func (r *NotificationResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update Notification
 	request := notification.read(ctx, &resp.Diagnostics)
 
+	if !typeNotificationFields(r.auth, r.client, request, notificationResourceName, &resp.Diagnostics, notification.Fields, notification.SensitiveFields) {
+		return
+	}
+
+	applyDefaultTags(r.auth, request, notification.SkipDefaultTags)
+
+	if !resolver.validate(notificationResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	if !validateNotificationTriggers(r.auth, r.client, request, notificationResourceName, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationResourceName, err))
@@ -808,10 +888,14 @@ func (r *NotificationResource) Update(ctx context.Context, req resource.UpdateRe
 	tflog.Trace(ctx, "updated "+notificationResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Notification
+	var state NotificationResourceData
 
 	state.writeSensitive(notification)
+	removeDefaultTags(ctx, r.auth, response, notification.Tags, notification.SkipDefaultTags)
 	state.write(ctx, response, &resp.Diagnostics)
+	state.TagLabels = notification.TagLabels
+	state.SkipDefaultTags = notification.SkipDefaultTags
+	resolver.write(ctx, &state.TagLabels, state.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -837,7 +921,7 @@ func (r *NotificationResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *NotificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	importNotificationState(ctx, r.auth, r.client, "", req, resp)
 	tflog.Trace(ctx, "imported "+notificationResourceName+": "+req.ID)
 }
 
@@ -852,6 +936,7 @@ func (n *Notification) write(ctx context.Context, notification *lidarr.Notificat
 	n.OnUpgrade = types.BoolValue(notification.GetOnUpgrade())
 	n.OnRename = types.BoolValue(notification.GetOnRename())
 	n.OnReleaseImport = types.BoolValue(notification.GetOnReleaseImport())
+	n.OnArtistAdd = types.BoolValue(notification.GetOnArtistAdd())
 	n.OnArtistDelete = types.BoolValue(notification.GetOnArtistDelete())
 	n.OnAlbumDelete = types.BoolValue(notification.GetOnAlbumDelete())
 	n.OnTrackRetag = types.BoolValue(notification.GetOnTrackRetag())
@@ -876,6 +961,7 @@ func (n *Notification) write(ctx context.Context, notification *lidarr.Notificat
 	n.FieldTags = types.SetValueMust(types.StringType, nil)
 	n.Topics = types.SetValueMust(types.StringType, nil)
 	helpers.WriteFields(ctx, n, notification.GetFields(), notificationFields)
+	n.Fields = helpers.WriteMapFields(ctx, notification.GetFields(), notificationFields, n.Fields)
 }
 
 func (n *Notification) read(ctx context.Context, diags *diag.Diagnostics) *lidarr.NotificationResource {
@@ -883,6 +969,7 @@ func (n *Notification) read(ctx context.Context, diags *diag.Diagnostics) *lidar
 	notification.SetOnGrab(n.OnGrab.ValueBool())
 	notification.SetOnReleaseImport(n.OnReleaseImport.ValueBool())
 	notification.SetOnAlbumDelete(n.OnAlbumDelete.ValueBool())
+	notification.SetOnArtistAdd(n.OnArtistAdd.ValueBool())
 	notification.SetOnArtistDelete(n.OnArtistDelete.ValueBool())
 	notification.SetOnUpgrade(n.OnUpgrade.ValueBool())
 	notification.SetOnRename(n.OnRename.ValueBool())
@@ -898,11 +985,55 @@ func (n *Notification) read(ctx context.Context, diags *diag.Diagnostics) *lidar
 	notification.SetImplementation(n.Implementation.ValueString())
 	notification.SetConfigContract(n.ConfigContract.ValueString())
 	diags.Append(n.Tags.ElementsAs(ctx, &notification.Tags, true)...)
-	notification.SetFields(helpers.ReadFields(ctx, n, notificationFields))
+	notification.SetFields(helpers.MergeFields(helpers.ReadFields(ctx, n, notificationFields), helpers.ReadMapFields(ctx, n.Fields)))
+
+	return notification
+}
+
+func (r *NotificationResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		notificationTriggersValidator{auth: r.auth},
+	}
+}
+
+func (n *NotificationResourceData) write(ctx context.Context, notification *lidarr.NotificationResource, diags *diag.Diagnostics) {
+	n.Notification.write(ctx, notification, diags)
+
+	// Secrets belong to sensitive_fields only, even when Lidarr returns them unmasked
+	if n.SensitiveFields.IsNull() || n.Fields.IsNull() {
+		return
+	}
+
+	fields := make(map[string]attr.Value, len(n.Fields.Elements()))
+	for k, v := range n.Fields.Elements() {
+		if _, ok := n.SensitiveFields.Elements()[k]; !ok {
+			fields[k] = v
+		}
+	}
+
+	n.Fields = types.MapValueMust(types.StringType, fields)
+}
+
+func (n *NotificationResourceData) read(ctx context.Context, diags *diag.Diagnostics) *lidarr.NotificationResource {
+	notification := n.Notification.read(ctx, diags)
+	notification.SetFields(helpers.MergeFields(notification.GetFields(), helpers.ReadMapFields(ctx, n.SensitiveFields)))
 
 	return notification
 }
 
+// writeSensitive copy sensitive data, fields and test data from another resource.
+func (n *NotificationResourceData) writeSensitive(notification *NotificationResourceData) {
+	// Test flag is not stored by Lidarr, keep the state value or use the default on import
+	n.TestOnCreate = types.BoolValue(notification.TestOnCreate.ValueBool())
+
+	n.Notification.writeSensitive(&notification.Notification)
+	n.SensitiveFields = notification.SensitiveFields
+
+	if !notification.Fields.IsUnknown() {
+		n.Fields = notification.Fields
+	}
+}
+
 // writeSensitive copy sensitive data from another resource.
 func (n *Notification) writeSensitive(notification *Notification) {
 	if !notification.Token.IsUnknown() {
@@ -949,7 +1080,200 @@ func (n *Notification) writeSensitive(notification *Notification) {
 		n.AuthPassword = notification.AuthPassword
 	}
 
-	if !notification.AuthPassword.IsUnknown() {
+	if !notification.SenderNumber.IsUnknown() {
 		n.SenderNumber = notification.SenderNumber
 	}
+
+	if !notification.Key.IsUnknown() {
+		n.Key = notification.Key
+	}
+}
+
+// importNotificationState imports a notification either by ID or by unique name using the "name:" prefix.
+// When an implementation is given, the matched notification must be of that implementation.
+func importNotificationState(ctx context.Context, auth context.Context, client *lidarr.APIClient, implementation string, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	name, found := strings.CutPrefix(req.ID, "name:")
+	if !found {
+		helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+
+		return
+	}
+
+	response, _, err := client.NotificationAPI.ListNotification(auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, notificationResourceName, err))
+
+		return
+	}
+
+	var ids []int32
+
+	for _, n := range response {
+		if n.GetName() != name {
+			continue
+		}
+
+		if implementation != "" && n.GetImplementation() != implementation {
+			resp.Diagnostics.AddError(helpers.UnexpectedImportIdentifier,
+				fmt.Sprintf("Notification '%s' has implementation '%s', expected '%s'", name, n.GetImplementation(), implementation))
+
+			return
+		}
+
+		ids = append(ids, n.GetId())
+	}
+
+	helpers.ImportStateByName(ctx, path.Root("id"), notificationResourceName, name, ids, resp)
+}
+
+// preserveNotificationFields keeps the current notification settings not managed by the provider, so that typed resources do not reset them on update.
+func preserveNotificationFields(auth context.Context, client *lidarr.APIClient, request *lidarr.NotificationResource) {
+	current, _, err := client.NotificationAPI.GetNotificationById(auth, request.GetId()).Execute()
+	if err != nil {
+		// Errors are reported by the update itself
+		return
+	}
+
+	request.SetFields(helpers.PreserveFields(request.GetFields(), current.GetFields(), notificationFields))
+}
+
+// testNotification runs the Lidarr notification test and reports any validation failure.
+func testNotification(auth context.Context, client *lidarr.APIClient, request *lidarr.NotificationResource, name string, diags *diag.Diagnostics) bool {
+	_, err := client.NotificationAPI.TestNotification(auth).NotificationResource(*request).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseValidationError(helpers.Validate, name, err))
+
+		return false
+	}
+
+	return true
+}
+
+// notificationSensitiveAttributes lists the attributes marked as sensitive in the notification resource schema.
+var notificationSensitiveAttributes = sync.OnceValue(func() []string {
+	var resp resource.SchemaResponse
+
+	(&NotificationResource{}).Schema(context.Background(), resource.SchemaRequest{}, &resp)
+
+	attributes := make([]string, 0)
+
+	for name, attribute := range resp.Schema.Attributes {
+		if attribute.IsSensitive() {
+			attributes = append(attributes, name)
+		}
+	}
+
+	sort.Strings(attributes)
+
+	return attributes
+})
+
+// notificationSchemas holds the notification schemas of a Lidarr instance, keyed by implementation.
+type notificationSchemas struct {
+	schemas map[string]*lidarr.NotificationResource
+	mu      sync.Mutex
+}
+
+// notificationSchemasCache holds a notificationSchemas for each configured client.
+var notificationSchemasCache sync.Map
+
+// getNotificationSchema returns the notification schema of the given implementation, nil if Lidarr does not provide it.
+// Schemas are fetched once per client, since they only change with the Lidarr version.
+func getNotificationSchema(auth context.Context, client *lidarr.APIClient, implementation, name string, diags *diag.Diagnostics) (*lidarr.NotificationResource, bool) {
+	cached, _ := notificationSchemasCache.LoadOrStore(client, &notificationSchemas{})
+	schemas, _ := cached.(*notificationSchemas)
+
+	schemas.mu.Lock()
+	defer schemas.mu.Unlock()
+
+	if schemas.schemas == nil {
+		response, _, err := client.NotificationAPI.ListNotificationSchema(auth).Execute()
+		if err != nil {
+			diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Validate, name, err))
+
+			return nil, false
+		}
+
+		schemas.schemas = make(map[string]*lidarr.NotificationResource, len(response))
+		for i := range response {
+			schemas.schemas[response[i].GetImplementation()] = &response[i]
+		}
+	}
+
+	return schemas.schemas[implementation], true
+}
+
+// validateNotificationTriggers checks that every enabled trigger flag is supported by the notification implementation.
+func validateNotificationTriggers(auth context.Context, client *lidarr.APIClient, notification *lidarr.NotificationResource, name string, diags *diag.Diagnostics) bool {
+	schema, ok := getNotificationSchema(auth, client, notification.GetImplementation(), name, diags)
+	if !ok {
+		return false
+	}
+
+	// Unknown implementations are left to Lidarr validation
+	if schema == nil {
+		return true
+	}
+
+	valid := true
+
+	for _, trigger := range notificationTriggers {
+		if trigger.enabled(notification) && !trigger.supported(schema) {
+			diags.AddAttributeError(path.Root(trigger.attribute), helpers.ResourceError,
+				fmt.Sprintf("%s notifications do not support %s", notification.GetImplementation(), trigger.attribute))
+
+			valid = false
+		}
+	}
+
+	return valid
+}
+
+var _ resource.ConfigValidator = notificationTriggersValidator{}
+
+// notificationTriggersValidator reports notifications without any trigger enabled, since they never fire.
+type notificationTriggersValidator struct {
+	auth context.Context
+}
+
+func (v notificationTriggersValidator) Description(_ context.Context) string {
+	return "at least one trigger flag or include_health_warnings must be enabled"
+}
+
+func (v notificationTriggersValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v notificationTriggersValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	attributes := []string{"include_health_warnings"}
+	for _, trigger := range notificationTriggers {
+		attributes = append(attributes, trigger.attribute)
+	}
+
+	for _, attribute := range attributes {
+		var value types.Bool
+
+		// Skip the triggers not exposed by the resource
+		if diags := req.Config.GetAttribute(ctx, path.Root(attribute), &value); diags.HasError() {
+			continue
+		}
+
+		if value.IsUnknown() || value.ValueBool() {
+			return
+		}
+	}
+
+	summary := "Notification Never Triggered"
+	detail := "All trigger flags and include_health_warnings are disabled, the notification will never fire."
+
+	// The provider is not configured during terraform validate, only warn in that case
+	if v.auth != nil {
+		if strict, _ := v.auth.Value(strictNotificationTriggersKey{}).(bool); strict {
+			resp.Diagnostics.AddError(summary, detail)
+
+			return
+		}
+	}
+
+	resp.Diagnostics.AddWarning(summary, detail)
 }
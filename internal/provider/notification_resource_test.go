@@ -1,16 +1,20 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 func TestAccNotificationResource(t *testing.T) {
 	t.Parallel()
 
+	var id int32
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -38,6 +42,25 @@ func TestAccNotificationResource(t *testing.T) {
 				Config: testAccNotificationResourceConfig("resourceTest", "true"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("lidarr_notification.test", "on_upgrade", "true"),
+					testAccResourceID("lidarr_notification.test", &id),
+				),
+			},
+			// Out of band delete testing
+			{
+				PreConfig: func() {
+					if _, err := testAccAPIClient().NotificationAPI.DeleteNotification(context.Background(), id).Execute(); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:             testAccNotificationResourceConfig("resourceTest", "true"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Recreate testing
+			{
+				Config: testAccNotificationResourceConfig("resourceTest", "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("lidarr_notification.test", "id"),
 				),
 			},
 			// ImportState testing
@@ -46,6 +69,15 @@ func TestAccNotificationResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Replace testing
+			{
+				Config: testAccNotificationResourceReplaceConfig("resourceTest"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("lidarr_notification.test", plancheck.ResourceActionReplace),
+					},
+				},
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -74,3 +106,160 @@ func testAccNotificationResourceConfig(name, upgrade string) string {
 		path = "/scripts/test.sh"
 	}`, upgrade, name)
 }
+
+func TestAccNotificationResourceFields(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Managed field testing
+			{
+				Config:      testAccNotificationResourceFieldsConfig("resourceFieldsTest", 2, `fields = { "server" = "http://gotify-server.net" }`),
+				ExpectError: regexp.MustCompile("Conflicting Extra Field"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccNotificationResourceFieldsConfig("resourceFieldsTest", 2, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("lidarr_notification.test", "fields.server"),
+					resource.TestCheckResourceAttr("lidarr_notification.test", "server", "http://gotify-server.net"),
+					resource.TestCheckNoResourceAttr("lidarr_notification.test", "fields.appToken"),
+					resource.TestCheckResourceAttrSet("lidarr_notification.test", "id"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccNotificationResourceFieldsConfig("resourceFieldsTest", 5, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lidarr_notification.test", "priority", "5"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "lidarr_notification.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// secrets are masked on import
+				ImportStateVerifyIgnore: []string{"sensitive_fields", "app_token"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccNotificationResourceFieldsConfig(name string, priority int, fields string) string {
+	return fmt.Sprintf(`
+	resource "lidarr_notification" "test" {
+		on_grab               = false
+		on_import_failure     = false
+		on_upgrade            = false
+		on_download_failure   = false
+		on_release_import     = false
+		on_health_issue       = false
+		on_application_update = false
+
+		include_health_warnings = false
+		name                    = "%s"
+
+		implementation  = "Gotify"
+		config_contract = "GotifySettings"
+
+		server   = "http://gotify-server.net"
+		priority = %d
+		%s
+		sensitive_fields = {
+			"appToken" = "Token"
+		}
+	}`, name, priority, fields)
+}
+
+func TestAccNotificationResourceUnsupportedTrigger(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unsupported trigger testing
+			{
+				Config:      testAccNotificationResourceUnsupportedTriggerConfig("resourceUnsupportedTest"),
+				ExpectError: regexp.MustCompile("Gotify notifications do not support on_rename"),
+			},
+		},
+	})
+}
+
+func testAccNotificationResourceUnsupportedTriggerConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "lidarr_notification" "test" {
+		on_grab   = true
+		on_rename = true
+
+		name = "%s"
+
+		implementation  = "Gotify"
+		config_contract = "GotifySettings"
+
+		server    = "http://gotify-server.net"
+		app_token = "Token"
+		priority  = 5
+	}`, name)
+}
+
+func TestAccNotificationResourceNoTrigger(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Strict provider testing
+			{
+				Config:      testAccNotificationResourceNoTriggerConfig("resourceNoTriggerTest") + testStrictNotificationTriggersProvider,
+				ExpectError: regexp.MustCompile("Notification Never Triggered"),
+			},
+			// Warning only testing
+			{
+				Config: testAccNotificationResourceNoTriggerConfig("resourceNoTriggerTest"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lidarr_notification.test", "on_grab", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccNotificationResourceNoTriggerConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "lidarr_notification" "test" {
+		on_grab                 = false
+		on_health_issue         = false
+		include_health_warnings = false
+
+		name = "%s"
+
+		implementation  = "CustomScript"
+		config_contract = "CustomScriptSettings"
+
+		path = "/scripts/test.sh"
+	}`, name)
+}
+
+func testAccNotificationResourceReplaceConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "lidarr_notification" "test" {
+		on_grab = true
+
+		include_health_warnings = false
+		name                    = "%s"
+
+		implementation  = "Webhook"
+		config_contract = "WebhookSettings"
+
+		url    = "http://webhook.lidarr.test"
+		method = 1
+	}`, name)
+}
@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// notificationTrigger links a trigger flag to its support flag in the notification schema.
+type notificationTrigger struct {
+	attribute   string
+	description string
+	enabled     func(*lidarr.NotificationResource) bool
+	supported   func(*lidarr.NotificationResource) bool
+}
+
+// notificationTriggers is the single definition of the trigger flags, shared by every notification schema.
+var notificationTriggers = []notificationTrigger{
+	{"on_grab", "On grab flag.", (*lidarr.NotificationResource).GetOnGrab, (*lidarr.NotificationResource).GetSupportsOnGrab},
+	{"on_release_import", "On release import flag.", (*lidarr.NotificationResource).GetOnReleaseImport, (*lidarr.NotificationResource).GetSupportsOnReleaseImport},
+	{"on_upgrade", "On upgrade flag.", (*lidarr.NotificationResource).GetOnUpgrade, (*lidarr.NotificationResource).GetSupportsOnUpgrade},
+	{"on_rename", "On rename flag.", (*lidarr.NotificationResource).GetOnRename, (*lidarr.NotificationResource).GetSupportsOnRename},
+	{"on_artist_add", "On artist add flag.", (*lidarr.NotificationResource).GetOnArtistAdd, (*lidarr.NotificationResource).GetSupportsOnArtistAdd},
+	{"on_artist_delete", "On artist delete flag.", (*lidarr.NotificationResource).GetOnArtistDelete, (*lidarr.NotificationResource).GetSupportsOnArtistDelete},
+	{"on_album_delete", "On album delete flag.", (*lidarr.NotificationResource).GetOnAlbumDelete, (*lidarr.NotificationResource).GetSupportsOnAlbumDelete},
+	{"on_health_issue", "On health issue flag.", (*lidarr.NotificationResource).GetOnHealthIssue, (*lidarr.NotificationResource).GetSupportsOnHealthIssue},
+	{"on_health_restored", "On health restored flag.", (*lidarr.NotificationResource).GetOnHealthRestored, (*lidarr.NotificationResource).GetSupportsOnHealthRestored},
+	{"on_download_failure", "On download failure flag.", (*lidarr.NotificationResource).GetOnDownloadFailure, (*lidarr.NotificationResource).GetSupportsOnDownloadFailure},
+	{"on_import_failure", "On import failure flag.", (*lidarr.NotificationResource).GetOnImportFailure, (*lidarr.NotificationResource).GetSupportsOnImportFailure},
+	{"on_track_retag", "On track retag flag.", (*lidarr.NotificationResource).GetOnTrackRetag, (*lidarr.NotificationResource).GetSupportsOnTrackRetag},
+	{"on_application_update", "On application update flag.", (*lidarr.NotificationResource).GetOnApplicationUpdate, (*lidarr.NotificationResource).GetSupportsOnApplicationUpdate},
+}
+
+// allNotificationTriggers lists the attribute of every trigger flag.
+func allNotificationTriggers() []string {
+	attributes := make([]string, len(notificationTriggers))
+	for i, trigger := range notificationTriggers {
+		attributes[i] = trigger.attribute
+	}
+
+	return attributes
+}
+
+// notificationTriggerAttributes returns the resource attributes of the given trigger flags.
+// Health warnings are included along with the health issue flag, the only trigger they apply to.
+func notificationTriggerAttributes(triggers []string) map[string]schema.Attribute {
+	attributes := make(map[string]schema.Attribute, len(triggers)+1)
+
+	for _, trigger := range notificationTriggers {
+		if !slices.Contains(triggers, trigger.attribute) {
+			continue
+		}
+
+		attributes[trigger.attribute] = schema.BoolAttribute{
+			MarkdownDescription: trigger.description,
+			Optional:            true,
+			Computed:            true,
+		}
+	}
+
+	if _, ok := attributes["on_health_issue"]; ok {
+		attributes["include_health_warnings"] = schema.BoolAttribute{
+			MarkdownDescription: "Include health warnings.",
+			Optional:            true,
+			Computed:            true,
+		}
+	}
+
+	return attributes
+}
+
+// notificationResourceAttributes builds the attributes of an implementation specific notification resource,
+// adding the given trigger flags and the attributes shared by every notification to its fields.
+func notificationResourceAttributes(triggers []string, fields map[string]schema.Attribute) map[string]schema.Attribute {
+	attributes := notificationTriggerAttributes(triggers)
+	attributes["extra_fields"] = helpers.ExtraFieldsAttribute()
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "Notification name.",
+		Required:            true,
+	}
+	attributes["test_on_create"] = schema.BoolAttribute{
+		MarkdownDescription: "Run the Lidarr notification test before creating it. Defaults to `false`.",
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+	}
+	attributes["tags"] = schema.SetAttribute{
+		MarkdownDescription: "List of associated tags.",
+		Optional:            true,
+		Computed:            true,
+		ElementType:         types.Int64Type,
+	}
+	attributes["tag_labels"] = tagLabelsAttribute()
+	attributes["create_missing_tags"] = createMissingTagsAttribute()
+	attributes["skip_default_tags"] = skipDefaultTagsAttribute()
+	attributes["id"] = schema.Int64Attribute{
+		MarkdownDescription: "Notification ID.",
+		Computed:            true,
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+	}
+
+	maps.Copy(attributes, fields)
+
+	return attributes
+}
+
+// notificationAttributesV1 lists the attributes every implementation specific notification resource gained in schema version 1.
+var notificationAttributesV1 = []string{"create_missing_tags", "extra_fields", "skip_default_tags", "tag_labels", "test_on_create"}
+
+// notificationUpgradeState returns the upgrader of a notification resource state from schema version 0.
+// Version 1 only added attributes, so the prior schema is the current one without the common and added attributes.
+func notificationUpgradeState(ctx context.Context, r resource.Resource, common []string, added ...string) map[int64]resource.StateUpgrader {
+	var current resource.SchemaResponse
+
+	r.Schema(ctx, resource.SchemaRequest{}, &current)
+
+	prior := schema.Schema{Attributes: maps.Clone(current.Schema.Attributes)}
+	for _, name := range append(slices.Clone(common), added...) {
+		delete(prior.Attributes, name)
+	}
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &prior,
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				helpers.UpgradeState(ctx, req, resp)
+			},
+		},
+	}
+}
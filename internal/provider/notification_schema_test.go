@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+// notificationResourceSchemas returns the schema of every notification resource by type name.
+func notificationResourceSchemas(t *testing.T) map[string]resource.SchemaResponse {
+	t.Helper()
+
+	schemas := make(map[string]resource.SchemaResponse)
+
+	for _, newResource := range (&LidarrProvider{}).Resources(context.Background()) {
+		r := newResource()
+
+		var metadata resource.MetadataResponse
+
+		r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "lidarr"}, &metadata)
+
+		if !strings.HasPrefix(metadata.TypeName, "lidarr_notification") {
+			continue
+		}
+
+		var response resource.SchemaResponse
+
+		r.Schema(context.Background(), resource.SchemaRequest{}, &response)
+		schemas[metadata.TypeName] = response
+	}
+
+	return schemas
+}
+
+func TestNotificationResourceTriggers(t *testing.T) {
+	t.Parallel()
+
+	all := allNotificationTriggers()
+	common := []string{"on_album_delete", "on_application_update", "on_artist_add", "on_artist_delete", "on_download_failure", "on_grab", "on_health_issue", "on_health_restored", "on_import_failure", "on_release_import", "on_upgrade"}
+	noFailure := []string{"on_album_delete", "on_application_update", "on_artist_add", "on_artist_delete", "on_grab", "on_health_issue", "on_health_restored", "on_release_import", "on_upgrade"}
+	library := []string{"on_album_delete", "on_artist_delete", "on_release_import", "on_rename", "on_track_retag", "on_upgrade"}
+
+	// Trigger flags exposed by each resource, pinned to the schemas defined before sharing the trigger table.
+	tests := map[string][]string{
+		"lidarr_notification":                  all,
+		"lidarr_notification_apprise":          common,
+		"lidarr_notification_custom_script":    all,
+		"lidarr_notification_discord":          all,
+		"lidarr_notification_email":            all,
+		"lidarr_notification_emby":             {"on_album_delete", "on_application_update", "on_artist_delete", "on_grab", "on_health_issue", "on_health_restored", "on_release_import", "on_rename", "on_track_retag", "on_upgrade"},
+		"lidarr_notification_gotify":           common,
+		"lidarr_notification_join":             noFailure,
+		"lidarr_notification_kodi":             {"on_application_update", "on_grab", "on_health_issue", "on_health_restored", "on_release_import", "on_rename", "on_track_retag", "on_upgrade"},
+		"lidarr_notification_mailgun":          noFailure,
+		"lidarr_notification_notifiarr":        noFailure,
+		"lidarr_notification_ntfy":             common,
+		"lidarr_notification_plex":             library,
+		"lidarr_notification_prowl":            noFailure,
+		"lidarr_notification_pushbullet":       common,
+		"lidarr_notification_pushover":         common,
+		"lidarr_notification_sendgrid":         common,
+		"lidarr_notification_signal":           common,
+		"lidarr_notification_simplepush":       common,
+		"lidarr_notification_slack":            all,
+		"lidarr_notification_subsonic":         {"on_album_delete", "on_artist_delete", "on_grab", "on_health_issue", "on_release_import", "on_rename", "on_track_retag", "on_upgrade"},
+		"lidarr_notification_synology_indexer": library,
+		"lidarr_notification_telegram":         common,
+		"lidarr_notification_twitter":          common,
+		"lidarr_notification_webhook":          all,
+	}
+
+	schemas := notificationResourceSchemas(t)
+	assert.Len(t, schemas, len(tests))
+
+	for name, triggers := range tests {
+		name := name
+		triggers := triggers
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			response, ok := schemas[name]
+			assert.True(t, ok)
+			assert.False(t, response.Diagnostics.HasError())
+
+			flags := make([]string, 0, len(triggers))
+
+			for attribute := range response.Schema.Attributes {
+				if strings.HasPrefix(attribute, "on_") {
+					flags = append(flags, attribute)
+				}
+			}
+
+			assert.ElementsMatch(t, triggers, flags)
+
+			// Health warnings only apply along with the health issue flag
+			_, health := response.Schema.Attributes["on_health_issue"]
+			_, warnings := response.Schema.Attributes["include_health_warnings"]
+			assert.Equal(t, health, warnings)
+		})
+	}
+}
+
+func TestNotificationResourceSharedAttributes(t *testing.T) {
+	t.Parallel()
+
+	shared := notificationResourceAttributes(allNotificationTriggers(), nil)
+
+	for name, response := range notificationResourceSchemas(t) {
+		name := name
+		response := response
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			for attribute, expected := range shared {
+				actual, ok := response.Schema.Attributes[attribute]
+
+				switch {
+				case attribute == "extra_fields" && name == "lidarr_notification":
+					// The generic resource exposes the fields as attributes instead
+					assert.False(t, ok)
+				case strings.HasPrefix(attribute, "on_") || attribute == "include_health_warnings":
+					if ok {
+						assert.Equal(t, expected, actual, attribute)
+					}
+				default:
+					assert.Equal(t, expected, actual, attribute)
+				}
+			}
+		})
+	}
+}
+
+func TestNotificationResourceVersion(t *testing.T) {
+	t.Parallel()
+
+	for name, response := range notificationResourceSchemas(t) {
+		assert.Equal(t, int64(1), response.Schema.Version, name)
+	}
+}
+
+func TestNotificationUpgradeStateV0(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		state string
+		empty []string
+	}{
+		"version 0": {
+			state: `{"id":1,"name":"test","tags":[1],"web_hook_url":"https://discord.com/api/webhooks/test","username":"user",` +
+				`"avatar":"","author":"","grab_fields":[0,1],"import_fields":[0,1],"on_grab":true,"on_release_import":false,` +
+				`"on_upgrade":false,"on_rename":false,"on_album_delete":false,"on_artist_delete":false,"on_track_retag":false,` +
+				`"on_health_issue":false,"on_health_restored":false,"include_health_warnings":false,"on_download_failure":false,` +
+				`"on_import_failure":false,"on_application_update":false}`,
+			empty: []string{"create_missing_tags", "extra_fields", "grab_fields_names", "import_fields_names", "on_artist_add", "skip_default_tags", "tag_labels", "test_on_create"},
+		},
+		"removed attribute": {
+			state: `{"id":1,"name":"test","web_hook_url":"https://discord.com/api/webhooks/test","on_download":true,"on_grab":true}`,
+			empty: []string{"tags", "on_upgrade"},
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			r := &NotificationDiscordResource{}
+			upgrader := r.UpgradeState(ctx)[0]
+
+			schemaResp := resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+			raw := tfprotov6.RawState{JSON: []byte(test.state)}
+			prior, err := raw.UnmarshalWithOpts(upgrader.PriorSchema.Type().TerraformType(ctx), tfprotov6.UnmarshalOpts{
+				ValueFromJSONOpts: tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true},
+			})
+			assert.NoError(t, err)
+
+			req := resource.UpgradeStateRequest{State: &tfsdk.State{Raw: prior, Schema: *upgrader.PriorSchema}}
+			resp := resource.UpgradeStateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+			upgrader.StateUpgrader(ctx, req, &resp)
+			assert.False(t, resp.Diagnostics.HasError())
+
+			var notification NotificationDiscord
+
+			resp.Diagnostics.Append(resp.State.Get(ctx, &notification)...)
+			assert.False(t, resp.Diagnostics.HasError())
+			assert.Equal(t, int64(1), notification.ID.ValueInt64())
+			assert.Equal(t, "https://discord.com/api/webhooks/test", notification.WebHookURL.ValueString())
+			assert.True(t, notification.OnGrab.ValueBool())
+
+			var values map[string]tftypes.Value
+
+			assert.NoError(t, resp.State.Raw.As(&values))
+
+			for _, attribute := range test.empty {
+				assert.True(t, values[attribute].IsNull(), attribute)
+			}
+		})
+	}
+}
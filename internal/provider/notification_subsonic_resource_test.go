@@ -40,6 +40,17 @@ func TestAccNotificationSubsonicResource(t *testing.T) {
 					resource.TestCheckResourceAttr("lidarr_notification_subsonic.test", "password", "pass2"),
 				),
 			},
+			// Implementation testing
+			{
+				Config: testAccNotificationSubsonicResourceConfig("resourceSubsonicTest", "pass2") + `
+				data "lidarr_notification" "test" {
+					name = lidarr_notification_subsonic.test.name
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lidarr_notification.test", "implementation", "Subsonic"),
+					resource.TestCheckResourceAttr("data.lidarr_notification.test", "config_contract", "SubsonicSettings"),
+				),
+			},
 			// ImportState testing
 			{
 				ResourceName:      "lidarr_notification_subsonic.test",
@@ -25,6 +25,7 @@ func TestAccNotificationTelegramResource(t *testing.T) {
 				Config: testAccNotificationTelegramResourceConfig("resourceTelegramTest", "chat01"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("lidarr_notification_telegram.test", "chat_id", "chat01"),
+					resource.TestCheckResourceAttr("lidarr_notification_telegram.test", "topic_id", "12"),
 					resource.TestCheckResourceAttrSet("lidarr_notification_telegram.test", "id"),
 				),
 			},
@@ -67,6 +68,7 @@ func testAccNotificationTelegramResourceConfig(name, chat string) string {
 		name                    = "%s"
 
 		chat_id = "%s"
+		topic_id = 12
 		bot_token = "Token"
 	}`, name, chat)
 }
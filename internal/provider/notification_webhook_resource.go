@@ -2,17 +2,18 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"slices"
 	"strconv"
 
 	"github.com/devopsarr/lidarr-go/lidarr"
 	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -22,12 +23,15 @@ const (
 	notificationWebhookResourceName   = "notification_webhook"
 	notificationWebhookImplementation = "Webhook"
 	notificationWebhookConfigContract = "WebhookSettings"
+	notificationWebhookHeadersField   = "headers"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &NotificationWebhookResource{}
-	_ resource.ResourceWithImportState = &NotificationWebhookResource{}
+	_ resource.Resource                     = &NotificationWebhookResource{}
+	_ resource.ResourceWithImportState      = &NotificationWebhookResource{}
+	_ resource.ResourceWithConfigValidators = &NotificationWebhookResource{}
+	_ resource.ResourceWithUpgradeState     = &NotificationWebhookResource{}
 )
 
 func NewNotificationWebhookResource() resource.Resource {
@@ -42,16 +46,21 @@ type NotificationWebhookResource struct {
 
 // NotificationWebhook describes the notification data model.
 type NotificationWebhook struct {
+	TagLabels
+	ExtraFields           types.Map    `tfsdk:"extra_fields"`
 	Tags                  types.Set    `tfsdk:"tags"`
+	Headers               types.Map    `tfsdk:"headers"`
 	URL                   types.String `tfsdk:"url"`
 	Name                  types.String `tfsdk:"name"`
 	Username              types.String `tfsdk:"username"`
 	Password              types.String `tfsdk:"password"`
 	ID                    types.Int64  `tfsdk:"id"`
 	Method                types.Int64  `tfsdk:"method"`
+	TestOnCreate          types.Bool   `tfsdk:"test_on_create"`
 	OnGrab                types.Bool   `tfsdk:"on_grab"`
 	OnReleaseImport       types.Bool   `tfsdk:"on_release_import"`
 	OnAlbumDelete         types.Bool   `tfsdk:"on_album_delete"`
+	OnArtistAdd           types.Bool   `tfsdk:"on_artist_add"`
 	OnArtistDelete        types.Bool   `tfsdk:"on_artist_delete"`
 	OnUpgrade             types.Bool   `tfsdk:"on_upgrade"`
 	OnRename              types.Bool   `tfsdk:"on_rename"`
@@ -62,10 +71,12 @@ type NotificationWebhook struct {
 	OnTrackRetag          types.Bool   `tfsdk:"on_track_retag"`
 	IncludeHealthWarnings types.Bool   `tfsdk:"include_health_warnings"`
 	OnApplicationUpdate   types.Bool   `tfsdk:"on_application_update"`
+	SkipDefaultTags       types.Bool   `tfsdk:"skip_default_tags"`
 }
 
 func (n NotificationWebhook) toNotification() *Notification {
 	return &Notification{
+		Fields:                n.ExtraFields,
 		Tags:                  n.Tags,
 		URL:                   n.URL,
 		Method:                n.Method,
@@ -76,6 +87,7 @@ func (n NotificationWebhook) toNotification() *Notification {
 		OnGrab:                n.OnGrab,
 		OnReleaseImport:       n.OnReleaseImport,
 		OnAlbumDelete:         n.OnAlbumDelete,
+		OnArtistAdd:           n.OnArtistAdd,
 		OnArtistDelete:        n.OnArtistDelete,
 		OnDownloadFailure:     n.OnDownloadFailure,
 		IncludeHealthWarnings: n.IncludeHealthWarnings,
@@ -108,10 +120,15 @@ func (n *NotificationWebhook) fromNotification(notification *Notification) {
 	n.OnHealthRestored = notification.OnHealthRestored
 	n.OnReleaseImport = notification.OnReleaseImport
 	n.OnAlbumDelete = notification.OnAlbumDelete
+	n.OnArtistAdd = notification.OnArtistAdd
 	n.OnArtistDelete = notification.OnArtistDelete
 	n.OnRename = notification.OnRename
 	n.OnUpgrade = notification.OnUpgrade
 	n.OnImportFailure = notification.OnImportFailure
+
+	if !n.ExtraFields.IsNull() {
+		n.ExtraFields = notification.Fields
+	}
 }
 
 func (r *NotificationWebhookResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -120,117 +137,50 @@ func (r *NotificationWebhookResource) Metadata(_ context.Context, req resource.M
 
 func (r *NotificationWebhookResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             1,
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification Webhook resource.\nFor more information refer to [Notification](https://wiki.servarr.com/lidarr/settings#connect) and [Webhook](https://wiki.servarr.com/lidarr/supported#webhook).",
-		Attributes: map[string]schema.Attribute{
-			"on_grab": schema.BoolAttribute{
-				MarkdownDescription: "On grab flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_release_import": schema.BoolAttribute{
-				MarkdownDescription: "On release import flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_album_delete": schema.BoolAttribute{
-				MarkdownDescription: "On album delete flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_artist_delete": schema.BoolAttribute{
-				MarkdownDescription: "On artist delete flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_upgrade": schema.BoolAttribute{
-				MarkdownDescription: "On upgrade flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_rename": schema.BoolAttribute{
-				MarkdownDescription: "On rename flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_download_failure": schema.BoolAttribute{
-				MarkdownDescription: "On download failure flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_import_failure": schema.BoolAttribute{
-				MarkdownDescription: "On import failure flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_track_retag": schema.BoolAttribute{
-				MarkdownDescription: "On track retag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_health_issue": schema.BoolAttribute{
-				MarkdownDescription: "On health issue flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_health_restored": schema.BoolAttribute{
-				MarkdownDescription: "On health restored flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"on_application_update": schema.BoolAttribute{
-				MarkdownDescription: "On application update flag.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"include_health_warnings": schema.BoolAttribute{
-				MarkdownDescription: "Include health warnings.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "NotificationWebhook name.",
-				Required:            true,
-			},
-			"tags": schema.SetAttribute{
-				MarkdownDescription: "List of associated tags.",
-				Optional:            true,
-				Computed:            true,
-				ElementType:         types.Int64Type,
-			},
-			"id": schema.Int64Attribute{
-				MarkdownDescription: "Notification ID.",
-				Computed:            true,
-				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.UseStateForUnknown(),
+		Attributes: notificationResourceAttributes(
+			[]string{"on_grab", "on_release_import", "on_upgrade", "on_rename", "on_artist_add", "on_artist_delete", "on_album_delete", "on_health_issue", "on_health_restored", "on_download_failure", "on_import_failure", "on_track_retag", "on_application_update"},
+			map[string]schema.Attribute{
+				// Field values
+				"url": schema.StringAttribute{
+					MarkdownDescription: "URL.",
+					Required:            true,
 				},
-			},
-			// Field values
-			"url": schema.StringAttribute{
-				MarkdownDescription: "URL.",
-				Required:            true,
-			},
-			"username": schema.StringAttribute{
-				MarkdownDescription: "Username.",
-				Optional:            true,
-				Computed:            true,
-			},
-			"password": schema.StringAttribute{
-				MarkdownDescription: "password.",
-				Optional:            true,
-				Computed:            true,
-				Sensitive:           true,
-			},
-			"method": schema.Int64Attribute{
-				MarkdownDescription: "Method. `1` POST, `2` PUT.",
-				Required:            true,
-				Validators: []validator.Int64{
-					int64validator.OneOf(1, 2),
+				"username": schema.StringAttribute{
+					MarkdownDescription: "Username.",
+					Optional:            true,
+					Computed:            true,
+				},
+				"password": schema.StringAttribute{
+					MarkdownDescription: "password.",
+					Optional:            true,
+					Computed:            true,
+					Sensitive:           true,
+				},
+				"headers": schema.MapAttribute{
+					MarkdownDescription: "Additional HTTP headers. Requires a Lidarr version supporting webhook headers.",
+					Optional:            true,
+					Computed:            true,
+					Sensitive:           true,
+					ElementType:         types.StringType,
+				},
+				"method": schema.Int64Attribute{
+					MarkdownDescription: "Method. `1` POST, `2` PUT.",
+					Required:            true,
+					Validators: []validator.Int64{
+						int64validator.OneOf(1, 2),
+					},
 				},
 			},
-		},
+		),
 	}
 }
 
+func (r *NotificationWebhookResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return notificationUpgradeState(ctx, r, notificationAttributesV1, "headers", "on_artist_add")
+}
+
 func (r *NotificationWebhookResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -248,9 +198,38 @@ func (r *NotificationWebhookResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create new NotificationWebhook
 	request := notification.read(ctx, &resp.Diagnostics)
 
+	if !typeNotificationFields(r.auth, r.client, request, notificationWebhookResourceName, &resp.Diagnostics, notification.ExtraFields) {
+		return
+	}
+
+	applyDefaultTags(r.auth, request, notification.SkipDefaultTags)
+
+	if !resolver.validate(notificationWebhookResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	if !validateNotificationTriggers(r.auth, r.client, request, notificationWebhookResourceName, &resp.Diagnostics) {
+		return
+	}
+
+	if !validateNotificationWebhookHeaders(r.auth, r.client, notification.Headers, &resp.Diagnostics) {
+		return
+	}
+
+	if notification.TestOnCreate.ValueBool() && !testNotification(r.auth, r.client, request, notificationWebhookResourceName, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationWebhookResourceName, err))
@@ -258,9 +237,13 @@ func (r *NotificationWebhookResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	response = helpers.ReadAfterCreate(ctx, response, notificationWebhookResourceName, r.client.NotificationAPI.GetNotificationById(r.auth, response.GetId()).Execute, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+notificationWebhookResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, notification.Tags, notification.SkipDefaultTags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &notification.TagLabels, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -275,8 +258,15 @@ func (r *NotificationWebhookResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get NotificationWebhook current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			resp.Diagnostics.AddWarning(helpers.ResourceNotFound, helpers.ParseResourceNotFound(notificationWebhookResourceName, notification.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationWebhookResourceName, err))
 
 		return
@@ -284,7 +274,11 @@ func (r *NotificationWebhookResource) Read(ctx context.Context, req resource.Rea
 
 	tflog.Trace(ctx, "read "+notificationWebhookResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	removeDefaultTags(ctx, r.auth, response, notification.Tags, notification.SkipDefaultTags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	newTagResolver(r.auth, r.client).write(ctx, &notification.TagLabels, notification.Tags, &resp.Diagnostics)
+	// Test flag is not stored by Lidarr, keep the state value or use the default on import
+	notification.TestOnCreate = types.BoolValue(notification.TestOnCreate.ValueBool())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -298,9 +292,35 @@ func (r *NotificationWebhookResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, notification.TagLabels, &notification.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update NotificationWebhook
 	request := notification.read(ctx, &resp.Diagnostics)
 
+	if !typeNotificationFields(r.auth, r.client, request, notificationWebhookResourceName, &resp.Diagnostics, notification.ExtraFields) {
+		return
+	}
+
+	preserveNotificationFields(r.auth, r.client, request)
+	applyDefaultTags(r.auth, request, notification.SkipDefaultTags)
+
+	if !resolver.validate(notificationWebhookResourceName, request, &resp.Diagnostics) {
+		return
+	}
+
+	if !validateNotificationTriggers(r.auth, r.client, request, notificationWebhookResourceName, &resp.Diagnostics) {
+		return
+	}
+
+	if !validateNotificationWebhookHeaders(r.auth, r.client, notification.Headers, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationWebhookResourceName, err))
@@ -310,7 +330,9 @@ func (r *NotificationWebhookResource) Update(ctx context.Context, req resource.U
 
 	tflog.Trace(ctx, "updated "+notificationWebhookResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, notification.Tags, notification.SkipDefaultTags)
 	notification.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &notification.TagLabels, notification.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
 }
 
@@ -336,16 +358,97 @@ func (r *NotificationWebhookResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *NotificationWebhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	importNotificationState(ctx, r.auth, r.client, notificationWebhookImplementation, req, resp)
 	tflog.Trace(ctx, "imported "+notificationWebhookResourceName+": "+req.ID)
 }
 
+func (r *NotificationWebhookResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		notificationTriggersValidator{auth: r.auth},
+	}
+}
+
 func (n *NotificationWebhook) write(ctx context.Context, notification *lidarr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
 	n.fromNotification(genericNotification)
+	n.Headers = types.MapNull(types.StringType)
+
+	if i := slices.IndexFunc(notification.GetFields(), func(f lidarr.Field) bool { return f.GetName() == notificationWebhookHeadersField }); i >= 0 {
+		n.Headers = readWebhookHeaders(notification.GetFields()[i].GetValue(), diags)
+	}
 }
 
 func (n *NotificationWebhook) read(ctx context.Context, diags *diag.Diagnostics) *lidarr.NotificationResource {
-	return n.toNotification().read(ctx, diags)
+	notification := n.toNotification().read(ctx, diags)
+
+	if !n.Headers.IsNull() && !n.Headers.IsUnknown() {
+		headers := make(map[string]string)
+		diags.Append(n.Headers.ElementsAs(ctx, &headers, false)...)
+
+		field := lidarr.NewField()
+		field.SetName(notificationWebhookHeadersField)
+		field.SetValue(writeWebhookHeaders(headers))
+		notification.SetFields(append(notification.GetFields(), *field))
+	}
+
+	return notification
+}
+
+// validateNotificationWebhookHeaders checks that Lidarr supports webhook headers when they are configured.
+func validateNotificationWebhookHeaders(auth context.Context, client *lidarr.APIClient, headers types.Map, diags *diag.Diagnostics) bool {
+	if headers.IsNull() || headers.IsUnknown() || len(headers.Elements()) == 0 {
+		return true
+	}
+
+	schema, ok := getNotificationSchema(auth, client, notificationWebhookImplementation, notificationWebhookResourceName, diags)
+	if !ok {
+		return false
+	}
+
+	if schema == nil || !slices.ContainsFunc(schema.GetFields(), func(f lidarr.Field) bool { return f.GetName() == notificationWebhookHeadersField }) {
+		diags.AddAttributeError(path.Root("headers"), helpers.ResourceError, "Webhook headers are not supported by this Lidarr version")
+
+		return false
+	}
+
+	return true
+}
+
+// writeWebhookHeaders converts headers to the key value list expected by Lidarr.
+func writeWebhookHeaders(headers map[string]string) []map[string]string {
+	keys := make([]string, 0, len(headers))
+	for k := range headers {
+		keys = append(keys, k)
+	}
+
+	slices.Sort(keys)
+
+	list := make([]map[string]string, len(keys))
+	for i, k := range keys {
+		list[i] = map[string]string{"key": k, "value": headers[k]}
+	}
+
+	return list
+}
+
+// readWebhookHeaders converts the key value list returned by Lidarr to headers.
+func readWebhookHeaders(value interface{}, diags *diag.Diagnostics) types.Map {
+	headers := make(map[string]attr.Value)
+
+	list, _ := value.([]interface{})
+	for _, item := range list {
+		header, ok := item.(map[string]interface{})
+		if !ok {
+			diags.AddError(helpers.ResourceError, fmt.Sprintf("Unexpected webhook header format: %v", item))
+
+			continue
+		}
+
+		key, _ := header["key"].(string)
+		value, _ := header["value"].(string)
+		headers[key] = types.StringValue(value)
+	}
+
+	return types.MapValueMust(types.StringType, headers)
 }
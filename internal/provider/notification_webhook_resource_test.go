@@ -25,6 +25,7 @@ func TestAccNotificationWebhookResource(t *testing.T) {
 				Config: testAccNotificationWebhookResourceConfig("resourceWebhookTest", "false"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("lidarr_notification_webhook.test", "on_upgrade", "false"),
+					resource.TestCheckResourceAttr("lidarr_notification_webhook.test", "on_artist_add", "true"),
 					resource.TestCheckResourceAttrSet("lidarr_notification_webhook.test", "id"),
 				),
 			},
@@ -58,6 +59,7 @@ func testAccNotificationWebhookResourceConfig(name, upgrade string) string {
 		on_track_retag                     = true
 		on_upgrade                         = %s
 		on_rename                          = false
+		on_artist_add                      = true
 		on_release_import                  = false
 		on_download_failure                = false
 		on_import_failure 				   = true
@@ -71,3 +73,59 @@ func testAccNotificationWebhookResourceConfig(name, upgrade string) string {
 		method = 1
 	}`, upgrade, name)
 }
+
+func TestAccNotificationWebhookResourceAuth(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid method testing
+			{
+				Config:      testAccNotificationWebhookResourceAuthConfig("resourceWebhookAuthTest", 3, "Token"),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccNotificationWebhookResourceAuthConfig("resourceWebhookAuthTest", 1, "Token"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lidarr_notification_webhook.test", "username", "User"),
+					resource.TestCheckResourceAttr("lidarr_notification_webhook.test", "headers.X-Api-Key", "Token"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccNotificationWebhookResourceAuthConfig("resourceWebhookAuthTest", 2, "Token2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lidarr_notification_webhook.test", "method", "2"),
+					resource.TestCheckResourceAttr("lidarr_notification_webhook.test", "headers.X-Api-Key", "Token2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "lidarr_notification_webhook.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccNotificationWebhookResourceAuthConfig(name string, method int, token string) string {
+	return fmt.Sprintf(`
+	resource "lidarr_notification_webhook" "test" {
+		on_grab = true
+		name    = "%s"
+
+		url      = "http://internal-service:8080"
+		method   = %d
+		username = "User"
+		password = "Pass"
+		headers  = {
+			"X-Api-Key" = "%s"
+		}
+	}`, name, method, token)
+}
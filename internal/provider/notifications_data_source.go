@@ -8,7 +8,10 @@ import (
 	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -31,6 +34,7 @@ type NotificationsDataSource struct {
 type Notifications struct {
 	Notifications types.Set    `tfsdk:"notifications"`
 	ID            types.String `tfsdk:"id"`
+	RedactSecrets types.Bool   `tfsdk:"redact_secrets"`
 }
 
 func (d *NotificationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -46,6 +50,10 @@ func (d *NotificationsDataSource) Schema(_ context.Context, _ datasource.SchemaR
 			"id": schema.StringAttribute{
 				Computed: true,
 			},
+			"redact_secrets": schema.BoolAttribute{
+				MarkdownDescription: "Return null instead of the value of the secret attributes. Defaults to `false`.",
+				Optional:            true,
+			},
 			"notifications": schema.SetNestedAttribute{
 				MarkdownDescription: "Notification list.",
 				Computed:            true,
@@ -63,6 +71,10 @@ func (d *NotificationsDataSource) Schema(_ context.Context, _ datasource.SchemaR
 							MarkdownDescription: "On album delete flag.",
 							Computed:            true,
 						},
+						"on_artist_add": schema.BoolAttribute{
+							MarkdownDescription: "On artist add flag.",
+							Computed:            true,
+						},
 						"on_artist_delete": schema.BoolAttribute{
 							MarkdownDescription: "On artist delete flag.",
 							Computed:            true,
@@ -84,7 +96,7 @@ func (d *NotificationsDataSource) Schema(_ context.Context, _ datasource.SchemaR
 							Computed:            true,
 						},
 						"on_track_retag": schema.BoolAttribute{
-							MarkdownDescription: "On track retag.",
+							MarkdownDescription: "On track retag flag.",
 							Computed:            true,
 						},
 						"on_health_issue": schema.BoolAttribute{
@@ -115,6 +127,11 @@ func (d *NotificationsDataSource) Schema(_ context.Context, _ datasource.SchemaR
 							MarkdownDescription: "Notification name.",
 							Computed:            true,
 						},
+						"fields": schema.MapAttribute{
+							MarkdownDescription: "Raw field values for settings not covered by other attributes, keyed by API field name.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
 						"tags": schema.SetAttribute{
 							MarkdownDescription: "List of associated tags.",
 							Computed:            true,
@@ -165,6 +182,14 @@ func (d *NotificationsDataSource) Schema(_ context.Context, _ datasource.SchemaR
 							MarkdownDescription: "Port.",
 							Computed:            true,
 						},
+						"topic_id": schema.Int64Attribute{
+							MarkdownDescription: "Topic ID.",
+							Computed:            true,
+						},
+						"use_encryption": schema.Int64Attribute{
+							MarkdownDescription: "Use encryption. `0` Preferred, `1` Always, `2` Never.",
+							Computed:            true,
+						},
 						"method": schema.Int64Attribute{
 							MarkdownDescription: "Method. `1` POST, `2` PUT.",
 							Computed:            true,
@@ -438,7 +463,15 @@ func (d *NotificationsDataSource) Configure(ctx context.Context, req datasource.
 	}
 }
 
-func (d *NotificationsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+func (d *NotificationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *Notifications
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get notifications current value
 	response, _, err := d.client.NotificationAPI.ListNotification(d.auth).Execute()
 	if err != nil {
@@ -452,9 +485,46 @@ func (d *NotificationsDataSource) Read(ctx context.Context, _ datasource.ReadReq
 	notifications := make([]Notification, len(response))
 	for i, n := range response {
 		notifications[i].write(ctx, &n, &resp.Diagnostics)
+
+		if data.RedactSecrets.ValueBool() {
+			notifications[i].redactSecrets(ctx, &resp.Diagnostics)
+		}
 	}
 
 	notificationList, diags := types.SetValueFrom(ctx, Notification{}.getType(), notifications)
 	resp.Diagnostics.Append(diags...)
-	resp.Diagnostics.Append(resp.State.Set(ctx, Notifications{Notifications: notificationList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
+
+	data.Notifications = notificationList
+	data.ID = types.StringValue(strconv.Itoa(len(response)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// redactSecrets replaces the value of the sensitive attributes with null.
+func (n *Notification) redactSecrets(ctx context.Context, diags *diag.Diagnostics) {
+	attrTypes := n.getType().(types.ObjectType).AttrTypes
+
+	object, localDiag := types.ObjectValueFrom(ctx, attrTypes, n)
+	diags.Append(localDiag...)
+
+	attributes := object.Attributes()
+
+	for _, name := range notificationSensitiveAttributes() {
+		attrType, ok := attrTypes[name]
+		if !ok {
+			continue
+		}
+
+		null, err := attrType.ValueFromTerraform(ctx, tftypes.NewValue(attrType.TerraformType(ctx), nil))
+		if err != nil {
+			diags.AddError(helpers.DataSourceError, err.Error())
+
+			return
+		}
+
+		attributes[name] = null
+	}
+
+	object, localDiag = types.ObjectValue(attrTypes, attributes)
+	diags.Append(localDiag...)
+	diags.Append(object.As(ctx, n, basetypes.ObjectAsOptions{})...)
 }
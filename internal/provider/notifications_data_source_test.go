@@ -30,6 +30,14 @@ func TestAccNotificationsDataSource(t *testing.T) {
 					resource.TestCheckTypeSetElemNestedAttrs("data.lidarr_notifications.test", "notifications.*", map[string]string{"path": "/scripts/test.sh"}),
 				),
 			},
+			// Read with redacted secrets testing
+			{
+				Config: testAccNotificationResourceConfig("datasourceTest", "true") + testAccNotificationsDataSourceRedactConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lidarr_notifications.test", "redact_secrets", "true"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.lidarr_notifications.test", "notifications.*", map[string]string{"name": "datasourceTest"}),
+				),
+			},
 		},
 	})
 }
@@ -38,3 +46,10 @@ const testAccNotificationsDataSourceConfig = `
 data "lidarr_notifications" "test" {
 }
 `
+
+const testAccNotificationsDataSourceRedactConfig = `
+data "lidarr_notifications" "test" {
+	redact_secrets = true
+	depends_on = [lidarr_notification.test]
+}
+`
@@ -91,5 +91,5 @@ func (e *MetadataProfileElement) findPrimary(name string, types []lidarr.Profile
 		}
 	}
 
-	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(primaryAlbumTypeDataSourceName, "name", name))
+	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(primaryAlbumTypeDataSourceName, "name", name, helpers.Values(types, func(t *lidarr.ProfilePrimaryAlbumTypeItemResource) string { return t.AlbumType.GetName() })))
 }
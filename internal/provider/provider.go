@@ -2,28 +2,59 @@ package provider
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
 	"fmt"
+	"net/http"
 	"net/url"
 	"os"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/devopsarr/lidarr-go/lidarr"
 	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/providervalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const (
+	// defaultTimeout is the default timeout in seconds of each request to Lidarr.
+	defaultTimeout = 30
+	// defaultMaxRetries is the default number of retries of rate limited or transient failed requests.
+	defaultMaxRetries = 3
+	// defaultRetryInitialDelay is the default delay in seconds before the first retry.
+	defaultRetryInitialDelay = 1
+	// defaultMaxConcurrentRequests is the default number of concurrent requests to Lidarr.
+	defaultMaxConcurrentRequests = 4
+)
+
 // needed for tf debug mode
 // var stderr = os.Stderr
 
 // Ensure provider defined types fully satisfy framework interfaces.
-var _ provider.Provider = &LidarrProvider{}
+var (
+	_ provider.Provider                     = &LidarrProvider{}
+	_ provider.ProviderWithConfigValidators = &LidarrProvider{}
+)
+
+// ClientFactory builds the Lidarr client from the configuration computed by the provider.
+type ClientFactory func(config *lidarr.Configuration) *lidarr.APIClient
 
 // ScaffoldingProvider defines the provider implementation.
 type LidarrProvider struct {
+	// newClient builds the Lidarr client, unit tests override it to reach a fake Lidarr.
+	newClient ClientFactory
 	// version is set to the provider version on release, "dev" when the
 	// provider is built and ran locally, and "test" when running acceptance
 	// testing.
@@ -32,9 +63,23 @@ type LidarrProvider struct {
 
 // Lidarr describes the provider data model.
 type Lidarr struct {
-	ExtraHeaders types.Set    `tfsdk:"extra_headers"`
-	APIKey       types.String `tfsdk:"api_key"`
-	URL          types.String `tfsdk:"url"`
+	ExtraHeaders               types.Set    `tfsdk:"extra_headers"`
+	DefaultTagIDs              types.Set    `tfsdk:"default_tag_ids"`
+	APIKey                     types.String `tfsdk:"api_key"`
+	APIKeyFile                 types.String `tfsdk:"api_key_file"`
+	URL                        types.String `tfsdk:"url"`
+	Username                   types.String `tfsdk:"username"`
+	Password                   types.String `tfsdk:"password"`
+	CACert                     types.String `tfsdk:"ca_cert"`
+	CACertFile                 types.String `tfsdk:"ca_cert_file"`
+	Timeout                    types.Int64  `tfsdk:"timeout"`
+	MaxRetries                 types.Int64  `tfsdk:"max_retries"`
+	RetryInitialDelay          types.Int64  `tfsdk:"retry_initial_delay"`
+	MaxConcurrentRequests      types.Int64  `tfsdk:"max_concurrent_requests"`
+	StrictNotificationTriggers types.Bool   `tfsdk:"strict_notification_triggers"`
+	SkipTagValidation          types.Bool   `tfsdk:"skip_tag_validation"`
+	InsecureSkipVerify         types.Bool   `tfsdk:"insecure_skip_verify"`
+	LogRequests                types.Bool   `tfsdk:"log_requests"`
 }
 
 // ExtraHeader is part of Lidarr.
@@ -43,6 +88,26 @@ type ExtraHeader struct {
 	Value types.String `tfsdk:"value"`
 }
 
+// defaultTagsKey stores the default tags settings in the auth context.
+type defaultTagsKey struct{}
+
+// defaultTags describes the tags added to every taggable resource.
+type defaultTags struct {
+	ids []int32
+}
+
+// taggable is implemented by the Lidarr resources supporting tags.
+type taggable interface {
+	GetTags() []int32
+	SetTags(v []int32)
+}
+
+// strictNotificationTriggersKey stores the strict_notification_triggers setting in the auth context.
+type strictNotificationTriggersKey struct{}
+
+// skipTagValidationKey stores the skip_tag_validation setting in the auth context.
+type skipTagValidationKey struct{}
+
 // LidarrData defines auth and client to be used when connecting to Lidarr.
 type LidarrData struct {
 	Auth   context.Context
@@ -63,12 +128,82 @@ func (p *LidarrProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"api_key_file": schema.StringAttribute{
+				MarkdownDescription: "Path of a file containing the API key for Lidarr authentication. Can be specified via the `LIDARR_API_KEY_FILE` environment variable. Conflicts with `api_key`.",
+				Optional:            true,
+			},
 			"url": schema.StringAttribute{
 				MarkdownDescription: "Full Lidarr URL with protocol and port (e.g. `https://test.lidarr.audio:8686`). You should **NOT** supply any path (`/api`), the SDK will use the appropriate paths. Can be specified via the `LIDARR_URL` environment variable.",
 				Optional:            true,
 			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Username for HTTP basic authentication, e.g. when Lidarr is behind a reverse proxy. Can be specified via the `LIDARR_USERNAME` environment variable.",
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password for HTTP basic authentication. Can be specified via the `LIDARR_PASSWORD` environment variable.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"timeout": schema.Int64Attribute{
+				MarkdownDescription: "Timeout in seconds of each request to Lidarr, unless the resource `timeouts` block is set. Can be specified via the `LIDARR_TIMEOUT` environment variable. Defaults to `30`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip the verification of the Lidarr TLS certificate, e.g. when it is self-signed. Can be specified via the `LIDARR_INSECURE_SKIP_VERIFY` environment variable. Conflicts with `ca_cert` and `ca_cert_file`. Defaults to `false`.",
+				Optional:            true,
+			},
+			"ca_cert": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA certificate to trust, on top of the system ones, when verifying the Lidarr TLS certificate. Conflicts with `insecure_skip_verify`.",
+				Optional:            true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path of a PEM encoded CA certificate file to trust, on top of the system ones, when verifying the Lidarr TLS certificate. Conflicts with `insecure_skip_verify`.",
+				Optional:            true,
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of concurrent requests to Lidarr, shared by all resources and data sources, to avoid database lock errors. Defaults to `4`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of retries of requests failed with `429`, `500` because of a locked database or, for idempotent requests, `502`, `503` and `504`. Defaults to `3`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_initial_delay": schema.Int64Attribute{
+				MarkdownDescription: "Delay in seconds before the first retry, doubled on each following one unless Lidarr sends a `Retry-After` header. Defaults to `1`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"log_requests": schema.BoolAttribute{
+				MarkdownDescription: "Log the requests sent to Lidarr and their responses, with secrets redacted, when `TF_LOG` is `DEBUG` or more verbose. Defaults to `false`.",
+				Optional:            true,
+			},
+			"strict_notification_triggers": schema.BoolAttribute{
+				MarkdownDescription: "Raise an error instead of a warning when a notification has no trigger enabled. Defaults to `false`.",
+				Optional:            true,
+			},
+			"skip_tag_validation": schema.BoolAttribute{
+				MarkdownDescription: "Do not check that the tags of taggable resources exist in Lidarr before creating or updating them, e.g. when tags are created out of band. Defaults to `false`.",
+				Optional:            true,
+			},
+			"default_tag_ids": schema.SetAttribute{
+				MarkdownDescription: "Tag IDs added to every taggable resource on create and update, unless the resource sets `skip_default_tags`. They are not reported in the resource `tags` unless explicitly configured there. Mind that in Lidarr tags also restrict the artists a resource applies to.",
+				Optional:            true,
+				ElementType:         types.Int64Type,
+			},
 			"extra_headers": schema.SetNestedAttribute{
-				MarkdownDescription: "Extra headers to be sent along with all Lidarr requests. If this attribute is unset, it can be specified via environment variables following this pattern `LIDARR_EXTRA_HEADER_${Header-Name}=${Header-Value}`.",
+				MarkdownDescription: "Extra headers to be sent along with all Lidarr requests, e.g. to authenticate against a reverse proxy. `X-Api-Key` cannot be set, use `api_key` instead. If this attribute is unset, it can be specified via environment variables following this pattern `LIDARR_EXTRA_HEADER_${Header-Name}=${Header-Value}`.",
 				Optional:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -79,6 +214,7 @@ func (p *LidarrProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 						"value": schema.StringAttribute{
 							MarkdownDescription: "Header value.",
 							Required:            true,
+							Sensitive:           true,
 						},
 					},
 				},
@@ -87,6 +223,14 @@ func (p *LidarrProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 	}
 }
 
+func (p *LidarrProvider) ConfigValidators(_ context.Context) []provider.ConfigValidator {
+	return []provider.ConfigValidator{
+		providervalidator.Conflicting(path.MatchRoot("insecure_skip_verify"), path.MatchRoot("ca_cert")),
+		providervalidator.Conflicting(path.MatchRoot("insecure_skip_verify"), path.MatchRoot("ca_cert_file")),
+		providervalidator.Conflicting(path.MatchRoot("api_key"), path.MatchRoot("api_key_file")),
+	}
+}
+
 func (p *LidarrProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	var data Lidarr
 
@@ -114,8 +258,28 @@ func (p *LidarrProvider) Configure(ctx context.Context, req provider.ConfigureRe
 
 	// Extract key
 	key := data.APIKey.ValueString()
-	if key == "" {
+	keyFile := data.APIKeyFile.ValueString()
+
+	if key == "" && keyFile == "" {
 		key = os.Getenv("LIDARR_API_KEY")
+		if key == "" {
+			keyFile = os.Getenv("LIDARR_API_KEY_FILE")
+		}
+	}
+
+	if key == "" && keyFile != "" {
+		content, readErr := os.ReadFile(keyFile)
+		if readErr != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("api_key_file"),
+				"Unable to read API key file",
+				fmt.Sprintf("Unable to read API key from %s, got error: %s", keyFile, readErr),
+			)
+
+			return
+		}
+
+		key = strings.TrimSpace(string(content))
 	}
 
 	if key == "" {
@@ -127,26 +291,162 @@ func (p *LidarrProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
-	// Init config
-	config := lidarr.NewConfiguration()
+	// Extract timeout
+	timeout := data.Timeout.ValueInt64()
+	if data.Timeout.IsNull() {
+		timeout = defaultTimeout
+
+		if env := os.Getenv("LIDARR_TIMEOUT"); env != "" {
+			timeout, err = strconv.ParseInt(env, 10, 64)
+			if err != nil || timeout <= 0 {
+				resp.Diagnostics.AddError(
+					"Unable to find valid timeout",
+					"LIDARR_TIMEOUT must be a positive number of seconds",
+				)
+
+				return
+			}
+		}
+	}
+
+	// Extract TLS verification
+	insecure := data.InsecureSkipVerify.ValueBool()
+	if data.InsecureSkipVerify.IsNull() {
+		if env := os.Getenv("LIDARR_INSECURE_SKIP_VERIFY"); env != "" {
+			insecure, err = strconv.ParseBool(env)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Unable to find valid TLS verification flag",
+					"LIDARR_INSECURE_SKIP_VERIFY must be a boolean",
+				)
+
+				return
+			}
+		}
+	}
+
+	if insecure && (!data.CACert.IsNull() || !data.CACertFile.IsNull()) {
+		resp.Diagnostics.AddError(
+			"Unable to find valid TLS verification flag",
+			"LIDARR_INSECURE_SKIP_VERIFY cannot be enabled along with ca_cert or ca_cert_file",
+		)
+
+		return
+	}
+
+	transport, _ := http.DefaultTransport.(*http.Transport)
+	transport = transport.Clone()
+
+	if insecure {
+		resp.Diagnostics.AddWarning(
+			"TLS verification disabled",
+			"The Lidarr TLS certificate is not verified, connections are exposed to man-in-the-middle attacks",
+		)
+
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} //nolint:gosec // explicitly requested by the user
+	}
+
+	// Extract CA certificates
+	if !data.CACert.IsNull() || !data.CACertFile.IsNull() {
+		rootCAs := loadCACerts(data, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		transport.TLSClientConfig = &tls.Config{RootCAs: rootCAs, MinVersion: tls.VersionTLS12}
+	}
+
 	// Check extra headers
+	headers := http.Header{}
+
 	if len(data.ExtraHeaders.Elements()) > 0 {
-		headers := make([]ExtraHeader, len(data.ExtraHeaders.Elements()))
-		resp.Diagnostics.Append(data.ExtraHeaders.ElementsAs(ctx, &headers, false)...)
+		extraHeaders := make([]ExtraHeader, len(data.ExtraHeaders.Elements()))
+		resp.Diagnostics.Append(data.ExtraHeaders.ElementsAs(ctx, &extraHeaders, false)...)
 
-		for _, header := range headers {
-			config.AddDefaultHeader(header.Name.ValueString(), header.Value.ValueString())
+		for _, header := range extraHeaders {
+			headers.Add(header.Name.ValueString(), header.Value.ValueString())
 		}
 	} else {
 		env := os.Environ()
 		for _, v := range env {
-			if strings.HasPrefix(v, "LIDARR_EXTRA_HEADER_") {
-				header := strings.Split(v, "=")
-				config.AddDefaultHeader(strings.TrimPrefix(header[0], "LIDARR_EXTRA_HEADER_"), header[1])
+			if name, value, found := strings.Cut(v, "="); found && strings.HasPrefix(name, "LIDARR_EXTRA_HEADER_") {
+				headers.Add(strings.TrimPrefix(name, "LIDARR_EXTRA_HEADER_"), value)
 			}
 		}
 	}
 
+	if headers.Get("X-Api-Key") != "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("extra_headers"),
+			"Unable to use extra headers",
+			"X-Api-Key cannot be set as extra header, use api_key instead",
+		)
+
+		return
+	}
+
+	// Extract basic auth credentials
+	username := data.Username.ValueString()
+	if username == "" {
+		username = os.Getenv("LIDARR_USERNAME")
+	}
+
+	password := data.Password.ValueString()
+	if password == "" {
+		password = os.Getenv("LIDARR_PASSWORD")
+	}
+
+	if username == "" && password != "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("username"),
+			"Unable to find username",
+			"Username cannot be an empty string when a password is set",
+		)
+
+		return
+	}
+
+	if username != "" {
+		if headers.Get("Authorization") != "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("extra_headers"),
+				"Unable to use extra headers",
+				"Authorization cannot be set as extra header along with username and password",
+			)
+
+			return
+		}
+
+		headers.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(username+":"+password)))
+	}
+
+	var requestTransport http.RoundTripper = &helpers.HeaderTransport{
+		Base:    transport,
+		Headers: headers,
+	}
+
+	if data.LogRequests.ValueBool() {
+		requestTransport = &helpers.LogTransport{
+			Base:      requestTransport,
+			Ctx:       context.WithoutCancel(ctx),
+			Sensitive: p.sensitiveAttributes(ctx),
+		}
+	}
+
+	// Init config
+	config := lidarr.NewConfiguration()
+	config.HTTPClient = &http.Client{
+		// Resources with a configured timeout replace the default one
+		Transport: &helpers.TimeoutTransport{
+			Base: &helpers.RetryTransport{
+				Base:         helpers.NewLimitTransport(requestTransport, int(valueOrDefault(data.MaxConcurrentRequests, defaultMaxConcurrentRequests))),
+				MaxRetries:   int(valueOrDefault(data.MaxRetries, defaultMaxRetries)),
+				InitialDelay: time.Duration(valueOrDefault(data.RetryInitialDelay, defaultRetryInitialDelay)) * time.Second,
+			},
+			Timeout: time.Duration(timeout) * time.Second,
+		},
+	}
+
 	// Set context for API calls
 	auth := context.WithValue(
 		context.Background(),
@@ -159,10 +459,18 @@ func (p *LidarrProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		"protocol": parsedAPIURL.Scheme,
 		"hostpath": parsedAPIURL.Host,
 	})
+	auth = context.WithValue(auth, strictNotificationTriggersKey{}, data.StrictNotificationTriggers.ValueBool())
+	auth = context.WithValue(auth, skipTagValidationKey{}, data.SkipTagValidation.ValueBool())
+
+	// Set default tags
+	var tags defaultTags
+
+	resp.Diagnostics.Append(data.DefaultTagIDs.ElementsAs(ctx, &tags.ids, true)...)
+	auth = context.WithValue(auth, defaultTagsKey{}, tags)
 
 	lidarrData := LidarrData{
 		Auth:   auth,
-		Client: lidarr.NewAPIClient(config),
+		Client: p.clientFactory()(config),
 	}
 	resp.DataSourceData = &lidarrData
 	resp.ResourceData = &lidarrData
@@ -203,6 +511,7 @@ func (p *LidarrProvider) Resources(_ context.Context) []func() resource.Resource
 		NewIndexerIptorrentsResource,
 		NewIndexerNewznabResource,
 		NewIndexerNyaaResource,
+		NewIndexerOrpheusResource,
 		NewIndexerRedactedResource,
 		NewIndexerTorrentRssResource,
 		NewIndexerTorrentleechResource,
@@ -270,7 +579,11 @@ func (p *LidarrProvider) Resources(_ context.Context) []func() resource.Resource
 		NewCustomFormatResource,
 
 		// System
+		NewBackupResource,
+		NewCommandResource,
 		NewHostResource,
+		NewQueueCleanerResource,
+		NewRestartResource,
 
 		// Tags
 		NewTagResource,
@@ -304,6 +617,7 @@ func (p *LidarrProvider) DataSources(_ context.Context) []func() datasource.Data
 		// Media Management
 		NewMediaManagementDataSource,
 		NewNamingDataSource,
+		NewRenamePreviewDataSource,
 		NewRootFolderDataSource,
 		NewRootFoldersDataSource,
 
@@ -319,6 +633,7 @@ func (p *LidarrProvider) DataSources(_ context.Context) []func() datasource.Data
 		// Profiles
 		NewCustomFormatDataSource,
 		NewCustomFormatsDataSource,
+		NewCustomFormatExportDataSource,
 		NewDelayProfileDataSource,
 		NewDelayProfilesDataSource,
 		NewMetadataProfileDataSource,
@@ -343,9 +658,14 @@ func (p *LidarrProvider) DataSources(_ context.Context) []func() datasource.Data
 
 		// System
 		NewHostDataSource,
+		NewLogDataSource,
+		NewLogFilesDataSource,
+		NewQueueDataSource,
 		NewSystemStatusDataSource,
+		NewTasksDataSource,
 
 		// Tags
+		NewAutoTaggingsDataSource,
 		NewTagDataSource,
 		NewTagsDataSource,
 	}
@@ -353,11 +673,132 @@ func (p *LidarrProvider) DataSources(_ context.Context) []func() datasource.Data
 
 // New returns the provider with a specific version.
 func New(version string) func() provider.Provider {
+	return NewWithClientFactory(version, lidarr.NewAPIClient)
+}
+
+// NewWithClientFactory returns the provider with a specific version, building the Lidarr client with the given factory.
+func NewWithClientFactory(version string, factory ClientFactory) func() provider.Provider {
 	return func() provider.Provider {
 		return &LidarrProvider{
-			version: version,
+			newClient: factory,
+			version:   version,
+		}
+	}
+}
+
+// clientFactory returns the configured client factory, the default one when unset.
+func (p *LidarrProvider) clientFactory() ClientFactory {
+	if p.newClient == nil {
+		return lidarr.NewAPIClient
+	}
+
+	return p.newClient
+}
+
+// valueOrDefault returns the configured value or the default one when unset.
+func valueOrDefault(value types.Int64, def int64) int64 {
+	if value.IsNull() || value.IsUnknown() {
+		return def
+	}
+
+	return value.ValueInt64()
+}
+
+// resourceDefaultTags returns the default tags to be added to a resource, none when it opts out.
+func resourceDefaultTags(auth context.Context, skip types.Bool) []int32 {
+	if auth == nil || skip.ValueBool() {
+		return nil
+	}
+
+	tags, _ := auth.Value(defaultTagsKey{}).(defaultTags)
+
+	return tags.ids
+}
+
+// applyDefaultTags adds the default tags to the request.
+func applyDefaultTags(auth context.Context, request taggable, skip types.Bool) {
+	tags := request.GetTags()
+
+	for _, tag := range resourceDefaultTags(auth, skip) {
+		if !slices.Contains(tags, tag) {
+			tags = append(tags, tag)
 		}
 	}
+
+	request.SetTags(tags)
+}
+
+// removeDefaultTags removes from the response the default tags not explicitly configured, to avoid drift.
+func removeDefaultTags(ctx, auth context.Context, response taggable, configured types.Set, skip types.Bool) {
+	defaults := resourceDefaultTags(auth, skip)
+	if len(defaults) == 0 {
+		return
+	}
+
+	expected := make([]int32, 0)
+	if !configured.IsNull() && !configured.IsUnknown() {
+		configured.ElementsAs(ctx, &expected, true)
+	}
+
+	tags := slices.DeleteFunc(slices.Clone(response.GetTags()), func(tag int32) bool {
+		return slices.Contains(defaults, tag) && !slices.Contains(expected, tag)
+	})
+
+	response.SetTags(tags)
+}
+
+// sensitiveAttributes lists the attributes marked as sensitive in any resource schema.
+func (p *LidarrProvider) sensitiveAttributes(ctx context.Context) []string {
+	attributes := make([]string, 0)
+
+	for _, newResource := range p.Resources(ctx) {
+		var resp resource.SchemaResponse
+
+		newResource().Schema(ctx, resource.SchemaRequest{}, &resp)
+
+		for name, attribute := range resp.Schema.Attributes {
+			if attribute.IsSensitive() && !slices.Contains(attributes, name) {
+				attributes = append(attributes, name)
+			}
+		}
+	}
+
+	return attributes
+}
+
+// loadCACerts returns the system certificate pool extended with the configured CA certificates.
+func loadCACerts(data Lidarr, diags *diag.Diagnostics) *x509.CertPool {
+	rootCAs, err := x509.SystemCertPool()
+	if err != nil {
+		rootCAs = x509.NewCertPool()
+	}
+
+	if !data.CACert.IsNull() && !rootCAs.AppendCertsFromPEM([]byte(data.CACert.ValueString())) {
+		diags.AddAttributeError(
+			path.Root("ca_cert"),
+			"Unable to find valid CA certificate",
+			"ca_cert must contain at least one PEM encoded certificate",
+		)
+	}
+
+	if !data.CACertFile.IsNull() {
+		pem, readErr := os.ReadFile(data.CACertFile.ValueString())
+		if readErr != nil {
+			diags.AddAttributeError(
+				path.Root("ca_cert_file"),
+				"Unable to read CA certificate file",
+				fmt.Sprintf("Unable to read ca_cert_file, got error: %s", readErr),
+			)
+		} else if !rootCAs.AppendCertsFromPEM(pem) {
+			diags.AddAttributeError(
+				path.Root("ca_cert_file"),
+				"Unable to find valid CA certificate",
+				"ca_cert_file must contain at least one PEM encoded certificate",
+			)
+		}
+	}
+
+	return rootCAs
 }
 
 // ResourceConfigure is a helper function to set the client for a specific resource.
@@ -1,12 +1,31 @@
 package provider
 
 import (
+	"context"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -37,6 +56,16 @@ func testAccAPIClient() *lidarr.APIClient {
 	return lidarr.NewAPIClient(config)
 }
 
+// testAccResourceID stores the ID of the given resource, to act on it out of band.
+func testAccResourceID(name string, id *int32) resource.TestCheckFunc {
+	return resource.TestCheckResourceAttrWith(name, "id", func(value string) error {
+		parsed, err := strconv.ParseInt(value, 10, 32)
+		*id = int32(parsed)
+
+		return err
+	})
+}
+
 const testUnauthorizedProvider = `
 provider "lidarr" {
 	url = "http://localhost:8686"
@@ -49,3 +78,620 @@ provider "lidarr" {
 	]
   }
 `
+
+const testStrictNotificationTriggersProvider = `
+provider "lidarr" {
+	strict_notification_triggers = true
+  }
+`
+
+func TestProviderConfigureTimeout(t *testing.T) {
+	tests := map[string]struct {
+		timeout  interface{}
+		env      string
+		expected time.Duration
+		err      bool
+	}{
+		"default": {
+			expected: 30 * time.Second,
+		},
+		"configured": {
+			timeout:  int64(60),
+			env:      "90",
+			expected: 60 * time.Second,
+		},
+		"environment": {
+			env:      "90",
+			expected: 90 * time.Second,
+		},
+		"invalid environment": {
+			env: "-1",
+			err: true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("LIDARR_TIMEOUT", test.env)
+
+			resp := testProviderConfigure(t, map[string]interface{}{"timeout": test.timeout})
+
+			if test.err {
+				assert.True(t, resp.Diagnostics.HasError())
+
+				return
+			}
+
+			assert.Equal(t, test.expected, testProviderTransport[*helpers.TimeoutTransport](resp).Timeout)
+		})
+	}
+}
+
+func TestProviderConfigureRetry(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		maxRetries   interface{}
+		initialDelay interface{}
+		expected     *helpers.RetryTransport
+	}{
+		"default": {
+			expected: &helpers.RetryTransport{MaxRetries: 3, InitialDelay: time.Second},
+		},
+		"configured": {
+			maxRetries:   int64(0),
+			initialDelay: int64(5),
+			expected:     &helpers.RetryTransport{MaxRetries: 0, InitialDelay: 5 * time.Second},
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := testProviderConfigure(t, map[string]interface{}{"max_retries": test.maxRetries, "retry_initial_delay": test.initialDelay})
+
+			transport := testProviderTransport[*helpers.RetryTransport](resp)
+			assert.Equal(t, test.expected.MaxRetries, transport.MaxRetries)
+			assert.Equal(t, test.expected.InitialDelay, transport.InitialDelay)
+		})
+	}
+}
+
+func TestProviderConfigureInsecureSkipVerify(t *testing.T) {
+	tests := map[string]struct {
+		insecure interface{}
+		caCert   interface{}
+		env      string
+		expected bool
+		err      bool
+	}{
+		"default": {
+			expected: false,
+		},
+		"configured": {
+			insecure: true,
+			env:      "false",
+			expected: true,
+		},
+		"environment": {
+			env:      "true",
+			expected: true,
+		},
+		"invalid environment": {
+			env: "maybe",
+			err: true,
+		},
+		"environment with ca_cert": {
+			caCert: "certificate",
+			env:    "true",
+			err:    true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("LIDARR_INSECURE_SKIP_VERIFY", test.env)
+
+			resp := testProviderConfigure(t, map[string]interface{}{"insecure_skip_verify": test.insecure, "ca_cert": test.caCert})
+
+			if test.err {
+				assert.Equal(t, 1, resp.Diagnostics.ErrorsCount())
+				assert.Equal(t, "Unable to find valid TLS verification flag", resp.Diagnostics.Errors()[0].Summary())
+
+				return
+			}
+
+			assert.Equal(t, test.expected, resp.Diagnostics.WarningsCount() > 0)
+
+			transport, _ := testProviderTransport[*helpers.HeaderTransport](resp).Base.(*http.Transport)
+			assert.Equal(t, test.expected, transport.TLSClientConfig != nil && transport.TLSClientConfig.InsecureSkipVerify)
+		})
+	}
+}
+
+func TestProviderConfigureCACert(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewTLSServer(http.NotFoundHandler())
+	t.Cleanup(server.Close)
+
+	certPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+	certFile := filepath.Join(t.TempDir(), "ca.pem")
+	assert.NoError(t, os.WriteFile(certFile, []byte(certPEM), 0o600))
+
+	tests := map[string]struct {
+		attributes map[string]interface{}
+		err        bool
+	}{
+		"ca_cert": {
+			attributes: map[string]interface{}{"ca_cert": certPEM},
+		},
+		"ca_cert_file": {
+			attributes: map[string]interface{}{"ca_cert_file": certFile},
+		},
+		"invalid ca_cert": {
+			attributes: map[string]interface{}{"ca_cert": "invalid"},
+			err:        true,
+		},
+		"missing ca_cert_file": {
+			attributes: map[string]interface{}{"ca_cert_file": filepath.Join(t.TempDir(), "missing.pem")},
+			err:        true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := testProviderConfigure(t, test.attributes)
+
+			if test.err {
+				assert.True(t, resp.Diagnostics.HasError())
+
+				return
+			}
+
+			client := &http.Client{Transport: testProviderTransport[*helpers.LimitTransport](resp)}
+
+			response, err := client.Get(server.URL)
+			if assert.NoError(t, err) {
+				response.Body.Close()
+			}
+		})
+	}
+}
+
+func TestProviderConfigureExtraHeaders(t *testing.T) {
+	tests := map[string]struct {
+		name     string
+		expected http.Header
+		err      bool
+	}{
+		"proxy": {
+			name:     "Proxy-Authorization",
+			expected: http.Header{"Proxy-Authorization": {"value"}},
+		},
+		"api key": {
+			name: "x-api-key",
+			err:  true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("LIDARR_EXTRA_HEADER_"+test.name, "value")
+
+			resp := testProviderConfigure(t, map[string]interface{}{})
+
+			if test.err {
+				assert.True(t, resp.Diagnostics.HasError())
+
+				return
+			}
+
+			assert.Equal(t, test.expected, testProviderTransport[*helpers.HeaderTransport](resp).Headers)
+		})
+	}
+}
+
+func TestProviderConfigureBasicAuth(t *testing.T) {
+	tests := map[string]struct {
+		username interface{}
+		password interface{}
+		env      string
+		expected http.Header
+		err      bool
+	}{
+		"none": {
+			expected: http.Header{},
+		},
+		"configured": {
+			username: "user",
+			password: "pass",
+			expected: http.Header{"Authorization": {"Basic dXNlcjpwYXNz"}},
+		},
+		"environment": {
+			username: "user",
+			env:      "pass",
+			expected: http.Header{"Authorization": {"Basic dXNlcjpwYXNz"}},
+		},
+		"missing username": {
+			password: "pass",
+			err:      true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("LIDARR_PASSWORD", test.env)
+
+			resp := testProviderConfigure(t, map[string]interface{}{"username": test.username, "password": test.password})
+
+			if test.err {
+				assert.True(t, resp.Diagnostics.HasError())
+
+				return
+			}
+
+			assert.Equal(t, test.expected, testProviderTransport[*helpers.HeaderTransport](resp).Headers)
+		})
+	}
+}
+
+func TestProviderConfigureMaxConcurrentRequests(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		maxConcurrentRequests interface{}
+		expected              int
+	}{
+		"default": {
+			expected: 4,
+		},
+		"configured": {
+			maxConcurrentRequests: int64(1),
+			expected:              1,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := testProviderConfigure(t, map[string]interface{}{"max_concurrent_requests": test.maxConcurrentRequests})
+
+			assert.Equal(t, test.expected, testProviderTransport[*helpers.LimitTransport](resp).Limit())
+		})
+	}
+}
+
+func TestProviderConfigureAPIKeyFile(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "api_key")
+	assert.NoError(t, os.WriteFile(keyFile, []byte("FileKey\n"), 0o600))
+
+	tests := map[string]struct {
+		keyFile  interface{}
+		env      string
+		expected string
+		err      bool
+	}{
+		"configured": {
+			keyFile:  keyFile,
+			expected: "FileKey",
+		},
+		"environment": {
+			env:      keyFile,
+			expected: "FileKey",
+		},
+		"missing": {
+			keyFile: filepath.Join(t.TempDir(), "missing"),
+			err:     true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("LIDARR_API_KEY", "")
+			t.Setenv("LIDARR_API_KEY_FILE", test.env)
+
+			resp := testProviderConfigure(t, map[string]interface{}{"api_key": nil, "api_key_file": test.keyFile})
+
+			if test.err {
+				assert.True(t, resp.Diagnostics.HasError())
+
+				return
+			}
+
+			data, _ := resp.ResourceData.(*LidarrData)
+			keys, _ := data.Auth.Value(lidarr.ContextAPIKeys).(map[string]lidarr.APIKey)
+			assert.Equal(t, test.expected, keys["X-Api-Key"].Key)
+		})
+	}
+}
+
+func TestDefaultTags(t *testing.T) {
+	t.Parallel()
+
+	auth := context.WithValue(context.Background(), defaultTagsKey{}, defaultTags{
+		ids: []int32{1, 2},
+	})
+
+	tests := map[string]struct {
+		skip       types.Bool
+		tags       []int32
+		configured []int64
+		request    []int32
+		response   []int32
+	}{
+		"merged": {
+			skip:       types.BoolNull(),
+			tags:       []int32{3},
+			configured: []int64{3},
+			request:    []int32{3, 1, 2},
+			response:   []int32{3},
+		},
+		"explicit": {
+			skip:       types.BoolValue(false),
+			tags:       []int32{1},
+			configured: []int64{1},
+			request:    []int32{1, 2},
+			response:   []int32{1},
+		},
+		"skipped": {
+			skip:       types.BoolValue(true),
+			tags:       []int32{3},
+			configured: []int64{3},
+			request:    []int32{3},
+			response:   []int32{3},
+		},
+		"skipped with default tag": {
+			skip:       types.BoolValue(true),
+			tags:       []int32{1},
+			configured: []int64{},
+			request:    []int32{1},
+			response:   []int32{1},
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			request := &lidarr.NotificationResource{Tags: slices.Clone(test.tags)}
+			applyDefaultTags(auth, request, test.skip)
+			assert.Equal(t, test.request, request.GetTags())
+
+			configured, _ := types.SetValueFrom(context.Background(), types.Int64Type, test.configured)
+			removeDefaultTags(context.Background(), auth, request, configured, test.skip)
+			assert.Equal(t, test.response, request.GetTags())
+		})
+	}
+}
+
+func TestProviderSensitiveAttributes(t *testing.T) {
+	t.Parallel()
+
+	p, _ := New("test")().(*LidarrProvider)
+	attributes := p.sensitiveAttributes(context.Background())
+
+	for _, name := range []string{"api_key", "password", "bot_token"} {
+		assert.Contains(t, attributes, name)
+	}
+
+	assert.NotContains(t, attributes, "name")
+}
+
+// testProviderTransport returns the transport of the given type in the chain of the configured client.
+func testProviderTransport[T http.RoundTripper](resp provider.ConfigureResponse) T {
+	data, _ := resp.ResourceData.(*LidarrData)
+	transport := data.Client.GetConfig().HTTPClient.Transport
+
+	for {
+		switch current := transport.(type) {
+		case T:
+			return current
+		case *helpers.TimeoutTransport:
+			transport = current.Base
+		case *helpers.RetryTransport:
+			transport = current.Base
+		case *helpers.LimitTransport:
+			transport = current.Base
+		case *helpers.LogTransport:
+			transport = current.Base
+		case *helpers.HeaderTransport:
+			transport = current.Base
+		default:
+			var zero T
+
+			return zero
+		}
+	}
+}
+
+// testProviderConfigure configures the provider with the given attribute values, on top of a test URL and, unless given, API key.
+func testProviderConfigure(t *testing.T, attributes map[string]interface{}) provider.ConfigureResponse {
+	t.Helper()
+
+	return testProviderConfigureWith(t, New("test")(), attributes)
+}
+
+// testProviderConfigureWith configures the given provider like testProviderConfigure.
+func testProviderConfigureWith(t *testing.T, p provider.Provider, attributes map[string]interface{}) provider.ConfigureResponse {
+	t.Helper()
+
+	ctx := context.Background()
+
+	var schemaResp provider.SchemaResponse
+
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+
+	objectType, _ := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, attributes[name])
+	}
+
+	values["url"] = tftypes.NewValue(tftypes.String, "http://localhost:8686")
+
+	if _, ok := attributes["api_key"]; !ok {
+		values["api_key"] = tftypes.NewValue(tftypes.String, "Key")
+	}
+
+	var resp provider.ConfigureResponse
+
+	p.Configure(ctx, provider.ConfigureRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+	}, &resp)
+
+	return resp
+}
+
+// testFixtureTransport serves the requests of the Lidarr client from the testdata fixtures, without any network access.
+// Requests without a fixture are answered with their own body, as Lidarr does on update, or not found when empty.
+type testFixtureTransport struct {
+	t        *testing.T
+	fixtures map[string]string
+	requests map[string][]byte
+	mu       sync.Mutex
+}
+
+func (f *testFixtureTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	key := r.Method + " " + r.URL.Path
+	recorder := httptest.NewRecorder()
+	recorder.Header().Set("Content-Type", "application/json")
+
+	var body []byte
+
+	if r.Body != nil {
+		body, _ = io.ReadAll(r.Body)
+	}
+
+	f.mu.Lock()
+	f.requests[key] = body
+	f.mu.Unlock()
+
+	fixture, ok := f.fixtures[key]
+
+	switch {
+	case ok:
+		content, err := os.ReadFile(filepath.Join("testdata", fixture))
+		assert.NoError(f.t, err)
+
+		_, _ = recorder.Write(content)
+	case len(body) > 0:
+		_, _ = recorder.Write(body)
+	default:
+		recorder.WriteHeader(http.StatusNotFound)
+	}
+
+	return recorder.Result(), nil
+}
+
+// request returns the body of the last request to the given "METHOD path".
+func (f *testFixtureTransport) request(key string) []byte {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.requests[key]
+}
+
+// testFixtureProvider configures the provider on a fake Lidarr answering each "METHOD path" with the given fixture.
+func testFixtureProvider(t *testing.T, fixtures map[string]string) (*LidarrData, *testFixtureTransport) {
+	t.Helper()
+
+	transport := &testFixtureTransport{
+		t:        t,
+		fixtures: fixtures,
+		requests: make(map[string][]byte),
+	}
+
+	p := NewWithClientFactory("test", func(config *lidarr.Configuration) *lidarr.APIClient {
+		config.HTTPClient = &http.Client{Transport: transport}
+
+		return lidarr.NewAPIClient(config)
+	})()
+
+	resp := testProviderConfigureWith(t, p, map[string]interface{}{})
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	data, _ := resp.ResourceData.(*LidarrData)
+
+	return data, transport
+}
+
+// testResourceState returns an empty state of the given resource, configured with the provider data.
+func testResourceState(t *testing.T, r fwresource.Resource, data *LidarrData) tfsdk.State {
+	t.Helper()
+
+	ctx := context.Background()
+
+	var schemaResp fwresource.SchemaResponse
+
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	if configurable, ok := r.(fwresource.ResourceWithConfigure); ok {
+		var configureResp fwresource.ConfigureResponse
+
+		configurable.Configure(ctx, fwresource.ConfigureRequest{ProviderData: data}, &configureResp)
+		assert.False(t, configureResp.Diagnostics.HasError(), configureResp.Diagnostics)
+	}
+
+	return tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+}
+
+// testResourceRead reads the resource with the given ID, as done on refresh and import.
+func testResourceRead(t *testing.T, r fwresource.Resource, data *LidarrData, id int64) tfsdk.State {
+	t.Helper()
+
+	ctx := context.Background()
+	state := testResourceState(t, r, data)
+	assert.False(t, state.SetAttribute(ctx, path.Root("id"), id).HasError())
+
+	resp := fwresource.ReadResponse{State: state}
+	r.Read(ctx, fwresource.ReadRequest{State: state}, &resp)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	return resp.State
+}
+
+// testResourceUpdate applies the given state back to Lidarr as plan, returning the new state.
+func testResourceUpdate(t *testing.T, r fwresource.Resource, state tfsdk.State) tfsdk.State {
+	t.Helper()
+
+	ctx := context.Background()
+	plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw.Copy()}
+	resp := fwresource.UpdateResponse{State: state}
+
+	r.Update(ctx, fwresource.UpdateRequest{
+		Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw.Copy()},
+		Plan:   plan,
+		State:  state,
+	}, &resp)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	return resp.State
+}
+
+// testFieldValues indexes the given fields values by name, to compare requests regardless of the fields order.
+func testFieldValues(fields []lidarr.Field) map[string]interface{} {
+	values := make(map[string]interface{}, len(fields))
+	for _, field := range fields {
+		values[field.GetName()] = field.GetValue()
+	}
+
+	return values
+}
@@ -101,7 +101,7 @@ func (q *Quality) find(name string, definitions []lidarr.QualityDefinitionResour
 		}
 	}
 
-	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(qualityDataSourceName, "name", name))
+	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(qualityDataSourceName, "name", name, helpers.Values(definitions, func(d *lidarr.QualityDefinitionResource) string { return d.Quality.GetName() })))
 }
 
 func (q *Quality) writeFromDefinition(quality *lidarr.QualityDefinitionResource) {
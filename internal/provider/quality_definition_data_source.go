@@ -103,5 +103,5 @@ func (p *QualityDefinition) find(id int64, definitions []lidarr.QualityDefinitio
 		}
 	}
 
-	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(qualityDefinitionDataSourceName, "id", strconv.Itoa(int(id))))
+	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(qualityDefinitionDataSourceName, "id", strconv.Itoa(int(id)), helpers.Values(definitions, (*lidarr.QualityDefinitionResource).GetId)))
 }
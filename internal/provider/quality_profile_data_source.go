@@ -14,7 +14,10 @@ import (
 const qualityProfileDataSourceName = "quality_profile"
 
 // Ensure provider defined types fully satisfy framework interfaces.
-var _ datasource.DataSource = &QualityProfileDataSource{}
+var (
+	_ datasource.DataSource                     = &QualityProfileDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &QualityProfileDataSource{}
+)
 
 func NewQualityProfileDataSource() datasource.DataSource {
 	return &QualityProfileDataSource{}
@@ -37,11 +40,13 @@ func (d *QualityProfileDataSource) Schema(_ context.Context, _ datasource.Schema
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Quality Profile ID.",
+				Optional:            true,
 				Computed:            true,
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Quality Profile Name.",
-				Required:            true,
+				Optional:            true,
+				Computed:            true,
 			},
 			"upgrade_allowed": schema.BoolAttribute{
 				MarkdownDescription: "Upgrade allowed flag.",
@@ -115,6 +120,12 @@ func (d *QualityProfileDataSource) Schema(_ context.Context, _ datasource.Schema
 	}
 }
 
+func (d *QualityProfileDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		helpers.IDOrName("name"),
+	}
+}
+
 func (d *QualityProfileDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
 		d.client = client
@@ -138,21 +149,21 @@ func (d *QualityProfileDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
-	data.find(ctx, data.Name.ValueString(), response, &resp.Diagnostics)
+	data.find(ctx, helpers.NewLookup(data.ID, "name", data.Name), response, &resp.Diagnostics)
 
 	tflog.Trace(ctx, "read "+qualityProfileDataSourceName)
 	// Map response body to resource schema attribute
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (p *QualityProfile) find(ctx context.Context, name string, profiles []lidarr.QualityProfileResource, diags *diag.Diagnostics) {
+func (p *QualityProfile) find(ctx context.Context, lookup helpers.Lookup, profiles []lidarr.QualityProfileResource, diags *diag.Diagnostics) {
 	for _, profile := range profiles {
-		if profile.GetName() == name {
+		if lookup.Matches(profile.GetId(), profile.GetName()) {
 			p.write(ctx, &profile, diags)
 
 			return
 		}
 	}
 
-	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(qualityProfileDataSourceName, "name", name))
+	diags.AddError(helpers.DataSourceError, lookup.NotFound(qualityProfileDataSourceName, helpers.Values(profiles, (*lidarr.QualityProfileResource).GetName)))
 }
@@ -32,6 +32,17 @@ func TestAccQualityProfileDataSource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.lidarr_quality_profile.test", "id"),
 					resource.TestCheckResourceAttr("data.lidarr_quality_profile.test", "cutoff", "1005")),
 			},
+			// Conflicting lookup testing
+			{
+				Config:      testAccQualityProfileDataSourceConflictConfig,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			// Read by ID testing
+			{
+				Config: testAccQualityProfileDataSourceIDConfig("1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lidarr_quality_profile.test", "name", "Any")),
+			},
 		},
 	})
 }
@@ -43,3 +54,18 @@ func testAccQualityProfileDataSourceConfig(name string) string {
 	}
 	`, name)
 }
+
+const testAccQualityProfileDataSourceConflictConfig = `
+data "lidarr_quality_profile" "test" {
+	id = 1
+	name = "Error"
+}
+`
+
+func testAccQualityProfileDataSourceIDConfig(id string) string {
+	return fmt.Sprintf(`
+	data "lidarr_quality_profile" "test" {
+		id = %s
+	}
+	`, id)
+}
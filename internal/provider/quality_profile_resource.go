@@ -2,11 +2,16 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/devopsarr/lidarr-go/lidarr"
 	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -15,16 +20,23 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-const qualityProfileResourceName = "quality_profile"
+const (
+	qualityProfileResourceName = "quality_profile"
+	// qualityGroupIDOffset is added to the item index to compute the IDs of the groups declared by name.
+	qualityGroupIDOffset = 1000
+	unknownQualityError  = "Unknown Quality"
+)
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &QualityProfileResource{}
-	_ resource.ResourceWithImportState = &QualityProfileResource{}
+	_ resource.Resource                     = &QualityProfileResource{}
+	_ resource.ResourceWithImportState      = &QualityProfileResource{}
+	_ resource.ResourceWithConfigValidators = &QualityProfileResource{}
 )
 
 func NewQualityProfileResource() resource.Resource {
@@ -37,6 +49,32 @@ type QualityProfileResource struct {
 	auth   context.Context
 }
 
+// QualityProfileResourceData describes the quality profile resource data model.
+// It extends the quality profile data model with attributes not stored in Lidarr.
+type QualityProfileResourceData struct {
+	QualityProfile
+	Items              types.List `tfsdk:"items"`
+	DeletionProtection types.Bool `tfsdk:"deletion_protection"`
+}
+
+// QualityProfileItem describes a quality profile item declared by quality names.
+type QualityProfileItem struct {
+	Qualities types.List   `tfsdk:"qualities"`
+	Name      types.String `tfsdk:"name"`
+	Group     types.String `tfsdk:"group"`
+	ID        types.Int64  `tfsdk:"id"`
+}
+
+func (i QualityProfileItem) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"qualities": types.ListType{}.WithElementType(types.StringType),
+			"name":      types.StringType,
+			"group":     types.StringType,
+			"id":        types.Int64Type,
+		})
+}
+
 // QualityProfile describes the quality profile data model.
 type QualityProfile struct {
 	FormatItems       types.Set    `tfsdk:"format_items"`
@@ -103,6 +141,7 @@ func (r *QualityProfileResource) Schema(_ context.Context, _ resource.SchemaRequ
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Profiles -->\nQuality Profile resource.\nFor more information refer to [Quality Profile](https://wiki.servarr.com/lidarr/settings#quality-profiles) documentation.",
 		Attributes: map[string]schema.Attribute{
+			"deletion_protection": helpers.DeletionProtectionAttribute(),
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Quality Profile ID.",
 				Computed:            true,
@@ -135,12 +174,20 @@ func (r *QualityProfileResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Computed:            true,
 			},
 			"quality_groups": schema.ListNestedAttribute{
-				MarkdownDescription: "Ordered list of allowed quality groups.",
-				Required:            true,
+				MarkdownDescription: "Ordered list of allowed quality groups. Required unless `items` is set.",
+				Optional:            true,
+				Computed:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: r.getQualityGroupSchema().Attributes,
 				},
 			},
+			"items": schema.ListNestedAttribute{
+				MarkdownDescription: "Ordered list of allowed qualities declared by name, as an alternative to `quality_groups`.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: r.getItemSchema().Attributes,
+				},
+			},
 			"format_items": schema.SetNestedAttribute{
 				MarkdownDescription: "Format items. Only the ones with score > 0 are needed.",
 				Optional:            true,
@@ -153,6 +200,40 @@ func (r *QualityProfileResource) Schema(_ context.Context, _ resource.SchemaRequ
 	}
 }
 
+func (r QualityProfileResource) getItemSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Quality ID, or group ID computed from the item position (1001 for the first item) for groups.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Quality name.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("group")),
+				},
+			},
+			"group": schema.StringAttribute{
+				MarkdownDescription: "Quality group name.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("qualities")),
+				},
+			},
+			"qualities": schema.ListAttribute{
+				MarkdownDescription: "Ordered list of quality names in group. Lidarr groups hold at least two qualities, use `name` for a single one.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("group")),
+					listvalidator.SizeAtLeast(2),
+				},
+			},
+		},
+	}
+}
+
 func (r QualityProfileResource) getQualityGroupSchema() schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
@@ -223,6 +304,15 @@ func (r QualityProfileResource) getFormatItemsSchema() schema.Schema {
 	}
 }
 
+func (r *QualityProfileResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("quality_groups"),
+			path.MatchRoot("items"),
+		),
+	}
+}
+
 func (r *QualityProfileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -232,7 +322,7 @@ func (r *QualityProfileResource) Configure(ctx context.Context, req resource.Con
 
 func (r *QualityProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var profile *QualityProfile
+	var profile *QualityProfileResourceData
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &profile)...)
 
@@ -241,7 +331,11 @@ func (r *QualityProfileResource) Create(ctx context.Context, req resource.Create
 	}
 
 	// Build Create resource
-	request := profile.read(ctx, r.getQualityIDs(&resp.Diagnostics), r.getFormatsIDs(&resp.Diagnostics), &resp.Diagnostics)
+	request := profile.read(ctx, r.getQualities(&resp.Diagnostics), r.getFormatsIDs(&resp.Diagnostics), &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Create new QualityProfile
 	response, _, err := r.client.QualityProfileAPI.CreateQualityProfile(r.auth).QualityProfileResource(*request).Execute()
@@ -251,6 +345,8 @@ func (r *QualityProfileResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	response = helpers.ReadAfterCreate(ctx, response, qualityProfileResourceName, r.client.QualityProfileAPI.GetQualityProfileById(r.auth, response.GetId()).Execute, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+qualityProfileResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	profile.write(ctx, response, &resp.Diagnostics)
@@ -259,7 +355,7 @@ func (r *QualityProfileResource) Create(ctx context.Context, req resource.Create
 
 func (r *QualityProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var profile *QualityProfile
+	var profile *QualityProfileResourceData
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &profile)...)
 
@@ -268,8 +364,15 @@ func (r *QualityProfileResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	// Get qualityprofile current value
-	response, _, err := r.client.QualityProfileAPI.GetQualityProfileById(r.auth, int32(profile.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.QualityProfileAPI.GetQualityProfileById(r.auth, int32(profile.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			resp.Diagnostics.AddWarning(helpers.ResourceNotFound, helpers.ParseResourceNotFound(qualityProfileResourceName, profile.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, qualityProfileResourceName, err))
 
 		return
@@ -283,7 +386,7 @@ func (r *QualityProfileResource) Read(ctx context.Context, req resource.ReadRequ
 
 func (r *QualityProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var profile *QualityProfile
+	var profile *QualityProfileResourceData
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &profile)...)
 
@@ -292,7 +395,11 @@ func (r *QualityProfileResource) Update(ctx context.Context, req resource.Update
 	}
 
 	// Build Update resource
-	request := profile.read(ctx, r.getQualityIDs(&resp.Diagnostics), r.getFormatsIDs(&resp.Diagnostics), &resp.Diagnostics)
+	request := profile.read(ctx, r.getQualities(&resp.Diagnostics), r.getFormatsIDs(&resp.Diagnostics), &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Update QualityProfile
 	response, _, err := r.client.QualityProfileAPI.UpdateQualityProfile(r.auth, strconv.Itoa(int(request.GetId()))).QualityProfileResource(*request).Execute()
@@ -317,6 +424,10 @@ func (r *QualityProfileResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	if helpers.DeletionProtected(ctx, req.State, qualityProfileResourceName, &resp.Diagnostics) {
+		return
+	}
+
 	// Delete qualityprofile current value
 	_, err := r.client.QualityProfileAPI.DeleteQualityProfile(r.auth, int32(ID)).Execute()
 	if err != nil {
@@ -331,9 +442,60 @@ func (r *QualityProfileResource) Delete(ctx context.Context, req resource.Delete
 
 func (r *QualityProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deletion_protection"), false)...)
 	tflog.Trace(ctx, "imported "+qualityProfileResourceName+": "+req.ID)
 }
 
+// write maps the response into the state, also mapping the quality IDs back to names when items are used.
+func (p *QualityProfileResourceData) write(ctx context.Context, profile *lidarr.QualityProfileResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	p.QualityProfile.write(ctx, profile, diags)
+
+	if p.Items.IsNull() {
+		return
+	}
+
+	items := make([]QualityProfileItem, 0, len(profile.GetItems()))
+
+	for _, i := range profile.GetItems() {
+		if i.GetAllowed() {
+			item := QualityProfileItem{}
+			item.write(ctx, &i, diags)
+			items = append(items, item)
+		}
+	}
+
+	// Order items from higher to lower
+	slices.Reverse(items)
+	p.Items, tempDiag = types.ListValueFrom(ctx, QualityProfileItem{}.getType(), items)
+	diags.Append(tempDiag...)
+}
+
+func (i *QualityProfileItem) write(ctx context.Context, item *lidarr.QualityProfileQualityItemResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	if len(item.GetItems()) == 0 {
+		i.ID = types.Int64Value(int64(item.Quality.GetId()))
+		i.Name = types.StringValue(item.Quality.GetName())
+		i.Group = types.StringNull()
+		i.Qualities = types.ListNull(types.StringType)
+
+		return
+	}
+
+	qualities := make([]string, len(item.GetItems()))
+	for n, q := range item.GetItems() {
+		qualities[n] = q.Quality.GetName()
+	}
+
+	i.ID = types.Int64Value(int64(item.GetId()))
+	i.Name = types.StringNull()
+	i.Group = types.StringValue(item.GetName())
+	i.Qualities, tempDiag = types.ListValueFrom(ctx, types.StringType, qualities)
+	diags.Append(tempDiag...)
+}
+
 func (p *QualityProfile) write(ctx context.Context, profile *lidarr.QualityProfileResource, diags *diag.Diagnostics) {
 	var tempDiag diag.Diagnostics
 
@@ -409,6 +571,70 @@ func (f *FormatItem) write(format *lidarr.ProfileFormatItemResource) {
 	f.Score = types.Int64Value(int64(format.GetScore()))
 }
 
+// read builds the quality profile request, resolving the quality names of the items when set.
+func (p *QualityProfileResourceData) read(ctx context.Context, qualities []lidarr.Quality, formatIDs []int32, diags *diag.Diagnostics) *lidarr.QualityProfileResource {
+	qualityIDs := make([]int32, len(qualities))
+	for n, q := range qualities {
+		qualityIDs[n] = q.GetId()
+	}
+
+	if !p.Items.IsNull() {
+		p.resolveItems(ctx, qualities, diags)
+	}
+
+	return p.QualityProfile.read(ctx, qualityIDs, formatIDs, diags)
+}
+
+// resolveItems converts the items declared by quality names into quality groups.
+func (p *QualityProfileResourceData) resolveItems(ctx context.Context, qualities []lidarr.Quality, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	items := make([]QualityProfileItem, len(p.Items.Elements()))
+	diags.Append(p.Items.ElementsAs(ctx, &items, false)...)
+
+	qualityIDs := make(map[string]int32, len(qualities))
+	available := make([]string, len(qualities))
+
+	for n, q := range qualities {
+		qualityIDs[q.GetName()] = q.GetId()
+		available[n] = q.GetName()
+	}
+
+	groups := make([]QualityGroup, len(items))
+
+	for n, item := range items {
+		names := []string{item.Name.ValueString()}
+		groups[n].ID = types.Int64Null()
+		groups[n].Name = types.StringNull()
+
+		if !item.Group.IsNull() {
+			names = make([]string, len(item.Qualities.Elements()))
+			diags.Append(item.Qualities.ElementsAs(ctx, &names, false)...)
+			groups[n].ID = types.Int64Value(int64(qualityGroupIDOffset + n + 1))
+			groups[n].Name = item.Group
+		}
+
+		groupQualities := make([]Quality, len(names))
+
+		for m, name := range names {
+			id, ok := qualityIDs[name]
+			if !ok {
+				diags.AddAttributeError(path.Root("items").AtListIndex(n), unknownQualityError, fmt.Sprintf("Unknown quality %q, available qualities: %s.", name, strings.Join(available, ", ")))
+
+				continue
+			}
+
+			groupQualities[m] = Quality{ID: types.Int64Value(int64(id)), Name: types.StringValue(name)}
+		}
+
+		groups[n].Qualities, tempDiag = types.ListValueFrom(ctx, Quality{}.getType(), groupQualities)
+		diags.Append(tempDiag...)
+	}
+
+	p.QualityGroups, tempDiag = types.ListValueFrom(ctx, QualityGroup{}.getType(), groups)
+	diags.Append(tempDiag...)
+}
+
 func (p *QualityProfile) read(ctx context.Context, qualitiesIDs []int32, formatIDs []int32, diags *diag.Diagnostics) *lidarr.QualityProfileResource {
 	var allowedQualities, allowedFormats []int32
 
@@ -525,25 +751,25 @@ func (f *FormatItem) read() *lidarr.ProfileFormatItemResource {
 	return formatItem
 }
 
-func (r QualityProfileResource) getQualityIDs(diags *diag.Diagnostics) []int32 {
+func (r QualityProfileResource) getQualities(diags *diag.Diagnostics) []lidarr.Quality {
 	// Get qualitydefinitions current value
-	qualities, _, err := r.client.QualityDefinitionAPI.ListQualityDefinition(r.auth).Execute()
+	definitions, _, err := r.client.QualityDefinitionAPI.ListQualityDefinition(r.auth).Execute()
 	if err != nil {
 		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, qualityDefinitionsDataSourceName, err))
 
-		return []int32{}
+		return []lidarr.Quality{}
 	}
 
-	// Generate a list of quality IDs
-	qualityIDs := make([]int32, len(qualities))
-	for i, q := range qualities {
-		qualityIDs[i] = q.Quality.GetId()
+	// Generate a list of qualities
+	qualities := make([]lidarr.Quality, len(definitions))
+	for i, d := range definitions {
+		qualities[i] = d.GetQuality()
 	}
 
 	// Reverse for better visual
-	slices.Reverse(qualityIDs)
+	slices.Reverse(qualities)
 
-	return qualityIDs
+	return qualities
 }
 
 func (r QualityProfileResource) getFormatsIDs(diags *diag.Diagnostics) []int32 {
@@ -1,11 +1,17 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
 
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccQualityProfileResource(t *testing.T) {
@@ -46,6 +52,26 @@ func TestAccQualityProfileResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Items by name testing
+			{
+				Config: testAccQualityProfileResourceItemsConfig("example-items", "FLAC"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lidarr_quality_profile.test", "items.0.group", "lossless"),
+					resource.TestCheckResourceAttr("lidarr_quality_profile.test", "items.0.id", "1001"),
+					resource.TestCheckResourceAttr("lidarr_quality_profile.test", "items.1.name", "OGG Vorbis Q10"),
+					resource.TestCheckResourceAttr("lidarr_quality_profile.test", "quality_groups.0.qualities.1.name", "FLAC"),
+				),
+			},
+			// Unknown quality name testing
+			{
+				Config:      testAccQualityProfileResourceItemsConfig("example-items", "FLAC-42"),
+				ExpectError: regexp.MustCompile(`Unknown quality "FLAC-42"`),
+			},
+			// Single quality group testing
+			{
+				Config:      testAccQualityProfileResourceSingleGroupConfig("example-items"),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Length`),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -118,3 +144,116 @@ func testAccQualityProfileResourceConfig(name string) string {
 	}
 	`, name)
 }
+
+func testAccQualityProfileResourceItemsConfig(name, quality string) string {
+	return fmt.Sprintf(`
+	resource "lidarr_quality_profile" "test" {
+		name            = "%s"
+		upgrade_allowed = true
+		cutoff          = 1001
+
+		items = [
+			{
+				group     = "lossless"
+				qualities = ["ALAC", "%s"]
+			},
+			{
+				name = "OGG Vorbis Q10"
+			}
+		]
+	}
+	`, name, quality)
+}
+
+func testAccQualityProfileResourceSingleGroupConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "lidarr_quality_profile" "test" {
+		name            = "%s"
+		upgrade_allowed = true
+		cutoff          = 1001
+
+		items = [
+			{
+				group     = "lossless"
+				qualities = ["FLAC"]
+			}
+		]
+	}
+	`, name)
+}
+
+func TestQualityProfileResolveItems(t *testing.T) {
+	t.Parallel()
+
+	qualities := []lidarr.Quality{
+		{Id: lidarr.PtrInt32(6), Name: *lidarr.NewNullableString(lidarr.PtrString("FLAC"))},
+		{Id: lidarr.PtrInt32(4), Name: *lidarr.NewNullableString(lidarr.PtrString("MP3-320"))},
+		{Id: lidarr.PtrInt32(12), Name: *lidarr.NewNullableString(lidarr.PtrString("AAC-320"))},
+	}
+	qualityList := func(names ...string) types.List {
+		values := make([]attr.Value, len(names))
+		for n, name := range names {
+			values[n] = types.StringValue(name)
+		}
+
+		return types.ListValueMust(types.StringType, values)
+	}
+
+	tests := map[string]struct {
+		items    []QualityProfileItem
+		expected []QualityGroup
+		err      bool
+	}{
+		"name and group": {
+			items: []QualityProfileItem{
+				{Name: types.StringValue("FLAC"), Group: types.StringNull(), Qualities: types.ListNull(types.StringType), ID: types.Int64Unknown()},
+				{Name: types.StringNull(), Group: types.StringValue("Lossy High"), Qualities: qualityList("MP3-320", "AAC-320"), ID: types.Int64Unknown()},
+			},
+			expected: []QualityGroup{
+				{
+					ID:   types.Int64Null(),
+					Name: types.StringNull(),
+					Qualities: types.ListValueMust(Quality{}.getType(), []attr.Value{
+						types.ObjectValueMust(map[string]attr.Type{"id": types.Int64Type, "name": types.StringType}, map[string]attr.Value{"id": types.Int64Value(6), "name": types.StringValue("FLAC")}),
+					}),
+				},
+				{
+					ID:   types.Int64Value(1002),
+					Name: types.StringValue("Lossy High"),
+					Qualities: types.ListValueMust(Quality{}.getType(), []attr.Value{
+						types.ObjectValueMust(map[string]attr.Type{"id": types.Int64Type, "name": types.StringType}, map[string]attr.Value{"id": types.Int64Value(4), "name": types.StringValue("MP3-320")}),
+						types.ObjectValueMust(map[string]attr.Type{"id": types.Int64Type, "name": types.StringType}, map[string]attr.Value{"id": types.Int64Value(12), "name": types.StringValue("AAC-320")}),
+					}),
+				},
+			},
+		},
+		"unknown quality": {
+			items: []QualityProfileItem{
+				{Name: types.StringValue("WAV"), Group: types.StringNull(), Qualities: types.ListNull(types.StringType), ID: types.Int64Unknown()},
+			},
+			err: true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+
+			profile := QualityProfileResourceData{}
+			profile.Items, diags = types.ListValueFrom(context.Background(), QualityProfileItem{}.getType(), test.items)
+			assert.False(t, diags.HasError())
+
+			profile.resolveItems(context.Background(), qualities, &diags)
+			assert.Equal(t, test.err, diags.HasError())
+
+			if !test.err {
+				groups := make([]QualityGroup, len(profile.QualityGroups.Elements()))
+				profile.QualityGroups.ElementsAs(context.Background(), &groups, false)
+				assert.Equal(t, test.expected, groups)
+			}
+		})
+	}
+}
@@ -0,0 +1,350 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	queueCleanerResourceName = "queue_cleaner"
+	queuePageSize            = 100
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                     = &QueueCleanerResource{}
+	_ resource.ResourceWithConfigValidators = &QueueCleanerResource{}
+)
+
+func NewQueueCleanerResource() resource.Resource {
+	return &QueueCleanerResource{}
+}
+
+// QueueCleanerResource defines the queue cleaner implementation.
+type QueueCleanerResource struct {
+	client *lidarr.APIClient
+	auth   context.Context
+}
+
+// QueueCleaner describes the queue cleaner data model.
+type QueueCleaner struct {
+	Triggers         types.Map    `tfsdk:"triggers"`
+	Statuses         types.Set    `tfsdk:"statuses"`
+	RemovedIDs       types.Set    `tfsdk:"removed_ids"`
+	MessagePattern   types.String `tfsdk:"message_pattern"`
+	MinAge           types.String `tfsdk:"min_age"`
+	ID               types.Int64  `tfsdk:"id"`
+	Removed          types.Int64  `tfsdk:"removed"`
+	RemoveFromClient types.Bool   `tfsdk:"remove_from_client"`
+	Blocklist        types.Bool   `tfsdk:"blocklist"`
+}
+
+// queueCleanerCriteria holds the parsed criteria of the queue items to remove.
+type queueCleanerCriteria struct {
+	pattern  *regexp.Regexp
+	statuses []string
+	minAge   time.Duration
+}
+
+func (r *QueueCleanerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + queueCleanerResourceName
+}
+
+func (r *QueueCleanerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:System -->\nQueue Cleaner resource. Removes the queue items matching all the configured criteria on creation, changing any argument runs it again. Destroying the resource only removes it from state.\nFor more information refer to [Queue](https://wiki.servarr.com/lidarr/activity#queue) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values which run the cleaner again when changed.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"statuses": schema.SetAttribute{
+				MarkdownDescription: "Download statuses to match, e.g. `failed` or `warning`. Case insensitive.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"message_pattern": schema.StringAttribute{
+				MarkdownDescription: "Regular expression to match against the error and status messages.",
+				Optional:            true,
+				Validators: []validator.String{
+					helpers.Regexp(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"min_age": schema.StringAttribute{
+				MarkdownDescription: "Minimum time since the item was added to the queue, as a duration string such as `30m` or `48h`.",
+				Optional:            true,
+				Validators: []validator.String{
+					helpers.Duration(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"remove_from_client": schema.BoolAttribute{
+				MarkdownDescription: "Remove the items from the download client. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"blocklist": schema.BoolAttribute{
+				MarkdownDescription: "Add the releases of the items to the blocklist. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"removed": schema.Int64Attribute{
+				MarkdownDescription: "Number of removed items.",
+				Computed:            true,
+			},
+			"removed_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the removed items.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Queue Cleaner ID, the Unix time of the run.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *QueueCleanerResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("statuses"),
+			path.MatchRoot("message_pattern"),
+			path.MatchRoot("min_age"),
+		),
+	}
+}
+
+func (r *QueueCleanerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+		r.auth = auth
+	}
+}
+
+func (r *QueueCleanerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var cleaner *QueueCleaner
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &cleaner)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	criteria, err := cleaner.read(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ResourceError, fmt.Sprintf("Unable to parse %s criteria, got error: %s", queueCleanerResourceName, err))
+
+		return
+	}
+
+	// Find the matching queue items
+	queue, err := r.listQueue()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, queueCleanerResourceName, err))
+
+		return
+	}
+
+	now := time.Now()
+	ids := make([]int32, 0)
+
+	for i := range queue {
+		if criteria.matches(&queue[i], now) {
+			ids = append(ids, queue[i].GetId())
+		}
+	}
+
+	// Remove them, if any
+	if len(ids) > 0 {
+		bulk := lidarr.NewQueueBulkResource()
+		bulk.SetIds(ids)
+
+		_, err = r.client.QueueAPI.DeleteQueueBulk(r.auth).
+			RemoveFromClient(cleaner.RemoveFromClient.ValueBool()).
+			Blocklist(cleaner.Blocklist.ValueBool()).
+			QueueBulkResource(*bulk).
+			Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, queueCleanerResourceName, err))
+
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "created "+queueCleanerResourceName+": removed "+strconv.Itoa(len(ids))+" items")
+	// Generate resource state struct
+	cleaner.write(ctx, ids, now)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &cleaner)...)
+}
+
+func (r *QueueCleanerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// The removed items are gone, the state only records the last run
+	var cleaner *QueueCleaner
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &cleaner)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read "+queueCleanerResourceName+": "+strconv.Itoa(int(cleaner.ID.ValueInt64())))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &cleaner)...)
+}
+
+func (r *QueueCleanerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All arguments require replacement, nothing can be updated in place
+	var cleaner *QueueCleaner
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &cleaner)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+queueCleanerResourceName+": "+strconv.Itoa(int(cleaner.ID.ValueInt64())))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &cleaner)...)
+}
+
+func (r *QueueCleanerResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Removed items cannot be restored, only remove the resource from state
+	tflog.Trace(ctx, "deleted "+queueCleanerResourceName)
+	resp.State.RemoveResource(ctx)
+}
+
+// listQueue returns all the queue items, going through every page.
+func (r *QueueCleanerResource) listQueue() ([]lidarr.QueueResource, error) {
+	var items []lidarr.QueueResource
+
+	for page := int32(1); ; page++ {
+		response, _, err := r.client.QueueAPI.GetQueue(r.auth).Page(page).PageSize(queuePageSize).IncludeUnknownArtistItems(true).Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, response.GetRecords()...)
+
+		if len(response.GetRecords()) < queuePageSize || len(items) >= int(response.GetTotalRecords()) {
+			return items, nil
+		}
+	}
+}
+
+func (c *QueueCleaner) write(ctx context.Context, ids []int32, now time.Time) {
+	c.ID = types.Int64Value(now.Unix())
+	c.Removed = types.Int64Value(int64(len(ids)))
+	c.RemovedIDs, _ = types.SetValueFrom(ctx, types.Int64Type, ids)
+}
+
+func (c *QueueCleaner) read(ctx context.Context) (*queueCleanerCriteria, error) {
+	criteria := queueCleanerCriteria{}
+	c.Statuses.ElementsAs(ctx, &criteria.statuses, true)
+
+	if !c.MessagePattern.IsNull() {
+		pattern, err := regexp.Compile(c.MessagePattern.ValueString())
+		if err != nil {
+			return nil, err
+		}
+
+		criteria.pattern = pattern
+	}
+
+	if !c.MinAge.IsNull() {
+		minAge, err := time.ParseDuration(c.MinAge.ValueString())
+		if err != nil {
+			return nil, err
+		}
+
+		criteria.minAge = minAge
+	}
+
+	return &criteria, nil
+}
+
+// matches checks if a queue item satisfies all the configured criteria.
+func (c *queueCleanerCriteria) matches(item *lidarr.QueueResource, now time.Time) bool {
+	if len(c.statuses) > 0 && !containsFold(c.statuses, item.GetStatus()) {
+		return false
+	}
+
+	if c.pattern != nil && !c.pattern.MatchString(queueItemMessages(item)) {
+		return false
+	}
+
+	if c.minAge > 0 && (!item.HasAdded() || now.Sub(item.GetAdded()) < c.minAge) {
+		return false
+	}
+
+	return true
+}
+
+// queueItemMessages joins the error and status messages of a queue item, one per line.
+func queueItemMessages(item *lidarr.QueueResource) string {
+	messages := []string{item.GetErrorMessage()}
+
+	for _, s := range item.GetStatusMessages() {
+		messages = append(messages, s.GetTitle())
+		messages = append(messages, s.GetMessages()...)
+	}
+
+	return strings.Join(messages, "\n")
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccQueueCleanerResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccQueueCleanerResourceConfig("1") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Missing criteria
+			{
+				Config:      `resource "lidarr_queue_cleaner" "test" {}`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			// Create and Read testing, the test queue is empty
+			{
+				Config: testAccQueueCleanerResourceConfig("1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lidarr_queue_cleaner.test", "removed", "0"),
+					resource.TestCheckResourceAttrSet("lidarr_queue_cleaner.test", "id"),
+				),
+			},
+			// Triggers testing
+			{
+				Config: testAccQueueCleanerResourceConfig("2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lidarr_queue_cleaner.test", "triggers.run", "2"),
+					resource.TestCheckResourceAttr("lidarr_queue_cleaner.test", "removed", "0"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccQueueCleanerResourceConfig(run string) string {
+	return fmt.Sprintf(`
+		resource "lidarr_queue_cleaner" "test" {
+			statuses = ["failed", "warning"]
+			message_pattern = "(?i)no files found"
+			min_age = "1h"
+			blocklist = true
+			triggers = {
+				run = "%s"
+			}
+		}
+	`, run)
+}
+
+func TestQueueCleanerCriteriaMatches(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	item := lidarr.NewQueueResource()
+	item.SetStatus("Warning")
+	item.SetAdded(now.Add(-2 * time.Hour))
+	item.SetStatusMessages([]lidarr.TrackedDownloadStatusMessage{{Messages: []string{"No files found are eligible for import"}}})
+
+	tests := map[string]struct {
+		criteria queueCleanerCriteria
+		expected bool
+	}{
+		"status": {
+			criteria: queueCleanerCriteria{statuses: []string{"warning"}},
+			expected: true,
+		},
+		"other status": {
+			criteria: queueCleanerCriteria{statuses: []string{"failed"}},
+			expected: false,
+		},
+		"message": {
+			criteria: queueCleanerCriteria{pattern: regexp.MustCompile("(?i)no files found")},
+			expected: true,
+		},
+		"other message": {
+			criteria: queueCleanerCriteria{pattern: regexp.MustCompile("not enough space")},
+			expected: false,
+		},
+		"old enough": {
+			criteria: queueCleanerCriteria{minAge: time.Hour},
+			expected: true,
+		},
+		"too recent": {
+			criteria: queueCleanerCriteria{minAge: 3 * time.Hour},
+			expected: false,
+		},
+		"all criteria": {
+			criteria: queueCleanerCriteria{statuses: []string{"warning"}, pattern: regexp.MustCompile("eligible"), minAge: time.Hour},
+			expected: true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, test.criteria.matches(item, now))
+		})
+	}
+}
@@ -0,0 +1,298 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	queueDataSourceName = "queue"
+	maxQueuePageSize    = 1000
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &QueueDataSource{}
+
+func NewQueueDataSource() datasource.DataSource {
+	return &QueueDataSource{}
+}
+
+// QueueDataSource defines the queue implementation.
+type QueueDataSource struct {
+	client *lidarr.APIClient
+	auth   context.Context
+}
+
+// Queue describes the queue data model.
+type Queue struct {
+	Records       types.List   `tfsdk:"records"`
+	SortKey       types.String `tfsdk:"sort_key"`
+	SortDirection types.String `tfsdk:"sort_direction"`
+	Protocol      types.String `tfsdk:"protocol"`
+	ID            types.String `tfsdk:"id"`
+	Page          types.Int64  `tfsdk:"page"`
+	PageSize      types.Int64  `tfsdk:"page_size"`
+	TotalRecords  types.Int64  `tfsdk:"total_records"`
+}
+
+// QueueItem describes a single queue item.
+type QueueItem struct {
+	Title                 types.String  `tfsdk:"title"`
+	Status                types.String  `tfsdk:"status"`
+	TrackedDownloadStatus types.String  `tfsdk:"tracked_download_status"`
+	TrackedDownloadState  types.String  `tfsdk:"tracked_download_state"`
+	Protocol              types.String  `tfsdk:"protocol"`
+	DownloadClient        types.String  `tfsdk:"download_client"`
+	DownloadID            types.String  `tfsdk:"download_id"`
+	Indexer               types.String  `tfsdk:"indexer"`
+	ErrorMessage          types.String  `tfsdk:"error_message"`
+	Timeleft              types.String  `tfsdk:"timeleft"`
+	Added                 types.String  `tfsdk:"added"`
+	Size                  types.Float64 `tfsdk:"size"`
+	Sizeleft              types.Float64 `tfsdk:"sizeleft"`
+	ID                    types.Int64   `tfsdk:"id"`
+	ArtistID              types.Int64   `tfsdk:"artist_id"`
+	AlbumID               types.Int64   `tfsdk:"album_id"`
+}
+
+func (q QueueItem) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"title":                   types.StringType,
+			"status":                  types.StringType,
+			"tracked_download_status": types.StringType,
+			"tracked_download_state":  types.StringType,
+			"protocol":                types.StringType,
+			"download_client":         types.StringType,
+			"download_id":             types.StringType,
+			"indexer":                 types.StringType,
+			"error_message":           types.StringType,
+			"timeleft":                types.StringType,
+			"added":                   types.StringType,
+			"size":                    types.Float64Type,
+			"sizeleft":                types.Float64Type,
+			"id":                      types.Int64Type,
+			"artist_id":               types.Int64Type,
+			"album_id":                types.Int64Type,
+		})
+}
+
+func (d *QueueDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + queueDataSourceName
+}
+
+func (d *QueueDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the delay server.
+		MarkdownDescription: "<!-- subcategory:Queue -->\nSingle page of the download queue. Paging, sorting and filtering are done by Lidarr.\nFor more information refer to [Queue](https://wiki.servarr.com/lidarr/activity#queue) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"page": schema.Int64Attribute{
+				MarkdownDescription: "Page number, starting from `1`. Defaults to `1`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"page_size": schema.Int64Attribute{
+				MarkdownDescription: "Number of items per page. Defaults to `" + strconv.Itoa(queuePageSize) + "`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, maxQueuePageSize),
+				},
+			},
+			"sort_key": schema.StringAttribute{
+				MarkdownDescription: "Sort key, e.g. `timeleft`, `title` or `progress`.",
+				Optional:            true,
+			},
+			"sort_direction": schema.StringAttribute{
+				MarkdownDescription: "Sort direction. Valid values are 'ascending' and 'descending'.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(lidarr.SORTDIRECTION_ASCENDING), string(lidarr.SORTDIRECTION_DESCENDING)),
+				},
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "Only list the items downloaded with the given protocol. Valid values are 'usenet' and 'torrent'.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(lidarr.DOWNLOADPROTOCOL_USENET), string(lidarr.DOWNLOADPROTOCOL_TORRENT)),
+				},
+			},
+			"total_records": schema.Int64Attribute{
+				MarkdownDescription: "Total number of items matching the filters.",
+				Computed:            true,
+			},
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"records": schema.ListNestedAttribute{
+				MarkdownDescription: "Queue item list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Queue item ID.",
+							Computed:            true,
+						},
+						"artist_id": schema.Int64Attribute{
+							MarkdownDescription: "Artist ID.",
+							Computed:            true,
+						},
+						"album_id": schema.Int64Attribute{
+							MarkdownDescription: "Album ID.",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Release title.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Download client status.",
+							Computed:            true,
+						},
+						"tracked_download_status": schema.StringAttribute{
+							MarkdownDescription: "Tracked download status.",
+							Computed:            true,
+						},
+						"tracked_download_state": schema.StringAttribute{
+							MarkdownDescription: "Tracked download state.",
+							Computed:            true,
+						},
+						"protocol": schema.StringAttribute{
+							MarkdownDescription: "Protocol.",
+							Computed:            true,
+						},
+						"download_client": schema.StringAttribute{
+							MarkdownDescription: "Download client name.",
+							Computed:            true,
+						},
+						"download_id": schema.StringAttribute{
+							MarkdownDescription: "Download ID in the download client.",
+							Computed:            true,
+						},
+						"indexer": schema.StringAttribute{
+							MarkdownDescription: "Indexer name.",
+							Computed:            true,
+						},
+						"error_message": schema.StringAttribute{
+							MarkdownDescription: "Error message.",
+							Computed:            true,
+						},
+						"timeleft": schema.StringAttribute{
+							MarkdownDescription: "Time left.",
+							Computed:            true,
+						},
+						"added": schema.StringAttribute{
+							MarkdownDescription: "Added time.",
+							Computed:            true,
+						},
+						"size": schema.Float64Attribute{
+							MarkdownDescription: "Size in bytes.",
+							Computed:            true,
+						},
+						"sizeleft": schema.Float64Attribute{
+							MarkdownDescription: "Size left in bytes.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *QueueDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *QueueDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *Queue
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get queue current value
+	response, err := d.getQueue(data)
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, queueDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+queueDataSourceName)
+	// Map response body to resource schema attribute
+	records := make([]QueueItem, len(response.GetRecords()))
+	for i, q := range response.GetRecords() {
+		records[i].write(&q)
+	}
+
+	recordList, diags := types.ListValueFrom(ctx, QueueItem{}.getType(), records)
+	resp.Diagnostics.Append(diags...)
+
+	data.Records = recordList
+	data.TotalRecords = types.Int64Value(int64(response.GetTotalRecords()))
+	data.ID = types.StringValue(fmt.Sprintf("%d/%d", response.GetPage(), response.GetPageSize()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// getQueue fetches a single page of the queue, passing every filter to Lidarr.
+func (d *QueueDataSource) getQueue(queue *Queue) (*lidarr.QueueResourcePagingResource, error) {
+	request := d.client.QueueAPI.GetQueue(d.auth).
+		Page(int32(valueOrDefault(queue.Page, 1))).
+		PageSize(int32(valueOrDefault(queue.PageSize, queuePageSize))).
+		IncludeUnknownArtistItems(true)
+
+	if !queue.SortKey.IsNull() {
+		request = request.SortKey(queue.SortKey.ValueString())
+	}
+
+	if !queue.SortDirection.IsNull() {
+		request = request.SortDirection(lidarr.SortDirection(queue.SortDirection.ValueString()))
+	}
+
+	if !queue.Protocol.IsNull() {
+		request = request.Protocol(lidarr.DownloadProtocol(queue.Protocol.ValueString()))
+	}
+
+	response, _, err := request.Execute()
+
+	return response, err
+}
+
+func (q *QueueItem) write(item *lidarr.QueueResource) {
+	q.ID = types.Int64Value(int64(item.GetId()))
+	q.ArtistID = types.Int64Value(int64(item.GetArtistId()))
+	q.AlbumID = types.Int64Value(int64(item.GetAlbumId()))
+	q.Title = types.StringValue(item.GetTitle())
+	q.Status = types.StringValue(item.GetStatus())
+	q.TrackedDownloadStatus = types.StringValue(string(item.GetTrackedDownloadStatus()))
+	q.TrackedDownloadState = types.StringValue(string(item.GetTrackedDownloadState()))
+	q.Protocol = types.StringValue(string(item.GetProtocol()))
+	q.DownloadClient = types.StringValue(item.GetDownloadClient())
+	q.DownloadID = types.StringValue(item.GetDownloadId())
+	q.Indexer = types.StringValue(item.GetIndexer())
+	q.ErrorMessage = types.StringValue(item.GetErrorMessage())
+	q.Timeleft = types.StringValue(item.GetTimeleft())
+	q.Added = types.StringValue(item.GetAdded().String())
+	q.Size = types.Float64Value(item.GetSize())
+	q.Sizeleft = types.Float64Value(item.GetSizeleft())
+}
@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccQueueDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccQueueDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccQueueDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lidarr_queue.test", "id", "1/10"),
+					resource.TestCheckResourceAttrSet("data.lidarr_queue.test", "total_records"),
+				),
+			},
+		},
+	})
+}
+
+const testAccQueueDataSourceConfig = `
+data "lidarr_queue" "test" {
+	page_size = 10
+	sort_key = "timeleft"
+	sort_direction = "ascending"
+	protocol = "torrent"
+}
+`
+
+func TestQueueDataSourceGetQueue(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		queue    Queue
+		expected map[string]string
+		count    int
+	}{
+		"defaults": {
+			queue: Queue{
+				Page:          types.Int64Null(),
+				PageSize:      types.Int64Null(),
+				SortKey:       types.StringNull(),
+				SortDirection: types.StringNull(),
+				Protocol:      types.StringNull(),
+			},
+			expected: map[string]string{"page": "1", "pageSize": "100", "sortKey": "", "sortDirection": "", "protocol": ""},
+			count:    100,
+		},
+		"filters": {
+			queue: Queue{
+				Page:          types.Int64Value(3),
+				PageSize:      types.Int64Value(50),
+				SortKey:       types.StringValue("title"),
+				SortDirection: types.StringValue("descending"),
+				Protocol:      types.StringValue("usenet"),
+			},
+			expected: map[string]string{"page": "3", "pageSize": "50", "sortKey": "title", "sortDirection": "descending", "protocol": "usenet"},
+			count:    50,
+		},
+		"last page": {
+			queue: Queue{
+				Page:          types.Int64Value(3),
+				PageSize:      types.Int64Value(1000),
+				SortKey:       types.StringNull(),
+				SortDirection: types.StringNull(),
+				Protocol:      types.StringNull(),
+			},
+			expected: map[string]string{"page": "3", "pageSize": "1000", "sortKey": "", "sortDirection": "", "protocol": ""},
+			count:    500,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			const total = 2500
+
+			// fake Lidarr serving the requested page of a large queue
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				query := r.URL.Query()
+				for key, value := range test.expected {
+					assert.Equal(t, value, query.Get(key), key)
+				}
+
+				page, _ := strconv.Atoi(query.Get("page"))
+				pageSize, _ := strconv.Atoi(query.Get("pageSize"))
+				records := make([]lidarr.QueueResource, 0, pageSize)
+
+				for id := (page-1)*pageSize + 1; id <= min(page*pageSize, total); id++ {
+					record := lidarr.NewQueueResource()
+					record.SetId(int32(id))
+					records = append(records, *record)
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{"page": page, "pageSize": pageSize, "totalRecords": total, "records": records})
+			}))
+			defer server.Close()
+
+			config := lidarr.NewConfiguration()
+			config.Servers[0].URL = server.URL
+			d := QueueDataSource{client: lidarr.NewAPIClient(config), auth: context.Background()}
+
+			response, err := d.getQueue(&test.queue)
+			assert.NoError(t, err)
+			assert.Len(t, response.GetRecords(), test.count)
+			assert.Equal(t, int32(total), response.GetTotalRecords())
+		})
+	}
+}
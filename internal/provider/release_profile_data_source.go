@@ -107,5 +107,5 @@ func (p *ReleaseProfile) find(ctx context.Context, id int64, profiles []lidarr.R
 		}
 	}
 
-	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(releaseProfileDataSourceName, "id", strconv.Itoa(int(id))))
+	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(releaseProfileDataSourceName, "id", strconv.Itoa(int(id)), helpers.Values(profiles, (*lidarr.ReleaseProfileResource).GetId)))
 }
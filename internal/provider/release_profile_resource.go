@@ -37,6 +37,14 @@ type ReleaseProfileResource struct {
 	auth   context.Context
 }
 
+// ReleaseProfileResourceData describes the release profile resource data model.
+// It extends the release profile data model with attributes not returned by Lidarr.
+type ReleaseProfileResourceData struct {
+	ReleaseProfile
+	TagLabels
+	SkipDefaultTags types.Bool `tfsdk:"skip_default_tags"`
+}
+
 // ReleaseProfile describes the release profile data model.
 type ReleaseProfile struct {
 	Tags      types.Set   `tfsdk:"tags"`
@@ -105,6 +113,9 @@ func (r *ReleaseProfileResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"tag_labels":          tagLabelsAttribute(),
+			"create_missing_tags": createMissingTagsAttribute(),
+			"skip_default_tags":   skipDefaultTagsAttribute(),
 		},
 	}
 }
@@ -118,7 +129,7 @@ func (r *ReleaseProfileResource) Configure(ctx context.Context, req resource.Con
 
 func (r *ReleaseProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var profile *ReleaseProfile
+	var profile *ReleaseProfileResourceData
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &profile)...)
 
@@ -126,8 +137,20 @@ func (r *ReleaseProfileResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, profile.TagLabels, &profile.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Build Create resource
 	request := profile.read(ctx, &resp.Diagnostics)
+	applyDefaultTags(r.auth, request, profile.SkipDefaultTags)
+
+	if !resolver.validate(releaseProfileResourceName, request, &resp.Diagnostics) {
+		return
+	}
 
 	// Create new ReleaseProfile
 	response, _, err := r.client.ReleaseProfileAPI.CreateReleaseProfile(r.auth).ReleaseProfileResource(*request).Execute()
@@ -137,15 +160,19 @@ func (r *ReleaseProfileResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	response = helpers.ReadAfterCreate(ctx, response, releaseProfileResourceName, r.client.ReleaseProfileAPI.GetReleaseProfileById(r.auth, response.GetId()).Execute, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created"+releaseProfileResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, profile.Tags, profile.SkipDefaultTags)
 	profile.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &profile.TagLabels, profile.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &profile)...)
 }
 
 func (r *ReleaseProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var profile *ReleaseProfile
+	var profile *ReleaseProfileResourceData
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &profile)...)
 
@@ -154,8 +181,15 @@ func (r *ReleaseProfileResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	// Get releaseprofile current value
-	response, _, err := r.client.ReleaseProfileAPI.GetReleaseProfileById(r.auth, int32(profile.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ReleaseProfileAPI.GetReleaseProfileById(r.auth, int32(profile.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			resp.Diagnostics.AddWarning(helpers.ResourceNotFound, helpers.ParseResourceNotFound(releaseProfileResourceName, profile.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, releaseProfileResourceName, err))
 
 		return
@@ -163,13 +197,15 @@ func (r *ReleaseProfileResource) Read(ctx context.Context, req resource.ReadRequ
 
 	tflog.Trace(ctx, "read "+releaseProfileResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
+	removeDefaultTags(ctx, r.auth, response, profile.Tags, profile.SkipDefaultTags)
 	profile.write(ctx, response, &resp.Diagnostics)
+	newTagResolver(r.auth, r.client).write(ctx, &profile.TagLabels, profile.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &profile)...)
 }
 
 func (r *ReleaseProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var profile *ReleaseProfile
+	var profile *ReleaseProfileResourceData
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &profile)...)
 
@@ -177,8 +213,20 @@ func (r *ReleaseProfileResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	resolver := newTagResolver(r.auth, r.client)
+	resolver.resolve(ctx, profile.TagLabels, &profile.Tags, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Build Update resource
 	request := profile.read(ctx, &resp.Diagnostics)
+	applyDefaultTags(r.auth, request, profile.SkipDefaultTags)
+
+	if !resolver.validate(releaseProfileResourceName, request, &resp.Diagnostics) {
+		return
+	}
 
 	// Update ReleaseProfile
 	response, _, err := r.client.ReleaseProfileAPI.UpdateReleaseProfile(r.auth, strconv.Itoa(int(request.GetId()))).ReleaseProfileResource(*request).Execute()
@@ -190,7 +238,9 @@ func (r *ReleaseProfileResource) Update(ctx context.Context, req resource.Update
 
 	tflog.Trace(ctx, "updated "+releaseProfileResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
+	removeDefaultTags(ctx, r.auth, response, profile.Tags, profile.SkipDefaultTags)
 	profile.write(ctx, response, &resp.Diagnostics)
+	resolver.write(ctx, &profile.TagLabels, profile.Tags, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &profile)...)
 }
 
@@ -51,6 +51,43 @@ func TestAccReleaseProfileResource(t *testing.T) {
 	})
 }
 
+func TestAccReleaseProfileResourceTagLabels(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Missing tag ID
+			{
+				Config:      testAccReleaseProfileResourceMissingTagConfig,
+				ExpectError: regexp.MustCompile("do not exist in Lidarr"),
+			},
+			// Missing tag
+			{
+				Config:      testAccReleaseProfileResourceLabelsConfig("false"),
+				ExpectError: regexp.MustCompile("do not exist"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccReleaseProfileResourceLabelsConfig("true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lidarr_release_profile.labels", "tag_labels.0", "ReleaseLabel"),
+					resource.TestCheckResourceAttr("lidarr_release_profile.labels", "tags.#", "1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "lidarr_release_profile.labels",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"tag_labels", "create_missing_tags"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
 func testAccReleaseProfileResourceConfig(required string) string {
 	return fmt.Sprintf(`
 	resource "lidarr_release_profile" "test" {
@@ -59,3 +96,22 @@ func testAccReleaseProfileResourceConfig(required string) string {
 		required = [%s]
 	}`, required)
 }
+
+func testAccReleaseProfileResourceLabelsConfig(create string) string {
+	return fmt.Sprintf(`
+	resource "lidarr_release_profile" "labels" {
+		enabled = true
+		indexer_id = 0
+		required = ["labels"]
+		tag_labels = ["ReleaseLabel"]
+		create_missing_tags = %s
+	}`, create)
+}
+
+const testAccReleaseProfileResourceMissingTagConfig = `
+	resource "lidarr_release_profile" "labels" {
+		enabled = true
+		indexer_id = 0
+		required = ["labels"]
+		tags = [9999]
+	}`
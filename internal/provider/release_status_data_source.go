@@ -92,5 +92,5 @@ func (e *MetadataProfileElement) find(name string, types []lidarr.ProfileRelease
 		}
 	}
 
-	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(releaseStatusDataSourceName, "name", name))
+	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(releaseStatusDataSourceName, "name", name, helpers.Values(types, func(t *lidarr.ProfileReleaseStatusItemResource) string { return t.ReleaseStatus.GetName() })))
 }
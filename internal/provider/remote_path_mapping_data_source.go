@@ -94,5 +94,5 @@ func (r *RemotePathMapping) find(id int64, mappings []lidarr.RemotePathMappingRe
 		}
 	}
 
-	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(remotePathMappingDataSourceName, "id", strconv.Itoa(int(id))))
+	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(remotePathMappingDataSourceName, "id", strconv.Itoa(int(id)), helpers.Values(mappings, (*lidarr.RemotePathMappingResource).GetId)))
 }
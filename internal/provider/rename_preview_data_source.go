@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const renamePreviewDataSourceName = "rename_preview"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RenamePreviewDataSource{}
+
+func NewRenamePreviewDataSource() datasource.DataSource {
+	return &RenamePreviewDataSource{}
+}
+
+// RenamePreviewDataSource defines the rename preview implementation.
+type RenamePreviewDataSource struct {
+	client *lidarr.APIClient
+	auth   context.Context
+}
+
+// RenamePreview describes the rename preview data model.
+type RenamePreview struct {
+	Renames  types.List   `tfsdk:"renames"`
+	ID       types.String `tfsdk:"id"`
+	ArtistID types.Int64  `tfsdk:"artist_id"`
+	AlbumID  types.Int64  `tfsdk:"album_id"`
+}
+
+// RenamePreviewItem describes a single track file rename.
+type RenamePreviewItem struct {
+	TrackNumbers types.List   `tfsdk:"track_numbers"`
+	ExistingPath types.String `tfsdk:"existing_path"`
+	NewPath      types.String `tfsdk:"new_path"`
+	AlbumID      types.Int64  `tfsdk:"album_id"`
+	TrackFileID  types.Int64  `tfsdk:"track_file_id"`
+}
+
+func (r RenamePreviewItem) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"track_numbers": types.ListType{ElemType: types.Int64Type},
+			"existing_path": types.StringType,
+			"new_path":      types.StringType,
+			"album_id":      types.Int64Type,
+			"track_file_id": types.Int64Type,
+		})
+}
+
+func (d *RenamePreviewDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + renamePreviewDataSourceName
+}
+
+func (d *RenamePreviewDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the delay server.
+		MarkdownDescription: "<!-- subcategory:Media Management -->\nPreview the track files Lidarr would rename for an artist, ordered by existing path.\nFor more information refer to [Naming](https://wiki.servarr.com/lidarr/settings#track-naming) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"artist_id": schema.Int64Attribute{
+				MarkdownDescription: "Artist ID.",
+				Required:            true,
+			},
+			"album_id": schema.Int64Attribute{
+				MarkdownDescription: "Album ID, to only preview the renames of a single album.",
+				Optional:            true,
+			},
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"renames": schema.ListNestedAttribute{
+				MarkdownDescription: "Rename list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"existing_path": schema.StringAttribute{
+							MarkdownDescription: "Current track file path.",
+							Computed:            true,
+						},
+						"new_path": schema.StringAttribute{
+							MarkdownDescription: "Track file path after the rename.",
+							Computed:            true,
+						},
+						"album_id": schema.Int64Attribute{
+							MarkdownDescription: "Album ID.",
+							Computed:            true,
+						},
+						"track_file_id": schema.Int64Attribute{
+							MarkdownDescription: "Track file ID.",
+							Computed:            true,
+						},
+						"track_numbers": schema.ListAttribute{
+							MarkdownDescription: "Track numbers.",
+							Computed:            true,
+							ElementType:         types.Int64Type,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RenamePreviewDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *RenamePreviewDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var preview *RenamePreview
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &preview)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the renames, the endpoint is not paginated and returns every track file at once
+	request := d.client.RenameTrackAPI.ListRename(d.auth).ArtistId(int32(preview.ArtistID.ValueInt64()))
+	if !preview.AlbumID.IsNull() {
+		request = request.AlbumId(int32(preview.AlbumID.ValueInt64()))
+	}
+
+	response, _, err := request.Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, renamePreviewDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+renamePreviewDataSourceName)
+	// Map response body to resource schema attribute
+	sortRenames(response)
+
+	renames := make([]RenamePreviewItem, len(response))
+	for i, r := range response {
+		renames[i].write(ctx, &r)
+	}
+
+	renameList, diags := types.ListValueFrom(ctx, RenamePreviewItem{}.getType(), renames)
+	resp.Diagnostics.Append(diags...)
+
+	preview.Renames = renameList
+	preview.ID = types.StringValue(strconv.Itoa(int(preview.ArtistID.ValueInt64())))
+
+	if !preview.AlbumID.IsNull() {
+		preview.ID = types.StringValue(fmt.Sprintf("%d/%d", preview.ArtistID.ValueInt64(), preview.AlbumID.ValueInt64()))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &preview)...)
+}
+
+func (r *RenamePreviewItem) write(ctx context.Context, rename *lidarr.RenameTrackResource) {
+	r.ExistingPath = types.StringValue(rename.GetExistingPath())
+	r.NewPath = types.StringValue(rename.GetNewPath())
+	r.AlbumID = types.Int64Value(int64(rename.GetAlbumId()))
+	r.TrackFileID = types.Int64Value(int64(rename.GetTrackFileId()))
+	r.TrackNumbers, _ = types.ListValueFrom(ctx, types.Int64Type, rename.GetTrackNumbers())
+}
+
+// sortRenames orders the renames by existing path, so that the output is stable across reads.
+func sortRenames(renames []lidarr.RenameTrackResource) {
+	sort.SliceStable(renames, func(i, j int) bool {
+		if renames[i].GetExistingPath() != renames[j].GetExistingPath() {
+			return renames[i].GetExistingPath() < renames[j].GetExistingPath()
+		}
+
+		return renames[i].GetTrackFileId() < renames[j].GetTrackFileId()
+	})
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccRenamePreviewDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccRenamePreviewDataSourceConfig("1") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing, the test artist has no files
+			{
+				Config: testAccArtistResourceConfig("Ludwig van Beethoven", "Ludwig_Van_Beethoven", "1f9df192-a621-4f54-8850-2c5373b7eac9") + testAccRenamePreviewDataSourceConfig("lidarr_artist.test.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.lidarr_rename_preview.test", "id"),
+					resource.TestCheckResourceAttr("data.lidarr_rename_preview.test", "renames.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRenamePreviewDataSourceConfig(artistID string) string {
+	return `
+	data "lidarr_rename_preview" "test" {
+		artist_id = ` + artistID + `
+	}
+	`
+}
+
+func TestSortRenames(t *testing.T) {
+	t.Parallel()
+
+	rename := func(id int32, existingPath string) lidarr.RenameTrackResource {
+		r := lidarr.NewRenameTrackResource()
+		r.SetTrackFileId(id)
+		r.SetExistingPath(existingPath)
+
+		return *r
+	}
+
+	renames := []lidarr.RenameTrackResource{
+		rename(3, "/music/b/01.flac"),
+		rename(2, "/music/a/02.flac"),
+		rename(5, "/music/a/01.flac"),
+		rename(1, "/music/a/01.flac"),
+	}
+	sortRenames(renames)
+
+	ids := make([]int32, len(renames))
+	for i, r := range renames {
+		ids[i] = r.GetTrackFileId()
+	}
+
+	assert.Equal(t, []int32{1, 5, 2, 3}, ids)
+}
@@ -0,0 +1,220 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	restartResourceName   = "restart"
+	defaultRestartTimeout = "5m"
+	restartPollInterval   = 2 * time.Second
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RestartResource{}
+
+func NewRestartResource() resource.Resource {
+	return &RestartResource{}
+}
+
+// RestartResource defines the restart implementation.
+type RestartResource struct {
+	client *lidarr.APIClient
+	auth   context.Context
+}
+
+// Restart describes the restart data model.
+type Restart struct {
+	Triggers       types.Map    `tfsdk:"triggers"`
+	Timeout        types.String `tfsdk:"timeout"`
+	StartTime      types.String `tfsdk:"start_time"`
+	ID             types.String `tfsdk:"id"`
+	WaitForRestart types.Bool   `tfsdk:"wait_for_restart"`
+}
+
+func (r *RestartResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + restartResourceName
+}
+
+func (r *RestartResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:System -->\nRestart resource. Restarts Lidarr on creation, changing `triggers` restarts it again. Use `depends_on` to restart after host changes, e.g. `lidarr_host`. Destroying the resource only removes it from state.\nFor more information refer to [Status](https://wiki.servarr.com/lidarr/system#status) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values which restart Lidarr again when changed.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_for_restart": schema.BoolAttribute{
+				MarkdownDescription: "Wait for Lidarr to be back with a new start time. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"timeout": schema.StringAttribute{
+				MarkdownDescription: "Maximum time to wait for the restart, as a duration string such as `30s` or `2m`. Reaching it only reports a warning. Defaults to `" + defaultRestartTimeout + "`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(defaultRestartTimeout),
+				Validators: []validator.String{
+					helpers.Duration(),
+				},
+			},
+			"start_time": schema.StringAttribute{
+				MarkdownDescription: "Lidarr start time after the restart, null when not waiting for it or when Lidarr is not back before the timeout.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Restart request time.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *RestartResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+		r.auth = auth
+	}
+}
+
+func (r *RestartResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var restart *Restart
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &restart)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, err := time.ParseDuration(restart.Timeout.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ResourceError, fmt.Sprintf("Unable to parse timeout, got error: %s", err))
+
+		return
+	}
+
+	// The previous start time tells the restarted instance apart
+	status, _, err := r.client.SystemAPI.GetSystemStatus(r.auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, restartResourceName, err))
+
+		return
+	}
+
+	requested := time.Now().UTC()
+
+	if _, err = r.client.SystemAPI.CreateSystemRestart(r.auth).Execute(); err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, restartResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "created "+restartResourceName+": "+requested.String())
+
+	restart.ID = types.StringValue(requested.String())
+	restart.StartTime = types.StringNull()
+
+	if restart.WaitForRestart.ValueBool() {
+		auth, cancel := context.WithTimeout(r.auth, timeout)
+		defer cancel()
+
+		// The restart already happened, keep it in state even if Lidarr is not back in time
+		status, err = waitForRestart(auth, r.client, status.GetStartTime(), restartPollInterval)
+		if err != nil {
+			resp.Diagnostics.AddWarning(helpers.ClientError, fmt.Sprintf("Lidarr restart was requested, unable to confirm it completed, got error: %s", err))
+		} else {
+			restart.StartTime = types.StringValue(status.GetStartTime().String())
+			tflog.Trace(ctx, "restarted "+restartResourceName+": "+restart.StartTime.ValueString())
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &restart)...)
+}
+
+func (r *RestartResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// A restart cannot be read back, the state only records the last one
+	var restart *Restart
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &restart)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read "+restartResourceName+": "+restart.ID.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &restart)...)
+}
+
+func (r *RestartResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Only wait_for_restart and timeout can be updated, without restarting again
+	var restart *Restart
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &restart)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+restartResourceName+": "+restart.ID.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &restart)...)
+}
+
+func (r *RestartResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Restarts cannot be undone, only remove them from state
+	tflog.Trace(ctx, "deleted "+restartResourceName)
+	resp.State.RemoveResource(ctx)
+}
+
+// waitForRestart polls the system status until Lidarr answers with a start time after the previous one.
+// Failures are expected while Lidarr is down, the last one is reported on timeout.
+func waitForRestart(ctx context.Context, client *lidarr.APIClient, previous time.Time, interval time.Duration) (*lidarr.SystemResource, error) {
+	var last error
+
+	for {
+		status, _, err := client.SystemAPI.GetSystemStatus(ctx).Execute()
+		if err == nil && status.GetStartTime().After(previous) {
+			return status, nil
+		}
+
+		if err != nil {
+			last = err
+		}
+
+		select {
+		case <-ctx.Done():
+			if last != nil {
+				return nil, fmt.Errorf("lidarr did not restart in time: %w, last error: %w", ctx.Err(), last)
+			}
+
+			return nil, fmt.Errorf("lidarr did not restart in time: %w", ctx.Err())
+		case <-time.After(interval):
+		}
+	}
+}
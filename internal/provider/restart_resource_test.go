@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+// Not parallel: the other acceptance tests are paused while Lidarr restarts.
+func TestAccRestartResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccRestartResourceConfig("1") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccRestartResourceConfig("1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("lidarr_restart.test", "start_time"),
+					resource.TestCheckResourceAttrSet("lidarr_restart.test", "id"),
+				),
+			},
+			// Changing the triggers restarts again
+			{
+				Config: testAccRestartResourceConfig("2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lidarr_restart.test", "triggers.version", "2"),
+					resource.TestCheckResourceAttrSet("lidarr_restart.test", "start_time"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccRestartResourceConfig(version string) string {
+	return fmt.Sprintf(`
+	resource "lidarr_restart" "test" {
+		triggers = {
+			version = "%s"
+		}
+		timeout = "3m"
+	}`, version)
+}
+
+func TestWaitForRestart(t *testing.T) {
+	t.Parallel()
+
+	previous := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		started time.Time
+		calls   int32
+		err     bool
+	}{
+		"restarted": {
+			started: previous.Add(time.Minute),
+			calls:   4,
+		},
+		"not restarted": {
+			started: previous,
+			err:     true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls int32
+
+			// fake Lidarr still running for the first request, then down while restarting
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				started := test.started
+
+				switch atomic.AddInt32(&calls, 1) {
+				case 1:
+					started = previous
+				case 2, 3:
+					w.WriteHeader(http.StatusServiceUnavailable)
+
+					return
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = fmt.Fprintf(w, `{"version":"2.0.0","startTime":"%s"}`, started.Format(time.RFC3339))
+			}))
+			defer server.Close()
+
+			config := lidarr.NewConfiguration()
+			config.Servers[0].URL = server.URL
+			client := lidarr.NewAPIClient(config)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+
+			status, err := waitForRestart(ctx, client, previous, time.Millisecond)
+			assert.Equal(t, test.err, err != nil)
+
+			if test.err {
+				assert.Contains(t, err.Error(), "did not restart in time")
+			} else {
+				assert.Equal(t, test.calls, atomic.LoadInt32(&calls))
+				assert.True(t, status.GetStartTime().Equal(test.started))
+			}
+		})
+	}
+}
+
+func TestRestartResourceCreateTimeout(t *testing.T) {
+	t.Parallel()
+
+	// fake Lidarr never coming back with a new start time
+	data, _ := testFixtureProvider(t, map[string]string{
+		"GET /api/v1/system/status":   "system_status.json",
+		"POST /api/v1/system/restart": "system_restart.json",
+	})
+
+	ctx := context.Background()
+	r := NewRestartResource()
+	state := testResourceState(t, r, data)
+	plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw.Copy()}
+
+	assert.False(t, plan.Set(ctx, &Restart{
+		Triggers:       types.MapNull(types.StringType),
+		Timeout:        types.StringValue("10ms"),
+		StartTime:      types.StringUnknown(),
+		ID:             types.StringUnknown(),
+		WaitForRestart: types.BoolValue(true),
+	}).HasError())
+
+	resp := fwresource.CreateResponse{State: state}
+	r.Create(ctx, fwresource.CreateRequest{Plan: plan}, &resp)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Equal(t, 1, resp.Diagnostics.WarningsCount())
+	assert.Contains(t, resp.Diagnostics.Warnings()[0].Detail(), "did not restart in time")
+
+	var restart Restart
+
+	assert.False(t, resp.State.Get(ctx, &restart).HasError())
+	assert.NotEmpty(t, restart.ID.ValueString())
+	assert.True(t, restart.StartTime.IsNull())
+}
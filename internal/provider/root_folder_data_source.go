@@ -116,5 +116,5 @@ func (r *RootFolder) find(ctx context.Context, path string, folders []lidarr.Roo
 		}
 	}
 
-	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(rootFolderDataSourceName, "path", path))
+	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(rootFolderDataSourceName, "path", path, helpers.Values(folders, (*lidarr.RootFolderResource).GetPath)))
 }
@@ -38,6 +38,13 @@ type RootFolderResource struct {
 	auth   context.Context
 }
 
+// RootFolderResourceData describes the root folder resource data model.
+// It extends the root folder data model with attributes not stored in Lidarr.
+type RootFolderResourceData struct {
+	RootFolder
+	DeletionProtection types.Bool `tfsdk:"deletion_protection"`
+}
+
 // RootFolder describes the root folder data model.
 type RootFolder struct {
 	Tags                 types.Set    `tfsdk:"tags"`
@@ -74,6 +81,7 @@ func (r *RootFolderResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Media Management -->\nRoot Folder resource.\nFor more information refer to [Root Folders](https://wiki.servarr.com/lidarr/settings#root-folders) documentation.",
 		Attributes: map[string]schema.Attribute{
+			"deletion_protection": helpers.DeletionProtectionAttribute(),
 			"path": schema.StringAttribute{
 				MarkdownDescription: "Root Folder absolute path.",
 				Required:            true,
@@ -137,7 +145,7 @@ func (r *RootFolderResource) Configure(ctx context.Context, req resource.Configu
 
 func (r *RootFolderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var folder *RootFolder
+	var folder *RootFolderResourceData
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &folder)...)
 
@@ -155,6 +163,8 @@ func (r *RootFolderResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	response = helpers.ReadAfterCreate(ctx, response, rootFolderResourceName, r.client.RootFolderAPI.GetRootFolderById(r.auth, response.GetId()).Execute, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created "+rootFolderResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	folder.write(ctx, response, &resp.Diagnostics)
@@ -163,7 +173,7 @@ func (r *RootFolderResource) Create(ctx context.Context, req resource.CreateRequ
 
 func (r *RootFolderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var folder *RootFolder
+	var folder *RootFolderResourceData
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &folder)...)
 
@@ -172,8 +182,15 @@ func (r *RootFolderResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	// Get rootFolder current value
-	response, _, err := r.client.RootFolderAPI.GetRootFolderById(r.auth, int32(folder.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.RootFolderAPI.GetRootFolderById(r.auth, int32(folder.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			resp.Diagnostics.AddWarning(helpers.ResourceNotFound, helpers.ParseResourceNotFound(rootFolderResourceName, folder.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, rootFolderResourceName, err))
 
 		return
@@ -187,7 +204,7 @@ func (r *RootFolderResource) Read(ctx context.Context, req resource.ReadRequest,
 
 func (r *RootFolderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var folder *RootFolder
+	var folder *RootFolderResourceData
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &folder)...)
 
@@ -220,6 +237,10 @@ func (r *RootFolderResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	if helpers.DeletionProtected(ctx, req.State, rootFolderResourceName, &resp.Diagnostics) {
+		return
+	}
+
 	// Delete rootFolder current value
 	_, err := r.client.RootFolderAPI.DeleteRootFolder(r.auth, int32(ID)).Execute()
 	if err != nil {
@@ -234,6 +255,7 @@ func (r *RootFolderResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 func (r *RootFolderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deletion_protection"), false)...)
 	tflog.Trace(ctx, "imported "+rootFolderResourceName+": "+req.ID)
 }
 
@@ -91,5 +91,5 @@ func (e *MetadataProfileElement) findSecondary(name string, types []lidarr.Profi
 		}
 	}
 
-	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(secondaryAlbumTypeDataSourceName, "name", name))
+	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(secondaryAlbumTypeDataSourceName, "name", name, helpers.Values(types, func(t *lidarr.ProfileSecondaryAlbumTypeItemResource) string { return t.AlbumType.GetName() })))
 }
@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+// testSweepPrefixes are the name prefixes of the objects created by the acceptance tests.
+var testSweepPrefixes = []string{"resource", "datasource", "data"}
+
+// TestMain runs the sweepers with the -sweep flag, e.g. go test ./internal/provider -v -sweep=all,
+// and the tests otherwise.
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func init() {
+	resource.AddTestSweepers(notificationResourceName, &resource.Sweeper{
+		Name: notificationResourceName,
+		F: func(_ string) error {
+			client := testAccAPIClient()
+
+			return testSweep(
+				client.NotificationAPI.ListNotification(context.Background()).Execute,
+				(*lidarr.NotificationResource).GetName,
+				(*lidarr.NotificationResource).GetId,
+				func(id int32) error {
+					_, err := client.NotificationAPI.DeleteNotification(context.Background(), id).Execute()
+
+					return err
+				},
+			)
+		},
+	})
+
+	resource.AddTestSweepers(indexerResourceName, &resource.Sweeper{
+		Name: indexerResourceName,
+		F: func(_ string) error {
+			client := testAccAPIClient()
+
+			return testSweep(
+				client.IndexerAPI.ListIndexer(context.Background()).Execute,
+				(*lidarr.IndexerResource).GetName,
+				(*lidarr.IndexerResource).GetId,
+				func(id int32) error {
+					_, err := client.IndexerAPI.DeleteIndexer(context.Background(), id).Execute()
+
+					return err
+				},
+			)
+		},
+	})
+
+	resource.AddTestSweepers(downloadClientResourceName, &resource.Sweeper{
+		Name: downloadClientResourceName,
+		F: func(_ string) error {
+			client := testAccAPIClient()
+
+			return testSweep(
+				client.DownloadClientAPI.ListDownloadClient(context.Background()).Execute,
+				(*lidarr.DownloadClientResource).GetName,
+				(*lidarr.DownloadClientResource).GetId,
+				func(id int32) error {
+					_, err := client.DownloadClientAPI.DeleteDownloadClient(context.Background(), id).Execute()
+
+					return err
+				},
+			)
+		},
+	})
+}
+
+// testSweepName reports whether the given name was created by an acceptance test, e.g. resourceDiscordTest or filelistResourceTest.
+func testSweepName(name string) bool {
+	if !strings.HasSuffix(name, "Test") {
+		return false
+	}
+
+	if strings.HasSuffix(name, "ResourceTest") {
+		return true
+	}
+
+	for _, prefix := range testSweepPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// testSweep deletes the listed objects left over by acceptance tests, going on after failed deletions.
+func testSweep[T any, R any](list func() ([]T, R, error), name func(*T) string, id func(*T) int32, remove func(int32) error) error {
+	elements, _, err := list()
+	if err != nil {
+		return fmt.Errorf("error listing objects to sweep: %w", err)
+	}
+
+	var errs []error
+
+	for i := range elements {
+		if !testSweepName(name(&elements[i])) {
+			continue
+		}
+
+		if err := remove(id(&elements[i])); err != nil {
+			errs = append(errs, fmt.Errorf("error sweeping %s: %w", name(&elements[i]), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func TestSweep(t *testing.T) {
+	t.Parallel()
+
+	labels := []string{"resourceDiscordTest", "filelistResourceTest", "Discord", "resourceBroken", "datasourceTest"}
+	elements := make([]lidarr.TagResource, len(labels))
+
+	for i, label := range labels {
+		elements[i].SetId(int32(i + 1))
+		elements[i].SetLabel(label)
+	}
+
+	removed := make([]int32, 0)
+	err := testSweep(
+		func() ([]lidarr.TagResource, interface{}, error) { return elements, nil, nil },
+		(*lidarr.TagResource).GetLabel,
+		(*lidarr.TagResource).GetId,
+		func(id int32) error {
+			removed = append(removed, id)
+			if id == 5 {
+				return errors.New("in use")
+			}
+
+			return nil
+		},
+	)
+
+	assert.Equal(t, []int32{1, 2, 5}, removed)
+	assert.ErrorContains(t, err, "error sweeping datasourceTest: in use")
+}
@@ -2,19 +2,25 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/devopsarr/lidarr-go/lidarr"
 	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 const tagDataSourceName = "tag"
 
 // Ensure provider defined types fully satisfy framework interfaces.
-var _ datasource.DataSource = &TagDataSource{}
+var (
+	_ datasource.DataSource                     = &TagDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &TagDataSource{}
+)
 
 func NewTagDataSource() datasource.DataSource {
 	return &TagDataSource{}
@@ -37,16 +43,24 @@ func (d *TagDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, re
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Tag ID.",
+				Optional:            true,
 				Computed:            true,
 			},
 			"label": schema.StringAttribute{
-				MarkdownDescription: "Tag label.",
-				Required:            true,
+				MarkdownDescription: "Tag label, matched ignoring case and surrounding whitespace.",
+				Optional:            true,
+				Computed:            true,
 			},
 		},
 	}
 }
 
+func (d *TagDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		helpers.IDOrName("label"),
+	}
+}
+
 func (d *TagDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
 		d.client = client
@@ -71,20 +85,46 @@ func (d *TagDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 		return
 	}
 
-	data.find(data.Label.ValueString(), response, &resp.Diagnostics)
+	lookup := helpers.NewLookup(data.ID, "label", data.Label)
+	lookup.Normalize = normalizeTagLabel
+
+	data.find(lookup, response, &resp.Diagnostics)
 	tflog.Trace(ctx, "read "+tagDataSourceName)
 	// Map response body to resource schema attribute
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (t *Tag) find(label string, tags []lidarr.TagResource, diags *diag.Diagnostics) {
-	for _, tag := range tags {
-		if tag.GetLabel() == label {
-			t.write(&tag)
+func (t *Tag) find(lookup helpers.Lookup, tags []lidarr.TagResource, diags *diag.Diagnostics) {
+	var found *lidarr.TagResource
+
+	for i := range tags {
+		if !lookup.Matches(tags[i].GetId(), tags[i].GetLabel()) {
+			continue
+		}
+
+		if found != nil {
+			diags.AddError(helpers.DataSourceError, fmt.Sprintf("Unable to find %s, got error: multiple tags match label '%s': '%s' and '%s'", tagDataSourceName, lookup.Name, found.GetLabel(), tags[i].GetLabel()))
 
 			return
 		}
+
+		found = &tags[i]
+	}
+
+	if found == nil {
+		diags.AddError(helpers.DataSourceError, lookup.NotFound(tagDataSourceName, helpers.Values(tags, (*lidarr.TagResource).GetLabel)))
+
+		return
+	}
+
+	t.write(found)
+	// Keep the configured label, which may differ in case and surrounding whitespace
+	if !lookup.ByID {
+		t.Label = types.StringValue(lookup.Name)
 	}
+}
 
-	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(tagDataSourceName, "label", label))
+// normalizeTagLabel makes a label comparable with the lowercase ones stored by Lidarr.
+func normalizeTagLabel(label string) string {
+	return strings.ToLower(strings.TrimSpace(label))
 }
@@ -5,7 +5,12 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccTagDataSource(t *testing.T) {
@@ -37,6 +42,33 @@ func TestAccTagDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.lidarr_tag.test", "label", "tag_datasource"),
 				),
 			},
+			// Mixed case read testing
+			{
+				Config: testAccTagResourceConfig("test", "tag_datasource") + testAccTagDataSourceConfig("Tag_DataSource"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.lidarr_tag.test", "id", "lidarr_tag.test", "id"),
+					resource.TestCheckResourceAttr("data.lidarr_tag.test", "label", "Tag_DataSource"),
+				),
+			},
+			// Surrounding whitespace read testing
+			{
+				Config: testAccTagResourceConfig("test", "tag_datasource") + testAccTagDataSourceConfig(" Tag_DataSource "),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.lidarr_tag.test", "id", "lidarr_tag.test", "id"),
+				),
+			},
+			// Conflicting lookup testing
+			{
+				Config:      testAccTagDataSourceConflictConfig,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			// Read by ID testing
+			{
+				Config: testAccTagResourceConfig("test", "tag_datasource") + testAccTagDataSourceIDConfig("lidarr_tag.test.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lidarr_tag.test", "label", "tag_datasource"),
+				),
+			},
 		},
 	})
 }
@@ -48,3 +80,107 @@ func testAccTagDataSourceConfig(label string) string {
 	}
 	`, label)
 }
+
+const testAccTagDataSourceConflictConfig = `
+data "lidarr_tag" "test" {
+	id = 1
+	label = "error"
+}
+`
+
+func testAccTagDataSourceIDConfig(id string) string {
+	return fmt.Sprintf(`
+	data "lidarr_tag" "test" {
+		id = %s
+	}
+	`, id)
+}
+
+func TestTagFind(t *testing.T) {
+	t.Parallel()
+
+	tag := func(id int32, label string) lidarr.TagResource {
+		r := lidarr.NewTagResource()
+		r.SetId(id)
+		r.SetLabel(label)
+
+		return *r
+	}
+
+	tests := map[string]struct {
+		label    string
+		tags     []lidarr.TagResource
+		id       int64
+		expected int64
+		err      bool
+	}{
+		"exact": {
+			label:    "lossless",
+			tags:     []lidarr.TagResource{tag(1, "mp3"), tag(2, "lossless")},
+			expected: 2,
+		},
+		"mixed case": {
+			label:    "Lossless",
+			tags:     []lidarr.TagResource{tag(1, "mp3"), tag(2, "lossless")},
+			expected: 2,
+		},
+		"surrounding whitespace": {
+			label:    " Lossless\t",
+			tags:     []lidarr.TagResource{tag(2, "lossless")},
+			expected: 2,
+		},
+		"not found": {
+			label: "flac",
+			tags:  []lidarr.TagResource{tag(2, "lossless")},
+			err:   true,
+		},
+		"id": {
+			id:       1,
+			tags:     []lidarr.TagResource{tag(1, "mp3"), tag(2, "lossless")},
+			expected: 1,
+		},
+		"id not found": {
+			id:   3,
+			tags: []lidarr.TagResource{tag(1, "mp3"), tag(2, "lossless")},
+			err:  true,
+		},
+		"ambiguous": {
+			label: "lossless",
+			tags:  []lidarr.TagResource{tag(1, "Lossless"), tag(2, "lossless")},
+			err:   true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+
+			lookup := helpers.NewLookup(types.Int64Null(), "label", types.StringValue(test.label))
+			if test.id != 0 {
+				lookup = helpers.NewLookup(types.Int64Value(test.id), "label", types.StringNull())
+			}
+
+			lookup.Normalize = normalizeTagLabel
+
+			data := Tag{}
+			data.find(lookup, test.tags, &diags)
+			assert.Equal(t, test.err, diags.HasError())
+
+			if test.err {
+				return
+			}
+
+			assert.Equal(t, test.expected, data.ID.ValueInt64())
+
+			// the label of a tag looked up by ID comes from Lidarr
+			if test.id != 0 {
+				assert.Equal(t, "mp3", data.Label.ValueString())
+			} else {
+				assert.Equal(t, test.label, data.Label.ValueString())
+			}
+		})
+	}
+}
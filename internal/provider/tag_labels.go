@@ -0,0 +1,243 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TagLabels describes the attributes selecting the tags of a resource by label instead of ID.
+type TagLabels struct {
+	Labels        types.Set  `tfsdk:"tag_labels"`
+	CreateMissing types.Bool `tfsdk:"create_missing_tags"`
+}
+
+func tagLabelsAttribute() schema.SetAttribute {
+	return schema.SetAttribute{
+		MarkdownDescription: "List of associated tag labels, resolved to IDs on apply. Conflicts with `tags`.",
+		Optional:            true,
+		ElementType:         types.StringType,
+		Validators: []validator.Set{
+			setvalidator.ConflictsWith(path.MatchRoot("tags")),
+			setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+		},
+	}
+}
+
+func createMissingTagsAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		MarkdownDescription: "Create the tags of `tag_labels` missing in Lidarr instead of failing. Defaults to `false`.",
+		Optional:            true,
+		Validators: []validator.Bool{
+			boolvalidator.AlsoRequires(path.MatchRoot("tag_labels")),
+		},
+	}
+}
+
+func skipDefaultTagsAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		MarkdownDescription: "Do not add the provider `default_tag_ids` to this resource. Defaults to `false`.",
+		Optional:            true,
+	}
+}
+
+// tagResolver maps tag labels to IDs and back and validates tag IDs, listing the tags at most once per operation.
+type tagResolver struct {
+	client *lidarr.APIClient
+	auth   context.Context
+	tags   []lidarr.TagResource
+	listed bool
+}
+
+func newTagResolver(auth context.Context, client *lidarr.APIClient) *tagResolver {
+	return &tagResolver{
+		client: client,
+		auth:   auth,
+	}
+}
+
+func (r *tagResolver) list() ([]lidarr.TagResource, error) {
+	if r.listed {
+		return r.tags, nil
+	}
+
+	tags, _, err := r.client.TagAPI.ListTag(r.auth).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	r.tags = tags
+	r.listed = true
+
+	return r.tags, nil
+}
+
+func (r *tagResolver) create(label string) (*lidarr.TagResource, error) {
+	request := lidarr.NewTagResource()
+	request.SetLabel(label)
+
+	tag, _, err := r.client.TagAPI.CreateTag(r.auth).TagResource(*request).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	r.tags = append(r.tags, *tag)
+
+	return tag, nil
+}
+
+// resolve replaces tags with the IDs of the configured labels, creating the missing tags when allowed.
+func (r *tagResolver) resolve(ctx context.Context, labels TagLabels, tags *types.Set, diags *diag.Diagnostics) {
+	if labels.Labels.IsNull() || labels.Labels.IsUnknown() {
+		return
+	}
+
+	configured := make([]string, 0, len(labels.Labels.Elements()))
+	diags.Append(labels.Labels.ElementsAs(ctx, &configured, false)...)
+
+	existing, err := r.list()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, tagResourceName, err))
+
+		return
+	}
+
+	ids := make([]int32, 0, len(configured))
+	missing := make([]string, 0)
+
+	for _, label := range configured {
+		if tag := findTagByLabel(existing, label); tag != nil {
+			ids = append(ids, tag.GetId())
+
+			continue
+		}
+
+		if !labels.CreateMissing.ValueBool() {
+			missing = append(missing, label)
+
+			continue
+		}
+
+		tag, err := r.create(label)
+		if err != nil {
+			diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, tagResourceName, err))
+
+			return
+		}
+
+		existing = r.tags
+		ids = append(ids, tag.GetId())
+	}
+
+	if len(missing) > 0 {
+		diags.AddAttributeError(path.Root("tag_labels"), helpers.ResourceError,
+			fmt.Sprintf("Tags '%s' do not exist, set create_missing_tags to create them.", strings.Join(missing, "', '")))
+
+		return
+	}
+
+	var tagDiags diag.Diagnostics
+
+	*tags, tagDiags = types.SetValueFrom(ctx, types.Int64Type, ids)
+	diags.Append(tagDiags...)
+}
+
+// write maps tags back to labels when they are configured by label, keeping the configured spelling.
+func (r *tagResolver) write(ctx context.Context, labels *TagLabels, tags types.Set, diags *diag.Diagnostics) {
+	if labels.Labels.IsNull() {
+		return
+	}
+
+	configured := make([]string, 0, len(labels.Labels.Elements()))
+	if !labels.Labels.IsUnknown() {
+		diags.Append(labels.Labels.ElementsAs(ctx, &configured, false)...)
+	}
+
+	ids := make([]int32, 0, len(tags.Elements()))
+	diags.Append(tags.ElementsAs(ctx, &ids, false)...)
+
+	existing, err := r.list()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, tagResourceName, err))
+
+		return
+	}
+
+	result := make([]string, 0, len(ids))
+
+	for _, id := range ids {
+		label := fmt.Sprint(id)
+
+		for i := range existing {
+			if existing[i].GetId() == id {
+				label = existing[i].GetLabel()
+
+				break
+			}
+		}
+
+		for _, c := range configured {
+			if normalizeTagLabel(c) == normalizeTagLabel(label) {
+				label = c
+
+				break
+			}
+		}
+
+		result = append(result, label)
+	}
+
+	var labelDiags diag.Diagnostics
+
+	labels.Labels, labelDiags = types.SetValueFrom(ctx, types.StringType, result)
+	diags.Append(labelDiags...)
+}
+
+// validate checks that the tags of the request exist in Lidarr, unless skip_tag_validation is set.
+// Some endpoints silently drop unknown tags, which would otherwise show up as drift.
+func (r *tagResolver) validate(name string, request taggable, diags *diag.Diagnostics) bool {
+	if skip, _ := r.auth.Value(skipTagValidationKey{}).(bool); skip || len(request.GetTags()) == 0 {
+		return true
+	}
+
+	existing, err := r.list()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Validate, name, err))
+
+		return false
+	}
+
+	missing := slices.DeleteFunc(slices.Clone(request.GetTags()), func(id int32) bool {
+		return slices.ContainsFunc(existing, func(tag lidarr.TagResource) bool { return tag.GetId() == id })
+	})
+	if len(missing) > 0 {
+		diags.AddAttributeError(path.Root("tags"), helpers.ResourceError,
+			fmt.Sprintf("Tags %s do not exist in Lidarr. Remove them, or set the provider skip_tag_validation flag if they are created out of band.", joinInt32(missing)))
+
+		return false
+	}
+
+	return true
+}
+
+func findTagByLabel(tags []lidarr.TagResource, label string) *lidarr.TagResource {
+	for i := range tags {
+		if normalizeTagLabel(tags[i].GetLabel()) == normalizeTagLabel(label) {
+			return &tags[i]
+		}
+	}
+
+	return nil
+}
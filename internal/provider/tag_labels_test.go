@@ -0,0 +1,253 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTagLabelsServer fakes the Lidarr tag API, counting the list calls.
+func newTagLabelsServer(t *testing.T, lists *int32) *httptest.Server {
+	t.Helper()
+
+	tags := []map[string]interface{}{
+		{"id": 1, "label": "flac"},
+		{"id": 2, "label": "lossy"},
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method == http.MethodPost {
+			var tag map[string]interface{}
+
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&tag))
+
+			tag["id"] = 3
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(tag)
+
+			return
+		}
+
+		atomic.AddInt32(lists, 1)
+		_ = json.NewEncoder(w).Encode(tags)
+	}))
+}
+
+func stringSet(values ...string) types.Set {
+	elements := make([]attr.Value, len(values))
+	for i, v := range values {
+		elements[i] = types.StringValue(v)
+	}
+
+	return types.SetValueMust(types.StringType, elements)
+}
+
+func TestTagResolverResolve(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		labels   TagLabels
+		expected []int64
+		err      bool
+	}{
+		"not configured": {
+			labels: TagLabels{Labels: types.SetNull(types.StringType), CreateMissing: types.BoolNull()},
+		},
+		"existing": {
+			labels:   TagLabels{Labels: stringSet("FLAC", "lossy"), CreateMissing: types.BoolNull()},
+			expected: []int64{1, 2},
+		},
+		"missing": {
+			labels: TagLabels{Labels: stringSet("flac", "hires"), CreateMissing: types.BoolNull()},
+			err:    true,
+		},
+		"create missing": {
+			labels:   TagLabels{Labels: stringSet("flac", "hires"), CreateMissing: types.BoolValue(true)},
+			expected: []int64{1, 3},
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var lists int32
+
+			server := newTagLabelsServer(t, &lists)
+			defer server.Close()
+
+			config := lidarr.NewConfiguration()
+			config.Servers[0].URL = server.URL
+			resolver := newTagResolver(context.Background(), lidarr.NewAPIClient(config))
+
+			var diags diag.Diagnostics
+
+			tags := types.SetUnknown(types.Int64Type)
+			resolver.resolve(context.Background(), test.labels, &tags, &diags)
+			// resolving the same labels again must reuse the listed tags
+			resolver.resolve(context.Background(), test.labels, &tags, &diags)
+
+			assert.Equal(t, test.err, diags.HasError())
+			assert.LessOrEqual(t, lists, int32(1))
+
+			if test.expected == nil {
+				return
+			}
+
+			ids := make([]int64, 0)
+			diags.Append(tags.ElementsAs(context.Background(), &ids, false)...)
+			assert.ElementsMatch(t, test.expected, ids)
+		})
+	}
+}
+
+func TestTagResolverWrite(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		labels   types.Set
+		expected types.Set
+		tags     []int64
+	}{
+		"not configured": {
+			labels:   types.SetNull(types.StringType),
+			tags:     []int64{1},
+			expected: types.SetNull(types.StringType),
+		},
+		"configured spelling": {
+			labels:   stringSet("FLAC"),
+			tags:     []int64{1},
+			expected: stringSet("FLAC"),
+		},
+		"drift": {
+			labels:   stringSet("flac"),
+			tags:     []int64{1, 2, 9},
+			expected: stringSet("flac", "lossy", "9"),
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var lists int32
+
+			server := newTagLabelsServer(t, &lists)
+			defer server.Close()
+
+			config := lidarr.NewConfiguration()
+			config.Servers[0].URL = server.URL
+			resolver := newTagResolver(context.Background(), lidarr.NewAPIClient(config))
+
+			var diags diag.Diagnostics
+
+			labels := TagLabels{Labels: test.labels, CreateMissing: types.BoolNull()}
+			tags, _ := types.SetValueFrom(context.Background(), types.Int64Type, test.tags)
+			resolver.write(context.Background(), &labels, tags, &diags)
+
+			assert.False(t, diags.HasError())
+			assert.True(t, test.expected.Equal(labels.Labels), labels.Labels.String())
+		})
+	}
+}
+
+func TestTagResolverValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		tags  []int32
+		lists int32
+		skip  bool
+		valid bool
+	}{
+		"no tags": {
+			tags:  []int32{},
+			valid: true,
+		},
+		"existing": {
+			tags:  []int32{1, 2},
+			lists: 1,
+			valid: true,
+		},
+		"missing": {
+			tags:  []int32{1, 7},
+			lists: 1,
+		},
+		"skipped": {
+			tags:  []int32{7},
+			skip:  true,
+			valid: true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var lists int32
+
+			server := newTagLabelsServer(t, &lists)
+			defer server.Close()
+
+			config := lidarr.NewConfiguration()
+			config.Servers[0].URL = server.URL
+			auth := context.WithValue(context.Background(), skipTagValidationKey{}, test.skip)
+			resolver := newTagResolver(auth, lidarr.NewAPIClient(config))
+
+			var diags diag.Diagnostics
+
+			request := lidarr.NewReleaseProfileResource()
+			request.SetTags(test.tags)
+
+			assert.Equal(t, test.valid, resolver.validate(releaseProfileResourceName, request, &diags))
+			assert.Equal(t, !test.valid, diags.HasError())
+			assert.Equal(t, test.lists, lists)
+		})
+	}
+}
+
+func TestTagLabelsResources(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	families := []string{"lidarr_notification", "lidarr_indexer", "lidarr_download_client", "lidarr_import_list", "lidarr_artist", "lidarr_delay_profile", "lidarr_release_profile"}
+
+	// Every taggable resource of the families selecting tags by label exposes the label attributes.
+	for _, newResource := range (&LidarrProvider{}).Resources(ctx) {
+		r := newResource()
+
+		var (
+			metadata fwresource.MetadataResponse
+			response fwresource.SchemaResponse
+		)
+
+		r.Metadata(ctx, fwresource.MetadataRequest{ProviderTypeName: "lidarr"}, &metadata)
+		r.Schema(ctx, fwresource.SchemaRequest{}, &response)
+
+		if _, ok := response.Schema.Attributes["tags"]; !ok || !slices.ContainsFunc(families, func(family string) bool {
+			return strings.HasPrefix(metadata.TypeName, family)
+		}) {
+			continue
+		}
+
+		assert.Contains(t, response.Schema.Attributes, "tag_labels", metadata.TypeName)
+		assert.Contains(t, response.Schema.Attributes, "create_missing_tags", metadata.TypeName)
+	}
+}
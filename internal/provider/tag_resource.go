@@ -3,19 +3,17 @@ package provider
 import (
 	"context"
 	"fmt"
-	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/devopsarr/lidarr-go/lidarr"
 	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
-	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -61,14 +59,8 @@ func (r *TagResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 		MarkdownDescription: "<!-- subcategory:Tags -->\nTag resource.\nFor more information refer to [Tags](https://wiki.servarr.com/lidarr/settings#tags) documentation.",
 		Attributes: map[string]schema.Attribute{
 			"label": schema.StringAttribute{
-				MarkdownDescription: "Tag label. It must be lowercase.",
+				MarkdownDescription: "Tag label. Lidarr stores labels lowercase, so labels differing only by case are considered equal.",
 				Required:            true,
-				Validators: []validator.String{
-					stringvalidator.RegexMatches(
-						regexp.MustCompile(`^.*[^A-Z]+.*$`),
-						"String cannot contains uppercase values",
-					),
-				},
 			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Tag ID.",
@@ -108,6 +100,8 @@ func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
+	response = helpers.ReadAfterCreate(ctx, response, tagResourceName, r.client.TagAPI.GetTagById(r.auth, response.GetId()).Execute, &resp.Diagnostics)
+
 	tflog.Trace(ctx, "created tag: "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	tag.write(response)
@@ -125,8 +119,15 @@ func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	}
 
 	// Get tag current value
-	response, _, err := r.client.TagAPI.GetTagById(r.auth, int32(tag.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.TagAPI.GetTagById(r.auth, int32(tag.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			resp.Diagnostics.AddWarning(helpers.ResourceNotFound, helpers.ParseResourceNotFound(tagResourceName, tag.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, tagResourceName, err))
 
 		return
@@ -192,7 +193,11 @@ func (r *TagResource) ImportState(ctx context.Context, req resource.ImportStateR
 
 func (t *Tag) write(tag *lidarr.TagResource) {
 	t.ID = types.Int64Value(int64(tag.GetId()))
-	t.Label = types.StringValue(tag.GetLabel())
+
+	// Lidarr lowercases labels, keep the configured case to avoid a perpetual diff
+	if !strings.EqualFold(t.Label.ValueString(), tag.GetLabel()) {
+		t.Label = types.StringValue(tag.GetLabel())
+	}
 }
 
 func (t *Tag) read() *lidarr.TagResource {
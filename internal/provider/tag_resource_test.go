@@ -1,16 +1,22 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
 
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccTagResource(t *testing.T) {
 	t.Parallel()
 
+	var id int32
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -39,19 +45,90 @@ func TestAccTagResource(t *testing.T) {
 				Config: testAccTagResourceConfig("test", "hvec"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("lidarr_tag.test", "label", "hvec"),
+					testAccResourceID("lidarr_tag.test", &id),
+				),
+			},
+			// Mixed case testing
+			{
+				Config: testAccTagResourceConfig("test", "Lossless"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lidarr_tag.test", "label", "Lossless"),
+					testAccResourceID("lidarr_tag.test", &id),
+				),
+			},
+			// Out of band delete testing
+			{
+				PreConfig: func() {
+					if _, err := testAccAPIClient().TagAPI.DeleteTag(context.Background(), id).Execute(); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:             testAccTagResourceConfig("test", "Lossless"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Recreate testing
+			{
+				Config: testAccTagResourceConfig("test", "Lossless"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("lidarr_tag.test", "id"),
 				),
 			},
 			// ImportState testing
 			{
-				ResourceName:      "lidarr_tag.test",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "lidarr_tag.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"label"},
 			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
 }
 
+func TestTagWrite(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		configured types.String
+		label      string
+		expected   types.String
+	}{
+		"same": {
+			configured: types.StringValue("lossless"),
+			label:      "lossless",
+			expected:   types.StringValue("lossless"),
+		},
+		"mixed case": {
+			configured: types.StringValue("Lossless"),
+			label:      "lossless",
+			expected:   types.StringValue("Lossless"),
+		},
+		"changed": {
+			configured: types.StringValue("Lossless"),
+			label:      "lossy",
+			expected:   types.StringValue("lossy"),
+		},
+		"imported": {
+			configured: types.StringNull(),
+			label:      "lossless",
+			expected:   types.StringValue("lossless"),
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tag := Tag{Label: test.configured}
+			tag.write(&lidarr.TagResource{Id: lidarr.PtrInt32(1), Label: *lidarr.NewNullableString(&test.label)})
+			assert.Equal(t, test.expected, tag.Label)
+			assert.Equal(t, int64(1), tag.ID.ValueInt64())
+		})
+	}
+}
+
 func testAccTagResourceConfig(name, label string) string {
 	return fmt.Sprintf(`
 		resource "lidarr_tag" "%s" {
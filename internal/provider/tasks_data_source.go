@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/devopsarr/terraform-provider-lidarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const tasksDataSourceName = "tasks"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TasksDataSource{}
+
+func NewTasksDataSource() datasource.DataSource {
+	return &TasksDataSource{}
+}
+
+// TasksDataSource defines the tasks implementation.
+type TasksDataSource struct {
+	client *lidarr.APIClient
+	auth   context.Context
+}
+
+// Tasks describes the tasks data model.
+type Tasks struct {
+	Tasks types.List   `tfsdk:"tasks"`
+	ID    types.String `tfsdk:"id"`
+}
+
+// Task describes the scheduled task data model.
+type Task struct {
+	Name          types.String `tfsdk:"name"`
+	TaskName      types.String `tfsdk:"task_name"`
+	LastExecution types.String `tfsdk:"last_execution"`
+	LastStartTime types.String `tfsdk:"last_start_time"`
+	NextExecution types.String `tfsdk:"next_execution"`
+	LastDuration  types.String `tfsdk:"last_duration"`
+	ID            types.Int64  `tfsdk:"id"`
+	Interval      types.Int64  `tfsdk:"interval"`
+}
+
+func (t Task) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"name":            types.StringType,
+			"task_name":       types.StringType,
+			"last_execution":  types.StringType,
+			"last_start_time": types.StringType,
+			"next_execution":  types.StringType,
+			"last_duration":   types.StringType,
+			"id":              types.Int64Type,
+			"interval":        types.Int64Type,
+		})
+}
+
+func (d *TasksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + tasksDataSourceName
+}
+
+func (d *TasksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the delay server.
+		MarkdownDescription: "<!-- subcategory:System -->\nList all scheduled tasks, ordered by name.\nFor more information refer to [Tasks](https://wiki.servarr.com/lidarr/system#tasks) documentation.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"tasks": schema.ListNestedAttribute{
+				MarkdownDescription: "Task list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Task ID.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Task name.",
+							Computed:            true,
+						},
+						"task_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the command run by the task.",
+							Computed:            true,
+						},
+						"interval": schema.Int64Attribute{
+							MarkdownDescription: "Interval in minutes.",
+							Computed:            true,
+						},
+						"last_execution": schema.StringAttribute{
+							MarkdownDescription: "Last execution time.",
+							Computed:            true,
+						},
+						"last_start_time": schema.StringAttribute{
+							MarkdownDescription: "Last start time.",
+							Computed:            true,
+						},
+						"next_execution": schema.StringAttribute{
+							MarkdownDescription: "Next execution time.",
+							Computed:            true,
+						},
+						"last_duration": schema.StringAttribute{
+							MarkdownDescription: "Duration of the last execution.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TasksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *TasksDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get tasks current value
+	response, _, err := d.client.TaskAPI.ListSystemTask(d.auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, tasksDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+tasksDataSourceName)
+	// Map response body to resource schema attribute
+	sortTasks(response)
+
+	tasks := make([]Task, len(response))
+	for i, t := range response {
+		tasks[i].write(&t)
+	}
+
+	taskList, diags := types.ListValueFrom(ctx, Task{}.getType(), tasks)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, Tasks{Tasks: taskList, ID: types.StringValue(strconv.Itoa(len(response)))})...)
+}
+
+func (t *Task) write(task *lidarr.TaskResource) {
+	t.ID = types.Int64Value(int64(task.GetId()))
+	t.Name = types.StringValue(task.GetName())
+	t.TaskName = types.StringValue(task.GetTaskName())
+	t.Interval = types.Int64Value(int64(task.GetInterval()))
+	t.LastExecution = types.StringValue(task.GetLastExecution().String())
+	t.LastStartTime = types.StringValue(task.GetLastStartTime().String())
+	t.NextExecution = types.StringValue(task.GetNextExecution().String())
+	t.LastDuration = types.StringValue(task.GetLastDuration())
+}
+
+// sortTasks orders the tasks by name, so that the output is stable across reads.
+func sortTasks(tasks []lidarr.TaskResource) {
+	sort.SliceStable(tasks, func(i, j int) bool {
+		if tasks[i].GetName() != tasks[j].GetName() {
+			return tasks[i].GetName() < tasks[j].GetName()
+		}
+
+		return tasks[i].GetId() < tasks[j].GetId()
+	})
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/devopsarr/lidarr-go/lidarr"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccTasksDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccTasksDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccTasksDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.lidarr_tasks.test", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.lidarr_tasks.test", "tasks.*", map[string]string{"task_name": "RssSync"}),
+				),
+			},
+		},
+	})
+}
+
+const testAccTasksDataSourceConfig = `
+data "lidarr_tasks" "test" {
+}
+`
+
+func TestSortTasks(t *testing.T) {
+	t.Parallel()
+
+	task := func(id int32, name string) lidarr.TaskResource {
+		r := lidarr.NewTaskResource()
+		r.SetId(id)
+		r.SetName(name)
+
+		return *r
+	}
+
+	tasks := []lidarr.TaskResource{
+		task(3, "Rss Sync"),
+		task(2, "Backup"),
+		task(5, "Housekeeping"),
+		task(1, "Backup"),
+	}
+	sortTasks(tasks)
+
+	ids := make([]int32, len(tasks))
+	for i, r := range tasks {
+		ids[i] = r.GetId()
+	}
+
+	assert.Equal(t, []int32{1, 2, 5, 3}, ids)
+}